@@ -2,21 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 
+	"github.com/google/tink/go/aead"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/pseudomuto/pacman/internal/auth"
 	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/cache"
 	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
 	"github.com/pseudomuto/pacman/internal/goproxy"
 	"github.com/pseudomuto/pacman/internal/packager"
 	"github.com/pseudomuto/pacman/internal/publisher"
 	"github.com/pseudomuto/pacman/internal/server"
 	"github.com/pseudomuto/pacman/internal/storage"
 	"github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/pseudomuto/pacman/internal/vcs"
 	"github.com/urfave/cli/v3"
 	"go.uber.org/fx"
 )
@@ -42,6 +49,24 @@ func main() {
 				Name:  "keygen",
 				Usage: "Generate a new Tink keyset",
 			},
+			&cli.StringFlag{
+				Name:  "encrypt",
+				Usage: "Encrypt a secret (e.g. an API token or upstream password) using the configured cryptoKey",
+			},
+		},
+		Commands: []*cli.Command{
+			{
+				Name:      "audit",
+				Usage:     "Check that a sumdb tree is internally consistent, exiting non-zero when it isn't",
+				ArgsUsage: "<tree>",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					if cmd.NArg() != 1 {
+						return errors.New("a tree name is required")
+					}
+
+					return audit(ctx, cmd.Writer, cmd.String("config"), cmd.Args().First())
+				},
+			},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.Bool("keygen") {
@@ -55,6 +80,16 @@ func main() {
 				return nil
 			}
 
+			if pt := cmd.String("encrypt"); pt != "" {
+				ct, err := encrypt(cmd.String("config"), pt)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.Writer, ct)
+				return nil
+			}
+
 			app := fx.New(
 				fx.Supply(
 					fx.Annotate(ctx, fx.As(new(context.Context))),
@@ -66,7 +101,9 @@ func main() {
 						return prometheus.DefaultRegisterer.(*prometheus.Registry)
 					},
 				),
+				auth.Module,
 				boot.Module,
+				cache.Module,
 				config.Module,
 				crypto.Module,
 				data.Module,
@@ -76,6 +113,7 @@ func main() {
 				server.Module,
 				storage.Module,
 				sumdb.Module,
+				vcs.Module,
 				fx.NopLogger,
 			)
 
@@ -90,5 +128,81 @@ func main() {
 
 	if err := app.Run(context.Background(), os.Args); err != nil {
 		slog.Error("failed running server", "err", err)
+		os.Exit(1)
+	}
+}
+
+// audit audits the named tree, using the database from the config at path, and writes the report to w. An error is
+// returned when the tree isn't consistent.
+func audit(ctx context.Context, w io.Writer, path, tree string) error {
+	var db *ent.Client
+	app := fx.New(
+		fx.Supply(config.ConfigFilePath(path)),
+		config.Module,
+		crypto.Module,
+		// NB: Auditing must not change the database, so it isn't migrated.
+		fx.Provide(data.Open),
+		fx.Populate(&db),
+		fx.NopLogger,
+	)
+	if err := app.Err(); err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	report, err := sumdb.Audit(ctx, db, tree)
+	if err != nil {
+		return err
 	}
+
+	fmt.Fprintf(
+		w,
+		"tree: %s\nsize: %d\nrecords: %d\nhashes: %d\nheads: %d\n",
+		report.Tree,
+		report.Size,
+		report.Records,
+		report.Hashes,
+		report.Heads,
+	)
+	if report.OK() {
+		fmt.Fprintln(w, "ok")
+		return nil
+	}
+
+	fmt.Fprintf(w, "divergences: %d\n", report.Total)
+	for _, d := range report.Divergences {
+		fmt.Fprintf(w, "  - %s\n", d)
+	}
+
+	if n := report.Total - len(report.Divergences); n > 0 {
+		fmt.Fprintf(w, "  ... and %d more\n", n)
+	}
+
+	return fmt.Errorf("tree %s has %d divergences", report.Tree, report.Total)
+}
+
+// encrypt returns pt as an encoded crypto.Secret using the cryptoKey from the config at path.
+func encrypt(path, pt string) (string, error) {
+	c, err := config.LoadFile(path, os.ExpandEnv)
+	if err != nil {
+		return "", err
+	}
+
+	kh, err := crypto.ReadKey(c)
+	if err != nil {
+		return "", err
+	}
+
+	cipher, err := aead.New(kh)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	crypto.SetCipher(cipher)
+	ct, err := crypto.Secret(pt).Value()
+	if err != nil {
+		return "", err
+	}
+
+	return ct.(string), nil
 }
@@ -41,9 +41,12 @@ func tarDir(w io.Writer, src string, opts *compressOptions) error {
 		if err != nil {
 			return err
 		}
-		if relPath == "." {
+		switch {
+		case len(opts.prefixComponents) > 0:
+			header.Name = filepath.ToSlash(filepath.Join(filepath.Join(opts.prefixComponents...), relPath))
+		case relPath == ".":
 			header.Name = filepath.Base(src)
-		} else {
+		default:
 			header.Name = relPath
 		}
 
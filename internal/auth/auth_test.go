@@ -0,0 +1,84 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/pseudomuto/pacman/internal/auth"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	a, err := New(&config.Config{
+		Auth: config.Auth{
+			Tokens: []config.Token{
+				{Name: "ci", Token: encrypt(t, "ci-token"), Scopes: []string{ScopePublish}},
+				{Name: "ops", Token: encrypt(t, "ops-token"), Scopes: []string{ScopePublish, ScopeAdmin}},
+				{Name: "disabled", Scopes: []string{ScopeAdmin}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	engine := gin.New()
+	handler := func(ctx *gin.Context) { ctx.String(http.StatusOK, Principal(ctx)) }
+	engine.GET("/open", a.Middleware(), handler)
+	engine.GET("/publish", func(ctx *gin.Context) { ctx.Set("bearerAuth.Scopes", []string{ScopePublish}) }, a.Middleware(), handler)
+	engine.GET("/admin", a.Require(ScopeAdmin), handler)
+
+	tests := []struct {
+		name  string
+		path  string
+		token string
+		code  int
+		body  string
+	}{
+		{name: "unsecured route", path: "/open", code: http.StatusOK},
+		{name: "missing token", path: "/publish", code: http.StatusUnauthorized},
+		{name: "invalid token", path: "/publish", token: "nope", code: http.StatusUnauthorized},
+		{name: "valid token", path: "/publish", token: "ci-token", code: http.StatusOK, body: "ci"},
+		{name: "missing scope", path: "/admin", token: "ci-token", code: http.StatusForbidden},
+		{name: "admin scope", path: "/admin", token: "ops-token", code: http.StatusOK, body: "ops"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+			require.Equal(t, tt.code, w.Code, w.Body.String())
+
+			if tt.body != "" {
+				require.Equal(t, tt.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	// Tokens must be encrypted.
+	_, err := New(&config.Config{
+		Auth: config.Auth{Tokens: []config.Token{{Name: "ci", Token: "ci-token", Scopes: []string{ScopePublish}}}},
+	})
+	require.ErrorContains(t, err, "invalid token: ci")
+}
+
+func encrypt(t *testing.T, token string) string {
+	t.Helper()
+
+	ct, err := crypto.Secret(token).Value()
+	require.NoError(t, err)
+	return ct.(string)
+}
@@ -0,0 +1,5 @@
+package auth
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("auth", fx.Provide(New))
@@ -7,7 +7,6 @@ import (
 	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/ent"
-	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
 	"github.com/pseudomuto/sumdb"
 	"go.uber.org/fx"
 )
@@ -15,15 +14,17 @@ import (
 type SumDB struct {
 	fx.Out
 
-	Trees []*ent.SumDBTree
+	Trees *Trees
 }
 
+// InitSumDBs creates the trees in Config.Go.SumDBs which don't exist yet. Config.Go.SumDBs isn't an allow list though.
+// Every tree in the database which hasn't been retired is served, including those created at runtime (see Trees).
 func InitSumDBs(c *config.Config, db *ent.Client) (SumDB, error) {
 	var data SumDB
 
 	creates := make([]*ent.SumDBTreeCreate, len(c.Go.SumDBs))
 	for i, name := range c.Go.SumDBs {
-		cr, err := mkTree(db, name)
+		cr, err := NewTree(db, name)
 		if err != nil {
 			return data, err
 		}
@@ -40,26 +41,34 @@ func InitSumDBs(c *config.Config, db *ent.Client) (SumDB, error) {
 		return data, fmt.Errorf("failed to create one or more sumdb trees: %w", err)
 	}
 
-	trees, err := db.SumDBTree.Query().
-		Where(sumdbtree.NameIn(c.Go.SumDBs...)).
-		All(ctx)
-	if err != nil {
-		return data, fmt.Errorf("failed to query sumdb trees: %w", err)
+	data.Trees = NewTrees(db, c.Go.SumDBs...)
+	if _, err := data.Trees.List(ctx); err != nil {
+		return data, err
 	}
 
-	data.Trees = trees
 	return data, nil
 }
 
-func mkTree(db *ent.Client, name string) (*ent.SumDBTreeCreate, error) {
-	skey, vkey, err := sumdb.GenerateKeys(name)
+// NewTree returns a builder for an empty tree named name, with a newly generated signing key (see GenerateKeys).
+func NewTree(db *ent.Client, name string) (*ent.SumDBTreeCreate, error) {
+	skey, vkey, err := GenerateKeys(name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate signing keys for tree: %s, %w", name, err)
+		return nil, err
 	}
 
 	return db.SumDBTree.Create().
 		SetName(name).
 		SetSize(0).
-		SetSignerKey(crypto.Secret(skey)).
+		SetSignerKey(skey).
 		SetVerifierKey(vkey), nil
 }
+
+// GenerateKeys generates a signing key for the named tree, returning it along with its verifier key.
+func GenerateKeys(name string) (crypto.Secret, string, error) {
+	skey, vkey, err := sumdb.GenerateKeys(name)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate signing keys for tree: %s, %w", name, err)
+	}
+
+	return crypto.Secret(skey), vkey, nil
+}
@@ -0,0 +1,106 @@
+package boot
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+)
+
+// Trees tracks the sumdb trees which are served, i.e. every tree in the database which hasn't been retired (not just
+// those in Config.Go.SumDBs).
+//
+// Trees can be created, frozen, and retired at runtime (see the sumdb API), possibly by another replica. So rather than
+// being fixed at startup, they're reloaded once they're older than cache.DefaultTTL, or after Reload is called.
+type Trees struct {
+	db    *ent.Client
+	order []string
+	now   func() time.Time
+
+	mu     sync.RWMutex
+	trees  []*ent.SumDBTree
+	loaded time.Time
+}
+
+// NewTrees creates Trees for the trees in db. The trees named in order (e.g. Config.Go.SumDBs) are listed first, in
+// that order, followed by the others in the order they were created.
+func NewTrees(db *ent.Client, order ...string) *Trees {
+	return &Trees{db: db, order: order, now: time.Now}
+}
+
+// List returns the trees which are served.
+func (t *Trees) List(ctx context.Context) ([]*ent.SumDBTree, error) {
+	t.mu.RLock()
+	trees, loaded := t.trees, t.loaded
+	t.mu.RUnlock()
+
+	if !loaded.IsZero() && t.now().Sub(loaded) < cache.DefaultTTL {
+		return trees, nil
+	}
+
+	return t.load(ctx)
+}
+
+// Find returns the named tree, or false when it isn't served. Names are matched exactly.
+//
+// NB: Unknown names don't trigger a reload, since anyone can request them. Trees created by another replica are found
+// once the trees are reloaded.
+func (t *Trees) Find(ctx context.Context, name string) (*ent.SumDBTree, bool, error) {
+	trees, err := t.List(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tree, ok := find(trees, name)
+	return tree, ok, nil
+}
+
+// Reload ensures the trees are reloaded when next used (e.g. after a tree has been created or retired).
+func (t *Trees) Reload() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.loaded = time.Time{}
+}
+
+func (t *Trees) load(ctx context.Context) ([]*ent.SumDBTree, error) {
+	trees, err := t.db.SumDBTree.Query().
+		Where(sumdbtree.StatusNEQ(sumdbtree.StatusRetired)).
+		Order(sumdbtree.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sumdb trees: %w", err)
+	}
+
+	rank := func(tree *ent.SumDBTree) int {
+		if idx := slices.Index(t.order, tree.Name); idx != -1 {
+			return idx
+		}
+
+		return len(t.order)
+	}
+
+	slices.SortStableFunc(trees, func(a, b *ent.SumDBTree) int { return cmp.Compare(rank(a), rank(b)) })
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.trees = trees
+	t.loaded = t.now()
+	return trees, nil
+}
+
+func find(trees []*ent.SumDBTree, name string) (*ent.SumDBTree, bool) {
+	idx := slices.IndexFunc(trees, func(t *ent.SumDBTree) bool { return t.Name == name })
+	if idx == -1 {
+		return nil, false
+	}
+
+	return trees[idx], true
+}
@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultSize is the default maximum number of entries in a Cache.
+	DefaultSize = 10_000
+
+	// DefaultTTL is the default time mutable entries are cached for.
+	DefaultTTL = 30 * time.Second
+)
+
+type (
+	// Cache is a bounded, in-process LRU cache. Entries for immutable data are kept until they're evicted, while mutable
+	// ones expire after a TTL (see Set).
+	//
+	// A nil *Cache is valid, and caches nothing. This allows caching to be disabled without checks at each call site.
+	Cache[K comparable, V any] struct {
+		mu    sync.Mutex
+		size  int
+		ll    *list.List
+		items map[K]*list.Element
+		now   func() time.Time
+
+		hits   prometheus.Counter
+		misses prometheus.Counter
+	}
+
+	// Metrics are the hit and miss counters for all caches, labeled by the cache name.
+	Metrics struct {
+		hits   *prometheus.CounterVec
+		misses *prometheus.CounterVec
+	}
+
+	// Option configures a Cache.
+	Option func(*options)
+
+	options struct {
+		metrics *Metrics
+		now     func() time.Time
+	}
+
+	entry[K comparable, V any] struct {
+		key     K
+		val     V
+		expires time.Time
+	}
+)
+
+// NewMetrics creates the cache metrics, registering them with reg.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		hits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "cache_hits_total",
+				Help: "Number of lookups served from an in-process cache",
+			},
+			[]string{"cache"},
+		),
+		misses: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "cache_misses_total",
+				Help: "Number of lookups not found in an in-process cache",
+			},
+			[]string{"cache"},
+		),
+	}
+
+	reg.MustRegister(m.hits, m.misses)
+	return m
+}
+
+// WithMetrics counts the cache's hits and misses in m.
+func WithMetrics(m *Metrics) Option {
+	return func(o *options) { o.metrics = m }
+}
+
+// WithClock sets the function used to get the current time (for expiring entries). Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) { o.now = now }
+}
+
+// New creates a Cache named name (used to label metrics), holding at most size entries. When size is negative, caching
+// is disabled and nil is returned. A size of zero uses DefaultSize.
+func New[K comparable, V any](name string, size int, opts ...Option) *Cache[K, V] {
+	if size < 0 {
+		return nil
+	}
+
+	o := options{now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c := &Cache[K, V]{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+		now:   o.now,
+	}
+
+	if c.size == 0 {
+		c.size = DefaultSize
+	}
+
+	if o.metrics != nil {
+		c.hits = o.metrics.hits.WithLabelValues(name)
+		c.misses = o.metrics.misses.WithLabelValues(name)
+	}
+
+	return c
+}
+
+// Get returns the value for key, when it's cached and hasn't expired.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	var zero V
+	if c == nil {
+		return zero, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if ok {
+		e := el.Value.(*entry[K, V])
+		if e.expires.IsZero() || c.now().Before(e.expires) {
+			c.ll.MoveToFront(el)
+			inc(c.hits)
+			return e.val, true
+		}
+
+		c.remove(el)
+	}
+
+	inc(c.misses)
+	return zero, false
+}
+
+// Set caches val for key. When ttl is positive, the entry expires after it. Otherwise, it's kept until evicted.
+func (c *Cache[K, V]) Set(key K, val V, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &entry[K, V]{key: key, val: val}
+	if ttl > 0 {
+		e.expires = c.now().Add(ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		el.Value = e
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(e)
+	for c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+	}
+}
+
+// Delete removes the entry for key.
+func (c *Cache[K, V]) Delete(key K) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// DeleteFunc removes the entries whose keys match fn.
+func (c *Cache[K, V]) DeleteFunc(fn func(K) bool) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.items {
+		if fn(key) {
+			c.remove(el)
+		}
+	}
+}
+
+// Len returns the number of cached entries, including any which have expired but haven't been removed yet.
+func (c *Cache[K, V]) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
+
+func inc(c prometheus.Counter) {
+	if c != nil {
+		c.Inc()
+	}
+}
@@ -0,0 +1,104 @@
+package cache_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/pseudomuto/pacman/internal/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := New[string, int]("test", 2)
+		c.Set("a", 1, 0)
+		c.Set("b", 2, 0)
+
+		_, ok := c.Get("a")
+		require.True(t, ok)
+
+		c.Set("c", 3, 0)
+		require.Equal(t, 2, c.Len())
+
+		_, ok = c.Get("b")
+		require.False(t, ok)
+
+		v, ok := c.Get("a")
+		require.True(t, ok)
+		require.Equal(t, 1, v)
+	})
+
+	t.Run("expires entries", func(t *testing.T) {
+		now := time.Now()
+		c := New[string, int]("test", 0, WithClock(func() time.Time { return now }))
+		c.Set("mutable", 1, time.Minute)
+		c.Set("immutable", 2, 0)
+
+		now = now.Add(59 * time.Second)
+		_, ok := c.Get("mutable")
+		require.True(t, ok)
+
+		now = now.Add(time.Second)
+		_, ok = c.Get("mutable")
+		require.False(t, ok)
+
+		now = now.Add(24 * time.Hour)
+		_, ok = c.Get("immutable")
+		require.True(t, ok)
+		require.Equal(t, 1, c.Len())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		c := New[string, int]("test", 0)
+		c.Set("a", 1, 0)
+		c.Set("b", 2, 0)
+		c.Set("c", 3, 0)
+
+		c.Delete("a")
+		c.DeleteFunc(func(k string) bool { return k == "b" })
+
+		_, ok := c.Get("a")
+		require.False(t, ok)
+		_, ok = c.Get("b")
+		require.False(t, ok)
+		_, ok = c.Get("c")
+		require.True(t, ok)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := New[string, int]("test", -1)
+		require.Nil(t, c)
+
+		c.Set("a", 1, 0)
+		_, ok := c.Get("a")
+		require.False(t, ok)
+		require.Zero(t, c.Len())
+	})
+}
+
+func TestCache_Metrics(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	m := NewMetrics(reg)
+
+	c := New[string, int]("things", 0, WithMetrics(m))
+	c.Set("a", 1, 0)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP cache_hits_total Number of lookups served from an in-process cache
+# TYPE cache_hits_total counter
+cache_hits_total{cache="things"} 2
+# HELP cache_misses_total Number of lookups not found in an in-process cache
+# TYPE cache_misses_total counter
+cache_misses_total{cache="things"} 1
+`)))
+}
@@ -0,0 +1,11 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"cache",
+	fx.Provide(func(reg *prometheus.Registry) *Metrics { return NewMetrics(reg) }),
+)
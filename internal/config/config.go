@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/goccy/go-yaml"
 )
@@ -18,10 +19,54 @@ type (
 		Addr           string   `yaml:"addr"`
 		MetricsAddr    string   `yaml:"metricsAddr"`
 		Debug          bool     `yaml:"debug"`
+		Auth           Auth     `yaml:"auth"`
 		DB             Database `yaml:"db"`
 		Go             Go       `yaml:"go"`
 		CryptoKey      string   `yaml:"cryptoKey"`
 		StorageBuckets []string `yaml:"storageBuckets"`
+		VCS            VCS      `yaml:"vcs"`
+	}
+
+	// Auth configures access to authenticated API endpoints.
+	Auth struct {
+		Tokens []Token `yaml:"tokens,omitempty"`
+	}
+
+	// Token is a bearer token permitted to call API endpoints requiring any of its scopes.
+	Token struct {
+		Name string `yaml:"name"`
+		// Token is a crypto.Secret, encrypted with the CryptoKey (see --encrypt).
+		Token  string   `yaml:"token"`
+		Scopes []string `yaml:"scopes"`
+	}
+
+	// VCS configures the version control systems packages can be published from.
+	VCS struct {
+		Git    *Git    `yaml:"git,omitempty"`
+		GitHub *GitHub `yaml:"github,omitempty"`
+		GitLab *GitLab `yaml:"gitlab,omitempty"`
+	}
+
+	// Git enables publishing from arbitrary git remotes (including file:// and local paths) using the git binary.
+	Git struct {
+		// Binary is the path to git. Defaults to git on the PATH.
+		Binary string `yaml:"binary,omitempty"`
+	}
+
+	// GitHub configures access to GitHub or GitHub Enterprise.
+	GitHub struct {
+		// BaseURL is the REST API root. Defaults to https://api.github.com.
+		BaseURL string `yaml:"baseURL,omitempty"`
+		// Token is a crypto.Secret, encrypted with the CryptoKey (see --encrypt). It's required for private repos.
+		Token string `yaml:"token,omitempty"`
+	}
+
+	// GitLab configures access to gitlab.com or a self-managed GitLab instance.
+	GitLab struct {
+		// BaseURL is the web root of the instance (e.g. https://gitlab.example.com). Defaults to https://gitlab.com.
+		BaseURL string `yaml:"baseURL,omitempty"`
+		// Token is a crypto.Secret, encrypted with the CryptoKey (see --encrypt). It's required for private repos.
+		Token string `yaml:"token,omitempty"`
 	}
 
 	Database struct {
@@ -31,7 +76,99 @@ type (
 
 	Go struct {
 		NoSumPatterns []string `yaml:"noSumPatterns,omitempty"`
-		SumDBs        []string `yaml:"sumdbs,omitempty"`
+		// SumDBs are the sumdb trees created at startup (unless they exist), which are listed first, in order. It isn't
+		// an allow list though. Every tree which hasn't been retired is served, including those created via the API.
+		SumDBs []string `yaml:"sumdbs,omitempty"`
+
+		// CacheBucket enables pull-through caching of upstream modules into this bucket. It must also be listed in
+		// StorageBuckets.
+		CacheBucket string `yaml:"cacheBucket,omitempty"`
+
+		// ChecksumDB verifies modules against this checksum database before they're cached (see CacheBucket). It uses
+		// GOSUMDB syntax, e.g. "sum.golang.org" or "<verifier key> [<url>]". Defaults to sum.golang.org, and "off"
+		// disables verification.
+		ChecksumDB string `yaml:"checksumDB,omitempty"`
+
+		// TileBucket stores complete sumdb tiles in this bucket, rather than in memory. It must also be listed in
+		// StorageBuckets.
+		TileBucket string `yaml:"tileBucket,omitempty"`
+
+		// Witnesses are the witnesses whose cosignatures of the sumdb tree heads are accepted and served.
+		Witnesses []Witness `yaml:"witnesses,omitempty"`
+
+		// Upstreams are the GOPROXY servers public modules are fetched from, in order. Defaults to proxy.golang.org.
+		Upstreams []Upstream `yaml:"upstreams,omitempty"`
+
+		// Virtual enables a single GOPROXY endpoint which resolves modules from the sumdb trees and upstreams.
+		Virtual *Virtual `yaml:"virtual,omitempty"`
+
+		// Limits restricts the size of published modules.
+		Limits Limits `yaml:"limits,omitempty"`
+
+		// MemoryCache configures the in-process cache of goproxy and sumdb lookups.
+		MemoryCache MemoryCache `yaml:"memoryCache,omitempty"`
+	}
+
+	// MemoryCache configures the in-process cache of goproxy and sumdb lookups. Recorded versions never change, so they're
+	// cached until evicted, while mutable entries (e.g. version lists and tree sizes) expire after TTL. Each replica has
+	// its own cache, so changes made through other replicas are seen once these entries expire.
+	MemoryCache struct {
+		// Size is the maximum number of entries in each cache. Defaults to 10,000. Negative values disable caching.
+		Size int `yaml:"size,omitempty"`
+		// TTL is how long mutable entries are cached. Defaults to 30s.
+		TTL time.Duration `yaml:"ttl,omitempty"`
+	}
+
+	// Limits restricts the size of published modules, in addition to the limits imposed by the module zip format
+	// (500 MiB uncompressed, 16 MiB go.mod). Zero values are unlimited.
+	Limits struct {
+		// MaxSize is the maximum total uncompressed size (in bytes) of the files in the module.
+		MaxSize int64 `yaml:"maxSize,omitempty"`
+		// MaxFileSize is the maximum size (in bytes) of any single file in the module.
+		MaxFileSize int64 `yaml:"maxFileSize,omitempty"`
+		// MaxFiles is the maximum number of files in the module.
+		MaxFiles int `yaml:"maxFiles,omitempty"`
+	}
+
+	// Witness is a witness which cosigns sumdb tree heads, after checking that they're consistent with those it has seen
+	// before (see https://c2sp.org/tlog-witness).
+	Witness struct {
+		// Key is the witness' verifier key. Both cosignature/v1 and Ed25519 keys are supported.
+		Key string `yaml:"key"`
+		// Trees are the sumdb trees the witness cosigns. Defaults to all of them.
+		Trees []string `yaml:"trees,omitempty"`
+	}
+
+	// Virtual configures the virtual Go proxy, served at /goproxy/<name>.
+	Virtual struct {
+		Name string `yaml:"name"`
+		// Routes are matched in order. Modules matching none of them are resolved from every tree (those in SumDBs
+		// first, in order), then upstream. Routes can only refer to trees which exist at startup.
+		Routes []Route `yaml:"routes,omitempty"`
+	}
+
+	// Route determines where modules matching Pattern are resolved from.
+	Route struct {
+		// Pattern is a comma separated list of glob patterns, using GOPRIVATE syntax.
+		Pattern string `yaml:"pattern"`
+		// Trees are the sumdb trees to search, in order.
+		Trees []string `yaml:"trees,omitempty"`
+		// Upstream enables falling back to the upstream proxies when the module isn't in any of the trees.
+		Upstream bool `yaml:"upstream,omitempty"`
+	}
+
+	// Upstream is a GOPROXY server (e.g. proxy.golang.org, Athens, Artifactory).
+	Upstream struct {
+		URL string `yaml:"url"`
+		// Timeout bounds each request to this upstream. Defaults to no timeout.
+		Timeout time.Duration `yaml:"timeout,omitempty"`
+		// FallbackOnError moves on to the next upstream for any error, like "|" in GOPROXY. Otherwise, only 404 and 410
+		// responses fall back, like ",".
+		FallbackOnError bool `yaml:"fallbackOnError,omitempty"`
+		// Username and Password are sent using basic auth. When only Password is set, it's sent as a bearer token.
+		Username string `yaml:"username,omitempty"`
+		// Password is a crypto.Secret, encrypted with the CryptoKey (see --encrypt).
+		Password string `yaml:"password,omitempty"`
 	}
 )
 
@@ -47,6 +184,29 @@ func Load(r io.Reader, exp EnvExpander) (*Config, error) {
 	c.DB.Dialect = exp(c.DB.Dialect)
 	c.DB.DSN = exp(c.DB.DSN)
 	c.CryptoKey = exp(c.CryptoKey)
+	c.Go.CacheBucket = exp(c.Go.CacheBucket)
+	c.Go.ChecksumDB = exp(c.Go.ChecksumDB)
+	c.Go.TileBucket = exp(c.Go.TileBucket)
+
+	for i := range c.Go.Upstreams {
+		c.Go.Upstreams[i].URL = exp(c.Go.Upstreams[i].URL)
+		c.Go.Upstreams[i].Username = exp(c.Go.Upstreams[i].Username)
+		c.Go.Upstreams[i].Password = exp(c.Go.Upstreams[i].Password)
+	}
+
+	if gh := c.VCS.GitHub; gh != nil {
+		gh.BaseURL = exp(gh.BaseURL)
+		gh.Token = exp(gh.Token)
+	}
+
+	if gl := c.VCS.GitLab; gl != nil {
+		gl.BaseURL = exp(gl.BaseURL)
+		gl.Token = exp(gl.Token)
+	}
+
+	for i := range c.Auth.Tokens {
+		c.Auth.Tokens[i].Token = exp(c.Auth.Tokens[i].Token)
+	}
 
 	return &c, nil
 }
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/pseudomuto/pacman/internal/config"
 	"github.com/stretchr/testify/require"
@@ -13,8 +14,11 @@ import (
 
 func TestLoad(t *testing.T) {
 	env := func(s string) string {
-		if s == "$DATABASE_URL" {
+		switch s {
+		case "$DATABASE_URL":
 			return "sqlite://open_string"
+		case "$CI_TOKEN":
+			return "secret"
 		}
 
 		return s
@@ -26,6 +30,43 @@ metricsAddr: ":9200"
 db:
   dialect: sqlite
   dsn: $DATABASE_URL
+auth:
+  tokens:
+    - name: ci
+      token: $CI_TOKEN
+      scopes: [publish]
+vcs:
+  gitlab:
+    baseURL: https://gitlab.example.com
+    token: $CI_TOKEN
+go:
+  cacheBucket: file:///path/on/disk/cache
+  tileBucket: file:///path/on/disk/tiles
+  witnesses:
+    - key: witness.example.com+1a2b3c4d+BCz1Ot6lMvvmjDTMv8fVFNKbeXKPJmuDIPnHFL2HxH29
+      trees: [private.sumdb.com]
+  upstreams:
+    - url: https://athens.example.com
+      timeout: 5s
+      fallbackOnError: true
+      username: ci
+      password: $CI_TOKEN
+    - url: https://proxy.golang.org
+  virtual:
+    name: all
+    routes:
+      - pattern: github.com/pseudomuto/*
+        trees: [private.sumdb.com]
+      - pattern: "*"
+        trees: [public.sumdb.com]
+        upstream: true
+  limits:
+    maxSize: 104857600
+    maxFileSize: 10485760
+    maxFiles: 1000
+  memoryCache:
+    size: 5000
+    ttl: 1m
 storageBuckets:
   - gs://some-gcp-bucket
   - s3://some-aws-bucket
@@ -39,10 +80,54 @@ debug: true`)
 		Addr:        ":8080",
 		MetricsAddr: ":9200",
 		Debug:       true,
+		Auth: Auth{
+			Tokens: []Token{
+				{Name: "ci", Token: "secret", Scopes: []string{"publish"}},
+			},
+		},
 		DB: Database{
 			Dialect: "sqlite",
 			DSN:     "sqlite://open_string",
 		},
+		VCS: VCS{
+			GitLab: &GitLab{BaseURL: "https://gitlab.example.com", Token: "secret"},
+		},
+		Go: Go{
+			CacheBucket: "file:///path/on/disk/cache",
+			TileBucket:  "file:///path/on/disk/tiles",
+			Witnesses: []Witness{
+				{
+					Key:   "witness.example.com+1a2b3c4d+BCz1Ot6lMvvmjDTMv8fVFNKbeXKPJmuDIPnHFL2HxH29",
+					Trees: []string{"private.sumdb.com"},
+				},
+			},
+			Upstreams: []Upstream{
+				{
+					URL:             "https://athens.example.com",
+					Timeout:         5 * time.Second,
+					FallbackOnError: true,
+					Username:        "ci",
+					Password:        "secret",
+				},
+				{URL: "https://proxy.golang.org"},
+			},
+			Virtual: &Virtual{
+				Name: "all",
+				Routes: []Route{
+					{Pattern: "github.com/pseudomuto/*", Trees: []string{"private.sumdb.com"}},
+					{Pattern: "*", Trees: []string{"public.sumdb.com"}, Upstream: true},
+				},
+			},
+			Limits: Limits{
+				MaxSize:     100 << 20,
+				MaxFileSize: 10 << 20,
+				MaxFiles:    1000,
+			},
+			MemoryCache: MemoryCache{
+				Size: 5000,
+				TTL:  time.Minute,
+			},
+		},
 		StorageBuckets: []string{
 			"gs://some-gcp-bucket",
 			"s3://some-aws-bucket",
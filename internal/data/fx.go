@@ -10,17 +10,29 @@ import (
 	"go.uber.org/fx"
 )
 
+// Module provides the *ent.Client for the configured database, migrating it to the current schema.
 var Module = fx.Module("data", fx.Provide(
 	func(c *config.Config) (*ent.Client, error) {
-		client, err := ent.Open(c.DB.Dialect, c.DB.DSN)
+		client, err := Open(c)
 		if err != nil {
-			return nil, fmt.Errorf("failed to open %s connection: %w", c.DB.Dialect, err)
+			return nil, err
 		}
 
-		if err := client.Schema.Create(context.Background()); err != nil {
-			return nil, fmt.Errorf("failed to run DB migrations: %w", err)
+		if err := Migrate(context.Background(), client); err != nil {
+			return nil, err
 		}
 
 		return client, nil
 	},
 ))
+
+// Open opens a connection to the configured database. Unlike Module, the schema isn't migrated, so it's suitable for
+// commands which must not change the database (e.g. audit).
+func Open(c *config.Config) (*ent.Client, error) {
+	client, err := ent.Open(c.DB.Dialect, c.DB.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s connection: %w", c.DB.Dialect, err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,37 @@
+package data_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	. "github.com/pseudomuto/pacman/internal/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "pacman.db") + "?_fk=1"
+	client, err := Open(&config.Config{DB: config.Database{Dialect: "sqlite3", DSN: dsn}})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	// Opening the database doesn't migrate it.
+	_, err = client.SumDBTree.Query().Count(t.Context())
+	require.ErrorContains(t, err, "no such table")
+
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	var tables int
+	require.NoError(t, db.QueryRowContext(t.Context(), "SELECT count(*) FROM sqlite_master").Scan(&tables))
+	require.Zero(t, tables)
+
+	t.Run("unknown dialect", func(t *testing.T) {
+		_, err := Open(&config.Config{DB: config.Database{Dialect: "oracle"}})
+		require.ErrorContains(t, err, "failed to open oracle connection")
+	})
+}
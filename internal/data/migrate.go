@@ -0,0 +1,19 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pseudomuto/pacman/internal/ent"
+)
+
+// Migrate creates or updates the schema of db. Migrations are additive: missing tables, columns and indexes are added,
+// and existing columns are widened (e.g. module paths and versions, which were originally varchar(200)/varchar(20) on
+// MySQL). Nothing is dropped.
+func Migrate(ctx context.Context, db *ent.Client) error {
+	if err := db.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("failed to run DB migrations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package data_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
+	entmigrate "github.com/pseudomuto/pacman/internal/ent/migrate"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "pacman.db") + "?_fk=1"
+
+	// An existing database, created by the first release (testdata/baseline.sql is its schema).
+	ddl, err := os.ReadFile("testdata/baseline.sql")
+	require.NoError(t, err)
+
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.ExecContext(t.Context(), string(ddl))
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), `
+		INSERT INTO sum_db_trees (id, created_at, updated_at, name, size, signer_key, verifier_key)
+		VALUES (1, datetime('now'), datetime('now'), 'sum.example.com', 1, 'skey', 'vkey');
+		INSERT INTO archives (id, created_at, updated_at, type, coordinate, assets)
+		VALUES (1, datetime('now'), datetime('now'), 'gomod', 'example.com/mod@v1.0.0', '[]');
+		INSERT INTO sum_db_records (id, created_at, updated_at, record_id, path, version, data, tree_id)
+		VALUES (1, datetime('now'), datetime('now'), 0, 'example.com/mod', 'v1.0.0', 'data', 1);
+	`)
+	require.NoError(t, err)
+
+	client, err := ent.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	require.NoError(t, Migrate(t.Context(), client))
+
+	// Existing rows are kept, and new columns get their defaults.
+	require.True(t, client.SumDBTree.Query().
+		Where(sumdbtree.Name("sum.example.com"), sumdbtree.StatusEQ(sumdbtree.StatusActive)).
+		ExistX(t.Context()))
+
+	arch := client.Archive.GetX(t.Context(), 1)
+	require.Equal(t, types.GoModule, arch.Type)
+	require.Equal(t, "example.com/mod@v1.0.0", arch.Coordinate)
+	require.False(t, arch.Cached)
+
+	rec := client.SumDBRecord.Query().Where(sumdbrecord.Path("example.com/mod")).OnlyX(t.Context())
+	require.Equal(t, "v1.0.0", rec.Version)
+
+	// Pseudo-versions and deep monorepo paths fit in the migrated columns.
+	path := "example.com/" + strings.Repeat("nested/", 60) + "mod"
+	version := "v0.0.0-20191109021931-daa7c04131f5"
+	client.SumDBRecord.Create().
+		SetTreeID(1).
+		SetRecordID(1).
+		SetPath(path).
+		SetVersion(version).
+		SetData([]byte("data")).
+		ExecX(t.Context())
+
+	client.Archive.Create().
+		SetType(types.GoModule).
+		SetCoordinate(path + "@" + version).
+		SetAssets(nil).
+		ExecX(t.Context())
+
+	// Migrating again (i.e. restarting) is a no-op.
+	require.NoError(t, Migrate(t.Context(), client))
+	require.Equal(t, 2, client.SumDBRecord.Query().CountX(t.Context()))
+}
+
+// SQLite doesn't enforce varchar lengths, so the ALTERs planned for the other dialects are checked instead. That is,
+// migrating the columns of the first release (varchar(200) paths and coordinates, varchar(20) versions) widens them.
+func TestMigrate_ColumnWidths(t *testing.T) {
+	t.Parallel()
+
+	// The columns of the first release.
+	baseline := func(tbl *entschema.Table) *entschema.Table {
+		cp := *tbl
+		cp.Columns = make([]*entschema.Column, len(tbl.Columns))
+		for i, c := range tbl.Columns {
+			col := *c
+			switch c.Name {
+			case "path", "coordinate":
+				col.Size = 200
+			case "version":
+				col.Size = 20
+			}
+
+			cp.Columns[i] = &col
+		}
+
+		return &cp
+	}
+
+	// plan returns the statements planned to migrate each table from the first release.
+	plan := func(t *testing.T, name string, diff atlas.Differ, pa migrate.PlanApplier) map[string][]string {
+		t.Helper()
+
+		db, err := sql.Open("sqlite3", "file:"+name+"?mode=memory")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		m, err := entschema.NewMigrate(planDriver{Driver: entsql.OpenDB(dialect.SQLite, db), name: name})
+		require.NoError(t, err)
+
+		read := func(tables ...*entschema.Table) map[string]*atlas.Table {
+			realm, err := m.StateReader(tables...).ReadState(t.Context())
+			require.NoError(t, err)
+
+			res := make(map[string]*atlas.Table)
+			for _, tbl := range realm.Schemas[0].Tables {
+				res[tbl.Name] = tbl
+			}
+
+			return res
+		}
+
+		// NB: Records reference their tree, so it's included too.
+		from := read(baseline(entmigrate.ArchivesTable), baseline(entmigrate.SumDbRecordsTable), entmigrate.SumDbTreesTable)
+		to := read(entmigrate.ArchivesTable, entmigrate.SumDbRecordsTable, entmigrate.SumDbTreesTable)
+
+		res := make(map[string][]string)
+		for _, tbl := range []string{"archives", "sum_db_records"} {
+			changes, err := diff.TableDiff(from[tbl], to[tbl])
+			require.NoError(t, err)
+
+			if len(changes) == 0 {
+				continue
+			}
+
+			p, err := pa.PlanChanges(t.Context(), tbl, []atlas.Change{&atlas.ModifyTable{T: to[tbl], Changes: changes}})
+			require.NoError(t, err)
+
+			for _, c := range p.Changes {
+				res[tbl] = append(res[tbl], c.Cmd)
+			}
+		}
+
+		return res
+	}
+
+	t.Run("mysql", func(t *testing.T) {
+		stmts := plan(t, dialect.MySQL, mysql.DefaultDiff, mysql.DefaultPlan)
+		require.Equal(t, []string{
+			"ALTER TABLE `archives` MODIFY COLUMN `coordinate` varchar(" + strconv.Itoa(schema.MaxCoordinateLen) + ") NOT NULL",
+		}, stmts["archives"])
+		require.Equal(t, []string{
+			"ALTER TABLE `sum_db_records` " +
+				"MODIFY COLUMN `path` varchar(" + strconv.Itoa(schema.MaxPathLen) + ") NOT NULL, " +
+				"MODIFY COLUMN `version` varchar(" + strconv.Itoa(schema.MaxVersionLen) + ") NOT NULL",
+		}, stmts["sum_db_records"])
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		// NB: Ent maps strings to unbounded varchar columns on Postgres, so they never needed widening.
+		stmts := plan(t, dialect.Postgres, postgres.DefaultDiff, postgres.DefaultPlan)
+		require.Empty(t, stmts)
+	})
+}
+
+// planDriver is a dialect.Driver for planning migrations for another dialect (name). Only the server version is
+// queried when planning, so it's answered by the underlying (SQLite) driver.
+type planDriver struct {
+	dialect.Driver
+	name string
+}
+
+func (d planDriver) Dialect() string {
+	return d.name
+}
+
+func (d planDriver) Query(ctx context.Context, query string, args, v any) error {
+	switch query {
+	case "SHOW server_version_num":
+		query = "SELECT '150000'"
+	case "SHOW VARIABLES LIKE 'version'":
+		query = "SELECT 'version', '8.0.36'"
+	}
+
+	return d.Driver.Query(ctx, query, args, v)
+}
@@ -0,0 +1,26 @@
+PRAGMA foreign_keys = off;
+CREATE TABLE `archives` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `type` text NOT NULL, `coordinate` text NOT NULL, `assets` json NOT NULL);
+CREATE INDEX `archive_created_at` ON `archives` (`created_at`);
+CREATE INDEX `archive_updated_at` ON `archives` (`updated_at`);
+CREATE INDEX `archive_type` ON `archives` (`type`);
+CREATE UNIQUE INDEX `archive_type_coordinate` ON `archives` (`type`, `coordinate`);
+CREATE TABLE `assets` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `type` text NOT NULL, `uri` text NOT NULL);
+CREATE INDEX `asset_created_at` ON `assets` (`created_at`);
+CREATE INDEX `asset_updated_at` ON `assets` (`updated_at`);
+CREATE TABLE `sum_db_hashes` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `index` integer NOT NULL, `hash` blob NOT NULL, `tree_id` integer NOT NULL, CONSTRAINT `sum_db_hashes_sum_db_trees_hashes` FOREIGN KEY (`tree_id`) REFERENCES `sum_db_trees` (`id`) ON DELETE NO ACTION);
+CREATE INDEX `sumdbhash_created_at` ON `sum_db_hashes` (`created_at`);
+CREATE INDEX `sumdbhash_updated_at` ON `sum_db_hashes` (`updated_at`);
+CREATE INDEX `sumdbhash_tree_id` ON `sum_db_hashes` (`tree_id`);
+CREATE UNIQUE INDEX `sumdbhash_index_tree_id` ON `sum_db_hashes` (`index`, `tree_id`);
+CREATE TABLE `sum_db_records` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `record_id` integer NOT NULL, `path` text NOT NULL, `version` text NOT NULL, `data` blob NOT NULL, `tree_id` integer NOT NULL, CONSTRAINT `sum_db_records_sum_db_trees_records` FOREIGN KEY (`tree_id`) REFERENCES `sum_db_trees` (`id`) ON DELETE NO ACTION);
+CREATE INDEX `sumdbrecord_created_at` ON `sum_db_records` (`created_at`);
+CREATE INDEX `sumdbrecord_updated_at` ON `sum_db_records` (`updated_at`);
+CREATE INDEX `sumdbrecord_tree_id` ON `sum_db_records` (`tree_id`);
+CREATE UNIQUE INDEX `sumdbrecord_record_id_tree_id` ON `sum_db_records` (`record_id`, `tree_id`);
+CREATE UNIQUE INDEX `sumdbrecord_path_version_tree_id` ON `sum_db_records` (`path`, `version`, `tree_id`);
+CREATE TABLE `sum_db_trees` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `size` integer NOT NULL, `signer_key` text NOT NULL, `verifier_key` text NOT NULL);
+CREATE UNIQUE INDEX `sum_db_trees_name_key` ON `sum_db_trees` (`name`);
+CREATE INDEX `sumdbtree_created_at` ON `sum_db_trees` (`created_at`);
+CREATE INDEX `sumdbtree_updated_at` ON `sum_db_trees` (`updated_at`);
+CREATE TABLE `sum_db_record_assets` (`sum_db_record_id` integer NOT NULL, `asset_id` integer NOT NULL, PRIMARY KEY (`sum_db_record_id`, `asset_id`), CONSTRAINT `sum_db_record_assets_sum_db_record_id` FOREIGN KEY (`sum_db_record_id`) REFERENCES `sum_db_records` (`id`) ON DELETE CASCADE, CONSTRAINT `sum_db_record_assets_asset_id` FOREIGN KEY (`asset_id`) REFERENCES `assets` (`id`) ON DELETE CASCADE);
+PRAGMA foreign_keys = on;
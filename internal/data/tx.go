@@ -2,8 +2,12 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
+	"github.com/mattn/go-sqlite3"
 	"github.com/pseudomuto/pacman/internal/ent"
 )
 
@@ -43,3 +47,64 @@ func WithTx[T any](ctx context.Context, client *ent.Client, fn func(tx *ent.Tx)
 
 	return res, nil
 }
+
+// MaxAttempts is the number of times WithRetry runs a transaction before giving up.
+const MaxAttempts = 10
+
+// ErrConflict is returned when a transaction loses a race with a concurrent one (e.g. from another replica). Retrying
+// the transaction (see WithRetry) will see the winner's changes.
+var ErrConflict = errors.New("concurrent modification")
+
+// WithRetry calls WithTx, retrying fn in a new transaction when it fails due to a conflict with a concurrent
+// transaction. Conflicts are errors wrapping ErrConflict, SQLite's busy and locked errors (which it returns to writers
+// which would otherwise deadlock), and Postgres' serialization failures and deadlocks. Attempts are separated by a
+// jittered, exponential backoff (capped at a second), and are limited to MaxAttempts.
+//
+// Since fn may be called more than once, it must not have side effects outside of the transaction.
+func WithRetry[T any](ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) (*T, error)) (*T, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := WithTx(ctx, client, fn)
+		if err == nil || !isConflict(err) || attempt == MaxAttempts {
+			return res, err
+		}
+
+		backoff := rand.N(min(10*time.Millisecond<<attempt, time.Second)) + time.Millisecond
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", err, ctx.Err())
+		case <-time.After(backoff):
+		}
+	}
+}
+
+const (
+	// pgSerializationFailure and pgDeadlockDetected are the SQLSTATE codes Postgres uses for transactions which can be
+	// retried.
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// sqlStateError is implemented by Postgres driver errors (i.e. pgconn.PgError and pq.Error).
+type sqlStateError interface {
+	error
+	SQLState() string
+}
+
+func isConflict(err error) bool {
+	if errors.Is(err, ErrConflict) {
+		return true
+	}
+
+	var serr sqlite3.Error
+	if errors.As(err, &serr) {
+		return serr.Code == sqlite3.ErrBusy || serr.Code == sqlite3.ErrLocked
+	}
+
+	var perr sqlStateError
+	if errors.As(err, &perr) {
+		code := perr.SQLState()
+		return code == pgSerializationFailure || code == pgDeadlockDetected
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package data_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	. "github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetry(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name     string
+		err      error
+		attempts int
+	}{
+		{name: "conflict", err: fmt.Errorf("failed: %w", ErrConflict), attempts: 2},
+		{name: "sqlite busy", err: sqlite3.Error{Code: sqlite3.ErrBusy}, attempts: 2},
+		{name: "sqlite locked", err: sqlite3.Error{Code: sqlite3.ErrLocked}, attempts: 2},
+		{name: "sqlite constraint", err: sqlite3.Error{Code: sqlite3.ErrConstraint}, attempts: 1},
+		{name: "postgres serialization failure", err: fmt.Errorf("failed: %w", &pgError{code: "40001"}), attempts: 2},
+		{name: "postgres deadlock", err: &pgError{code: "40P01"}, attempts: 2},
+		{name: "postgres unique violation", err: &pgError{code: "23505"}, attempts: 1},
+		{name: "other", err: errBoom, attempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			res, err := WithRetry(t.Context(), client, func(*ent.Tx) (*int, error) {
+				attempts++
+				if attempts == 1 {
+					return nil, tt.err
+				}
+
+				return &attempts, nil
+			})
+
+			require.Equal(t, tt.attempts, attempts)
+			if tt.attempts == 1 {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 2, *res)
+		})
+	}
+
+	t.Run("max attempts", func(t *testing.T) {
+		var attempts int
+		_, err := WithRetry(t.Context(), client, func(*ent.Tx) (*int, error) {
+			attempts++
+			return nil, ErrConflict
+		})
+
+		require.ErrorIs(t, err, ErrConflict)
+		require.Equal(t, MaxAttempts, attempts)
+	})
+}
+
+// pgError mimics the errors returned by Postgres drivers, which report the SQLSTATE code.
+type pgError struct {
+	code string
+}
+
+func (e *pgError) Error() string    { return "postgres error: " + e.code }
+func (e *pgError) SQLState() string { return e.code }
@@ -29,7 +29,15 @@ type Archive struct {
 	// Coordinate holds the value of the "coordinate" field.
 	Coordinate string `json:"coordinate,omitempty"`
 	// Assets holds the value of the "assets" field.
-	Assets       []schema.AssetURL `json:"assets,omitempty"`
+	Assets []schema.AssetURL `json:"assets,omitempty"`
+	// When the version was released (the Time in .info)
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// Where the version came from (the Origin in .info)
+	Origin *schema.Origin `json:"origin,omitempty"`
+	// The h1: dirhash of the package built from source, used to detect conflicting publishes
+	Hash string `json:"hash,omitempty"`
+	// Whether the archive was cached from an upstream proxy, rather than published
+	Cached       bool `json:"cached,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -38,13 +46,15 @@ func (*Archive) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case archive.FieldAssets:
+		case archive.FieldAssets, archive.FieldOrigin:
 			values[i] = new([]byte)
+		case archive.FieldCached:
+			values[i] = new(sql.NullBool)
 		case archive.FieldID:
 			values[i] = new(sql.NullInt64)
-		case archive.FieldCoordinate:
+		case archive.FieldCoordinate, archive.FieldHash:
 			values[i] = new(sql.NullString)
-		case archive.FieldCreatedAt, archive.FieldUpdatedAt:
+		case archive.FieldCreatedAt, archive.FieldUpdatedAt, archive.FieldReleasedAt:
 			values[i] = new(sql.NullTime)
 		case archive.FieldType:
 			values[i] = new(types.ArchiveType)
@@ -101,6 +111,33 @@ func (_m *Archive) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field assets: %w", err)
 				}
 			}
+		case archive.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		case archive.FieldOrigin:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Origin); err != nil {
+					return fmt.Errorf("unmarshal field origin: %w", err)
+				}
+			}
+		case archive.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case archive.FieldCached:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cached", values[i])
+			} else if value.Valid {
+				_m.Cached = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -151,6 +188,20 @@ func (_m *Archive) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("assets=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Assets))
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Origin))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("cached=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cached))
 	builder.WriteByte(')')
 	return builder.String()
 }
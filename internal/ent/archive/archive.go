@@ -25,6 +25,14 @@ const (
 	FieldCoordinate = "coordinate"
 	// FieldAssets holds the string denoting the assets field in the database.
 	FieldAssets = "assets"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldCached holds the string denoting the cached field in the database.
+	FieldCached = "cached"
 	// Table holds the table name of the archive in the database.
 	Table = "archives"
 )
@@ -37,6 +45,10 @@ var Columns = []string{
 	FieldType,
 	FieldCoordinate,
 	FieldAssets,
+	FieldReleasedAt,
+	FieldOrigin,
+	FieldHash,
+	FieldCached,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -58,6 +70,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// CoordinateValidator is a validator for the "coordinate" field. It is called by the builders before save.
 	CoordinateValidator func(string) error
+	// DefaultCached holds the default value on creation for the "cached" field.
+	DefaultCached bool
 )
 
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
@@ -97,3 +111,18 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 func ByCoordinate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoordinate, opts...).ToFunc()
 }
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByCached orders the results by the cached field.
+func ByCached(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCached, opts...).ToFunc()
+}
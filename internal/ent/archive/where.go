@@ -70,6 +70,21 @@ func Coordinate(v string) predicate.Archive {
 	return predicate.Archive(sql.FieldEQ(FieldCoordinate, v))
 }
 
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldHash, v))
+}
+
+// Cached applies equality check predicate on the "cached" field. It's identical to CachedEQ.
+func Cached(v bool) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldCached, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Archive {
 	return predicate.Archive(sql.FieldEQ(FieldCreatedAt, v))
@@ -235,6 +250,151 @@ func CoordinateContainsFold(v string) predicate.Archive {
 	return predicate.Archive(sql.FieldContainsFold(FieldCoordinate, v))
 }
 
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// OriginIsNil applies the IsNil predicate on the "origin" field.
+func OriginIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldOrigin))
+}
+
+// OriginNotNil applies the NotNil predicate on the "origin" field.
+func OriginNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldOrigin))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashIsNil applies the IsNil predicate on the "hash" field.
+func HashIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldHash))
+}
+
+// HashNotNil applies the NotNil predicate on the "hash" field.
+func HashNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldHash))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldContainsFold(FieldHash, v))
+}
+
+// CachedEQ applies the EQ predicate on the "cached" field.
+func CachedEQ(v bool) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldCached, v))
+}
+
+// CachedNEQ applies the NEQ predicate on the "cached" field.
+func CachedNEQ(v bool) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldCached, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Archive) predicate.Archive {
 	return predicate.Archive(sql.AndPredicates(predicates...))
@@ -70,6 +70,54 @@ func (_c *ArchiveCreate) SetAssets(v []schema.AssetURL) *ArchiveCreate {
 	return _c
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (_c *ArchiveCreate) SetReleasedAt(v time.Time) *ArchiveCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableReleasedAt(v *time.Time) *ArchiveCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetOrigin sets the "origin" field.
+func (_c *ArchiveCreate) SetOrigin(v *schema.Origin) *ArchiveCreate {
+	_c.mutation.SetOrigin(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ArchiveCreate) SetHash(v string) *ArchiveCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableHash(v *string) *ArchiveCreate {
+	if v != nil {
+		_c.SetHash(*v)
+	}
+	return _c
+}
+
+// SetCached sets the "cached" field.
+func (_c *ArchiveCreate) SetCached(v bool) *ArchiveCreate {
+	_c.mutation.SetCached(v)
+	return _c
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableCached(v *bool) *ArchiveCreate {
+	if v != nil {
+		_c.SetCached(*v)
+	}
+	return _c
+}
+
 // Mutation returns the ArchiveMutation object of the builder.
 func (_c *ArchiveCreate) Mutation() *ArchiveMutation {
 	return _c.mutation
@@ -113,6 +161,10 @@ func (_c *ArchiveCreate) defaults() {
 		v := archive.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Cached(); !ok {
+		v := archive.DefaultCached
+		_c.mutation.SetCached(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -142,6 +194,9 @@ func (_c *ArchiveCreate) check() error {
 	if _, ok := _c.mutation.Assets(); !ok {
 		return &ValidationError{Name: "assets", err: errors.New(`ent: missing required field "Archive.assets"`)}
 	}
+	if _, ok := _c.mutation.Cached(); !ok {
+		return &ValidationError{Name: "cached", err: errors.New(`ent: missing required field "Archive.cached"`)}
+	}
 	return nil
 }
 
@@ -189,6 +244,22 @@ func (_c *ArchiveCreate) createSpec() (*Archive, *sqlgraph.CreateSpec) {
 		_spec.SetField(archive.FieldAssets, field.TypeJSON, value)
 		_node.Assets = value
 	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(archive.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := _c.mutation.Origin(); ok {
+		_spec.SetField(archive.FieldOrigin, field.TypeJSON, value)
+		_node.Origin = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(archive.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Cached(); ok {
+		_spec.SetField(archive.FieldCached, field.TypeBool, value)
+		_node.Cached = value
+	}
 	return _node, _spec
 }
 
@@ -289,6 +360,72 @@ func (u *ArchiveUpsert) UpdateAssets() *ArchiveUpsert {
 	return u
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (u *ArchiveUpsert) SetReleasedAt(v time.Time) *ArchiveUpsert {
+	u.Set(archive.FieldReleasedAt, v)
+	return u
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *ArchiveUpsert) UpdateReleasedAt() *ArchiveUpsert {
+	u.SetExcluded(archive.FieldReleasedAt)
+	return u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *ArchiveUpsert) ClearReleasedAt() *ArchiveUpsert {
+	u.SetNull(archive.FieldReleasedAt)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *ArchiveUpsert) SetOrigin(v *schema.Origin) *ArchiveUpsert {
+	u.Set(archive.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *ArchiveUpsert) UpdateOrigin() *ArchiveUpsert {
+	u.SetExcluded(archive.FieldOrigin)
+	return u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (u *ArchiveUpsert) ClearOrigin() *ArchiveUpsert {
+	u.SetNull(archive.FieldOrigin)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *ArchiveUpsert) SetHash(v string) *ArchiveUpsert {
+	u.Set(archive.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ArchiveUpsert) UpdateHash() *ArchiveUpsert {
+	u.SetExcluded(archive.FieldHash)
+	return u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *ArchiveUpsert) ClearHash() *ArchiveUpsert {
+	u.SetNull(archive.FieldHash)
+	return u
+}
+
+// SetCached sets the "cached" field.
+func (u *ArchiveUpsert) SetCached(v bool) *ArchiveUpsert {
+	u.Set(archive.FieldCached, v)
+	return u
+}
+
+// UpdateCached sets the "cached" field to the value that was provided on create.
+func (u *ArchiveUpsert) UpdateCached() *ArchiveUpsert {
+	u.SetExcluded(archive.FieldCached)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -390,6 +527,83 @@ func (u *ArchiveUpsertOne) UpdateAssets() *ArchiveUpsertOne {
 	})
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (u *ArchiveUpsertOne) SetReleasedAt(v time.Time) *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *ArchiveUpsertOne) UpdateReleasedAt() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *ArchiveUpsertOne) ClearReleasedAt() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *ArchiveUpsertOne) SetOrigin(v *schema.Origin) *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *ArchiveUpsertOne) UpdateOrigin() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (u *ArchiveUpsertOne) ClearOrigin() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.ClearOrigin()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *ArchiveUpsertOne) SetHash(v string) *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ArchiveUpsertOne) UpdateHash() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *ArchiveUpsertOne) ClearHash() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.ClearHash()
+	})
+}
+
+// SetCached sets the "cached" field.
+func (u *ArchiveUpsertOne) SetCached(v bool) *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetCached(v)
+	})
+}
+
+// UpdateCached sets the "cached" field to the value that was provided on create.
+func (u *ArchiveUpsertOne) UpdateCached() *ArchiveUpsertOne {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateCached()
+	})
+}
+
 // Exec executes the query.
 func (u *ArchiveUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -657,6 +871,83 @@ func (u *ArchiveUpsertBulk) UpdateAssets() *ArchiveUpsertBulk {
 	})
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (u *ArchiveUpsertBulk) SetReleasedAt(v time.Time) *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *ArchiveUpsertBulk) UpdateReleasedAt() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *ArchiveUpsertBulk) ClearReleasedAt() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *ArchiveUpsertBulk) SetOrigin(v *schema.Origin) *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *ArchiveUpsertBulk) UpdateOrigin() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (u *ArchiveUpsertBulk) ClearOrigin() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.ClearOrigin()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *ArchiveUpsertBulk) SetHash(v string) *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ArchiveUpsertBulk) UpdateHash() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *ArchiveUpsertBulk) ClearHash() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.ClearHash()
+	})
+}
+
+// SetCached sets the "cached" field.
+func (u *ArchiveUpsertBulk) SetCached(v bool) *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.SetCached(v)
+	})
+}
+
+// UpdateCached sets the "cached" field to the value that was provided on create.
+func (u *ArchiveUpsertBulk) UpdateCached() *ArchiveUpsertBulk {
+	return u.Update(func(s *ArchiveUpsert) {
+		s.UpdateCached()
+	})
+}
+
 // Exec executes the query.
 func (u *ArchiveUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -77,6 +77,72 @@ func (_u *ArchiveUpdate) AppendAssets(v []schema.AssetURL) *ArchiveUpdate {
 	return _u
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (_u *ArchiveUpdate) SetReleasedAt(v time.Time) *ArchiveUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableReleasedAt(v *time.Time) *ArchiveUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *ArchiveUpdate) ClearReleasedAt() *ArchiveUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetOrigin sets the "origin" field.
+func (_u *ArchiveUpdate) SetOrigin(v *schema.Origin) *ArchiveUpdate {
+	_u.mutation.SetOrigin(v)
+	return _u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (_u *ArchiveUpdate) ClearOrigin() *ArchiveUpdate {
+	_u.mutation.ClearOrigin()
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ArchiveUpdate) SetHash(v string) *ArchiveUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableHash(v *string) *ArchiveUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *ArchiveUpdate) ClearHash() *ArchiveUpdate {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// SetCached sets the "cached" field.
+func (_u *ArchiveUpdate) SetCached(v bool) *ArchiveUpdate {
+	_u.mutation.SetCached(v)
+	return _u
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableCached(v *bool) *ArchiveUpdate {
+	if v != nil {
+		_u.SetCached(*v)
+	}
+	return _u
+}
+
 // Mutation returns the ArchiveMutation object of the builder.
 func (_u *ArchiveUpdate) Mutation() *ArchiveMutation {
 	return _u.mutation
@@ -162,6 +228,27 @@ func (_u *ArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, archive.FieldAssets, value)
 		})
 	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(archive.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(archive.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Origin(); ok {
+		_spec.SetField(archive.FieldOrigin, field.TypeJSON, value)
+	}
+	if _u.mutation.OriginCleared() {
+		_spec.ClearField(archive.FieldOrigin, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(archive.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(archive.FieldHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cached(); ok {
+		_spec.SetField(archive.FieldCached, field.TypeBool, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{archive.Label}
@@ -228,6 +315,72 @@ func (_u *ArchiveUpdateOne) AppendAssets(v []schema.AssetURL) *ArchiveUpdateOne
 	return _u
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (_u *ArchiveUpdateOne) SetReleasedAt(v time.Time) *ArchiveUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableReleasedAt(v *time.Time) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *ArchiveUpdateOne) ClearReleasedAt() *ArchiveUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetOrigin sets the "origin" field.
+func (_u *ArchiveUpdateOne) SetOrigin(v *schema.Origin) *ArchiveUpdateOne {
+	_u.mutation.SetOrigin(v)
+	return _u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (_u *ArchiveUpdateOne) ClearOrigin() *ArchiveUpdateOne {
+	_u.mutation.ClearOrigin()
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ArchiveUpdateOne) SetHash(v string) *ArchiveUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableHash(v *string) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *ArchiveUpdateOne) ClearHash() *ArchiveUpdateOne {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// SetCached sets the "cached" field.
+func (_u *ArchiveUpdateOne) SetCached(v bool) *ArchiveUpdateOne {
+	_u.mutation.SetCached(v)
+	return _u
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableCached(v *bool) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetCached(*v)
+	}
+	return _u
+}
+
 // Mutation returns the ArchiveMutation object of the builder.
 func (_u *ArchiveUpdateOne) Mutation() *ArchiveMutation {
 	return _u.mutation
@@ -343,6 +496,27 @@ func (_u *ArchiveUpdateOne) sqlSave(ctx context.Context) (_node *Archive, err er
 			sqljson.Append(u, archive.FieldAssets, value)
 		})
 	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(archive.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(archive.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Origin(); ok {
+		_spec.SetField(archive.FieldOrigin, field.TypeJSON, value)
+	}
+	if _u.mutation.OriginCleared() {
+		_spec.ClearField(archive.FieldOrigin, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(archive.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(archive.FieldHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cached(); ok {
+		_spec.SetField(archive.FieldCached, field.TypeBool, value)
+	}
 	_node = &Archive{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
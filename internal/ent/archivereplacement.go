@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
+)
+
+// ArchiveReplacement is the model entity for the ArchiveReplacement schema.
+type ArchiveReplacement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// When this object was initially created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The last time this object was modified
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Coordinate holds the value of the "coordinate" field.
+	Coordinate string `json:"coordinate,omitempty"`
+	// The hash of the replaced package, empty when it wasn't known
+	PreviousHash string `json:"previous_hash,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// The name of the token used to replace the archive
+	Principal string `json:"principal,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason       string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchiveReplacement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archivereplacement.FieldID:
+			values[i] = new(sql.NullInt64)
+		case archivereplacement.FieldCoordinate, archivereplacement.FieldPreviousHash, archivereplacement.FieldHash, archivereplacement.FieldPrincipal, archivereplacement.FieldReason:
+			values[i] = new(sql.NullString)
+		case archivereplacement.FieldCreatedAt, archivereplacement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchiveReplacement fields.
+func (_m *ArchiveReplacement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archivereplacement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case archivereplacement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case archivereplacement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case archivereplacement.FieldCoordinate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coordinate", values[i])
+			} else if value.Valid {
+				_m.Coordinate = value.String
+			}
+		case archivereplacement.FieldPreviousHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_hash", values[i])
+			} else if value.Valid {
+				_m.PreviousHash = value.String
+			}
+		case archivereplacement.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case archivereplacement.FieldPrincipal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field principal", values[i])
+			} else if value.Valid {
+				_m.Principal = value.String
+			}
+		case archivereplacement.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchiveReplacement.
+// This includes values selected through modifiers, order, etc.
+func (_m *ArchiveReplacement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ArchiveReplacement.
+// Note that you need to call ArchiveReplacement.Unwrap() before calling this method if this ArchiveReplacement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ArchiveReplacement) Update() *ArchiveReplacementUpdateOne {
+	return NewArchiveReplacementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ArchiveReplacement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ArchiveReplacement) Unwrap() *ArchiveReplacement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArchiveReplacement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ArchiveReplacement) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchiveReplacement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("coordinate=")
+	builder.WriteString(_m.Coordinate)
+	builder.WriteString(", ")
+	builder.WriteString("previous_hash=")
+	builder.WriteString(_m.PreviousHash)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("principal=")
+	builder.WriteString(_m.Principal)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchiveReplacements is a parsable slice of ArchiveReplacement.
+type ArchiveReplacements []*ArchiveReplacement
@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivereplacement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Coordinate applies equality check predicate on the "coordinate" field. It's identical to CoordinateEQ.
+func Coordinate(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldCoordinate, v))
+}
+
+// PreviousHash applies equality check predicate on the "previous_hash" field. It's identical to PreviousHashEQ.
+func PreviousHash(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldPreviousHash, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldHash, v))
+}
+
+// Principal applies equality check predicate on the "principal" field. It's identical to PrincipalEQ.
+func Principal(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CoordinateEQ applies the EQ predicate on the "coordinate" field.
+func CoordinateEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldCoordinate, v))
+}
+
+// CoordinateNEQ applies the NEQ predicate on the "coordinate" field.
+func CoordinateNEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldCoordinate, v))
+}
+
+// CoordinateIn applies the In predicate on the "coordinate" field.
+func CoordinateIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldCoordinate, vs...))
+}
+
+// CoordinateNotIn applies the NotIn predicate on the "coordinate" field.
+func CoordinateNotIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldCoordinate, vs...))
+}
+
+// CoordinateGT applies the GT predicate on the "coordinate" field.
+func CoordinateGT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldCoordinate, v))
+}
+
+// CoordinateGTE applies the GTE predicate on the "coordinate" field.
+func CoordinateGTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldCoordinate, v))
+}
+
+// CoordinateLT applies the LT predicate on the "coordinate" field.
+func CoordinateLT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldCoordinate, v))
+}
+
+// CoordinateLTE applies the LTE predicate on the "coordinate" field.
+func CoordinateLTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldCoordinate, v))
+}
+
+// CoordinateContains applies the Contains predicate on the "coordinate" field.
+func CoordinateContains(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContains(FieldCoordinate, v))
+}
+
+// CoordinateHasPrefix applies the HasPrefix predicate on the "coordinate" field.
+func CoordinateHasPrefix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasPrefix(FieldCoordinate, v))
+}
+
+// CoordinateHasSuffix applies the HasSuffix predicate on the "coordinate" field.
+func CoordinateHasSuffix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasSuffix(FieldCoordinate, v))
+}
+
+// CoordinateEqualFold applies the EqualFold predicate on the "coordinate" field.
+func CoordinateEqualFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEqualFold(FieldCoordinate, v))
+}
+
+// CoordinateContainsFold applies the ContainsFold predicate on the "coordinate" field.
+func CoordinateContainsFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContainsFold(FieldCoordinate, v))
+}
+
+// PreviousHashEQ applies the EQ predicate on the "previous_hash" field.
+func PreviousHashEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldPreviousHash, v))
+}
+
+// PreviousHashNEQ applies the NEQ predicate on the "previous_hash" field.
+func PreviousHashNEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldPreviousHash, v))
+}
+
+// PreviousHashIn applies the In predicate on the "previous_hash" field.
+func PreviousHashIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldPreviousHash, vs...))
+}
+
+// PreviousHashNotIn applies the NotIn predicate on the "previous_hash" field.
+func PreviousHashNotIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldPreviousHash, vs...))
+}
+
+// PreviousHashGT applies the GT predicate on the "previous_hash" field.
+func PreviousHashGT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldPreviousHash, v))
+}
+
+// PreviousHashGTE applies the GTE predicate on the "previous_hash" field.
+func PreviousHashGTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldPreviousHash, v))
+}
+
+// PreviousHashLT applies the LT predicate on the "previous_hash" field.
+func PreviousHashLT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldPreviousHash, v))
+}
+
+// PreviousHashLTE applies the LTE predicate on the "previous_hash" field.
+func PreviousHashLTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldPreviousHash, v))
+}
+
+// PreviousHashContains applies the Contains predicate on the "previous_hash" field.
+func PreviousHashContains(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContains(FieldPreviousHash, v))
+}
+
+// PreviousHashHasPrefix applies the HasPrefix predicate on the "previous_hash" field.
+func PreviousHashHasPrefix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasPrefix(FieldPreviousHash, v))
+}
+
+// PreviousHashHasSuffix applies the HasSuffix predicate on the "previous_hash" field.
+func PreviousHashHasSuffix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasSuffix(FieldPreviousHash, v))
+}
+
+// PreviousHashEqualFold applies the EqualFold predicate on the "previous_hash" field.
+func PreviousHashEqualFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEqualFold(FieldPreviousHash, v))
+}
+
+// PreviousHashContainsFold applies the ContainsFold predicate on the "previous_hash" field.
+func PreviousHashContainsFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContainsFold(FieldPreviousHash, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContainsFold(FieldHash, v))
+}
+
+// PrincipalEQ applies the EQ predicate on the "principal" field.
+func PrincipalEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// PrincipalNEQ applies the NEQ predicate on the "principal" field.
+func PrincipalNEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldPrincipal, v))
+}
+
+// PrincipalIn applies the In predicate on the "principal" field.
+func PrincipalIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldPrincipal, vs...))
+}
+
+// PrincipalNotIn applies the NotIn predicate on the "principal" field.
+func PrincipalNotIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldPrincipal, vs...))
+}
+
+// PrincipalGT applies the GT predicate on the "principal" field.
+func PrincipalGT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldPrincipal, v))
+}
+
+// PrincipalGTE applies the GTE predicate on the "principal" field.
+func PrincipalGTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldPrincipal, v))
+}
+
+// PrincipalLT applies the LT predicate on the "principal" field.
+func PrincipalLT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldPrincipal, v))
+}
+
+// PrincipalLTE applies the LTE predicate on the "principal" field.
+func PrincipalLTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldPrincipal, v))
+}
+
+// PrincipalContains applies the Contains predicate on the "principal" field.
+func PrincipalContains(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContains(FieldPrincipal, v))
+}
+
+// PrincipalHasPrefix applies the HasPrefix predicate on the "principal" field.
+func PrincipalHasPrefix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasPrefix(FieldPrincipal, v))
+}
+
+// PrincipalHasSuffix applies the HasSuffix predicate on the "principal" field.
+func PrincipalHasSuffix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasSuffix(FieldPrincipal, v))
+}
+
+// PrincipalEqualFold applies the EqualFold predicate on the "principal" field.
+func PrincipalEqualFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEqualFold(FieldPrincipal, v))
+}
+
+// PrincipalContainsFold applies the ContainsFold predicate on the "principal" field.
+func PrincipalContainsFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContainsFold(FieldPrincipal, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchiveReplacement) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchiveReplacement) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchiveReplacement) predicate.ArchiveReplacement {
+	return predicate.ArchiveReplacement(sql.NotPredicates(p))
+}
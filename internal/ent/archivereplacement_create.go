@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
+)
+
+// ArchiveReplacementCreate is the builder for creating a ArchiveReplacement entity.
+type ArchiveReplacementCreate struct {
+	config
+	mutation *ArchiveReplacementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ArchiveReplacementCreate) SetCreatedAt(v time.Time) *ArchiveReplacementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ArchiveReplacementCreate) SetNillableCreatedAt(v *time.Time) *ArchiveReplacementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ArchiveReplacementCreate) SetUpdatedAt(v time.Time) *ArchiveReplacementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ArchiveReplacementCreate) SetNillableUpdatedAt(v *time.Time) *ArchiveReplacementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCoordinate sets the "coordinate" field.
+func (_c *ArchiveReplacementCreate) SetCoordinate(v string) *ArchiveReplacementCreate {
+	_c.mutation.SetCoordinate(v)
+	return _c
+}
+
+// SetPreviousHash sets the "previous_hash" field.
+func (_c *ArchiveReplacementCreate) SetPreviousHash(v string) *ArchiveReplacementCreate {
+	_c.mutation.SetPreviousHash(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ArchiveReplacementCreate) SetHash(v string) *ArchiveReplacementCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetPrincipal sets the "principal" field.
+func (_c *ArchiveReplacementCreate) SetPrincipal(v string) *ArchiveReplacementCreate {
+	_c.mutation.SetPrincipal(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ArchiveReplacementCreate) SetReason(v string) *ArchiveReplacementCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// Mutation returns the ArchiveReplacementMutation object of the builder.
+func (_c *ArchiveReplacementCreate) Mutation() *ArchiveReplacementMutation {
+	return _c.mutation
+}
+
+// Save creates the ArchiveReplacement in the database.
+func (_c *ArchiveReplacementCreate) Save(ctx context.Context) (*ArchiveReplacement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ArchiveReplacementCreate) SaveX(ctx context.Context) *ArchiveReplacement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchiveReplacementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchiveReplacementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ArchiveReplacementCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := archivereplacement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := archivereplacement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ArchiveReplacementCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArchiveReplacement.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ArchiveReplacement.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Coordinate(); !ok {
+		return &ValidationError{Name: "coordinate", err: errors.New(`ent: missing required field "ArchiveReplacement.coordinate"`)}
+	}
+	if v, ok := _c.mutation.Coordinate(); ok {
+		if err := archivereplacement.CoordinateValidator(v); err != nil {
+			return &ValidationError{Name: "coordinate", err: fmt.Errorf(`ent: validator failed for field "ArchiveReplacement.coordinate": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviousHash(); !ok {
+		return &ValidationError{Name: "previous_hash", err: errors.New(`ent: missing required field "ArchiveReplacement.previous_hash"`)}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ArchiveReplacement.hash"`)}
+	}
+	if _, ok := _c.mutation.Principal(); !ok {
+		return &ValidationError{Name: "principal", err: errors.New(`ent: missing required field "ArchiveReplacement.principal"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ArchiveReplacement.reason"`)}
+	}
+	return nil
+}
+
+func (_c *ArchiveReplacementCreate) sqlSave(ctx context.Context) (*ArchiveReplacement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ArchiveReplacementCreate) createSpec() (*ArchiveReplacement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchiveReplacement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(archivereplacement.Table, sqlgraph.NewFieldSpec(archivereplacement.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(archivereplacement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(archivereplacement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Coordinate(); ok {
+		_spec.SetField(archivereplacement.FieldCoordinate, field.TypeString, value)
+		_node.Coordinate = value
+	}
+	if value, ok := _c.mutation.PreviousHash(); ok {
+		_spec.SetField(archivereplacement.FieldPreviousHash, field.TypeString, value)
+		_node.PreviousHash = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(archivereplacement.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Principal(); ok {
+		_spec.SetField(archivereplacement.FieldPrincipal, field.TypeString, value)
+		_node.Principal = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(archivereplacement.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArchiveReplacement.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArchiveReplacementUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ArchiveReplacementCreate) OnConflict(opts ...sql.ConflictOption) *ArchiveReplacementUpsertOne {
+	_c.conflict = opts
+	return &ArchiveReplacementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArchiveReplacement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ArchiveReplacementCreate) OnConflictColumns(columns ...string) *ArchiveReplacementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ArchiveReplacementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ArchiveReplacementUpsertOne is the builder for "upsert"-ing
+	//  one ArchiveReplacement node.
+	ArchiveReplacementUpsertOne struct {
+		create *ArchiveReplacementCreate
+	}
+
+	// ArchiveReplacementUpsert is the "OnConflict" setter.
+	ArchiveReplacementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArchiveReplacementUpsert) SetUpdatedAt(v time.Time) *ArchiveReplacementUpsert {
+	u.Set(archivereplacement.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArchiveReplacementUpsert) UpdateUpdatedAt() *ArchiveReplacementUpsert {
+	u.SetExcluded(archivereplacement.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ArchiveReplacement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArchiveReplacementUpsertOne) UpdateNewValues() *ArchiveReplacementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(archivereplacement.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Coordinate(); exists {
+			s.SetIgnore(archivereplacement.FieldCoordinate)
+		}
+		if _, exists := u.create.mutation.PreviousHash(); exists {
+			s.SetIgnore(archivereplacement.FieldPreviousHash)
+		}
+		if _, exists := u.create.mutation.Hash(); exists {
+			s.SetIgnore(archivereplacement.FieldHash)
+		}
+		if _, exists := u.create.mutation.Principal(); exists {
+			s.SetIgnore(archivereplacement.FieldPrincipal)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(archivereplacement.FieldReason)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArchiveReplacement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArchiveReplacementUpsertOne) Ignore() *ArchiveReplacementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArchiveReplacementUpsertOne) DoNothing() *ArchiveReplacementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArchiveReplacementCreate.OnConflict
+// documentation for more info.
+func (u *ArchiveReplacementUpsertOne) Update(set func(*ArchiveReplacementUpsert)) *ArchiveReplacementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArchiveReplacementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArchiveReplacementUpsertOne) SetUpdatedAt(v time.Time) *ArchiveReplacementUpsertOne {
+	return u.Update(func(s *ArchiveReplacementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArchiveReplacementUpsertOne) UpdateUpdatedAt() *ArchiveReplacementUpsertOne {
+	return u.Update(func(s *ArchiveReplacementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArchiveReplacementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArchiveReplacementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArchiveReplacementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArchiveReplacementUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArchiveReplacementUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ArchiveReplacementCreateBulk is the builder for creating many ArchiveReplacement entities in bulk.
+type ArchiveReplacementCreateBulk struct {
+	config
+	err      error
+	builders []*ArchiveReplacementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ArchiveReplacement entities in the database.
+func (_c *ArchiveReplacementCreateBulk) Save(ctx context.Context) ([]*ArchiveReplacement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ArchiveReplacement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchiveReplacementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ArchiveReplacementCreateBulk) SaveX(ctx context.Context) []*ArchiveReplacement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchiveReplacementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchiveReplacementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArchiveReplacement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArchiveReplacementUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ArchiveReplacementCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArchiveReplacementUpsertBulk {
+	_c.conflict = opts
+	return &ArchiveReplacementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArchiveReplacement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ArchiveReplacementCreateBulk) OnConflictColumns(columns ...string) *ArchiveReplacementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ArchiveReplacementUpsertBulk{
+		create: _c,
+	}
+}
+
+// ArchiveReplacementUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArchiveReplacement nodes.
+type ArchiveReplacementUpsertBulk struct {
+	create *ArchiveReplacementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArchiveReplacement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArchiveReplacementUpsertBulk) UpdateNewValues() *ArchiveReplacementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(archivereplacement.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Coordinate(); exists {
+				s.SetIgnore(archivereplacement.FieldCoordinate)
+			}
+			if _, exists := b.mutation.PreviousHash(); exists {
+				s.SetIgnore(archivereplacement.FieldPreviousHash)
+			}
+			if _, exists := b.mutation.Hash(); exists {
+				s.SetIgnore(archivereplacement.FieldHash)
+			}
+			if _, exists := b.mutation.Principal(); exists {
+				s.SetIgnore(archivereplacement.FieldPrincipal)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(archivereplacement.FieldReason)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArchiveReplacement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArchiveReplacementUpsertBulk) Ignore() *ArchiveReplacementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArchiveReplacementUpsertBulk) DoNothing() *ArchiveReplacementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArchiveReplacementCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArchiveReplacementUpsertBulk) Update(set func(*ArchiveReplacementUpsert)) *ArchiveReplacementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArchiveReplacementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArchiveReplacementUpsertBulk) SetUpdatedAt(v time.Time) *ArchiveReplacementUpsertBulk {
+	return u.Update(func(s *ArchiveReplacementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArchiveReplacementUpsertBulk) UpdateUpdatedAt() *ArchiveReplacementUpsertBulk {
+	return u.Update(func(s *ArchiveReplacementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArchiveReplacementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArchiveReplacementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArchiveReplacementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArchiveReplacementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
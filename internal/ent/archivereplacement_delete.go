@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ArchiveReplacementDelete is the builder for deleting a ArchiveReplacement entity.
+type ArchiveReplacementDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveReplacementMutation
+}
+
+// Where appends a list predicates to the ArchiveReplacementDelete builder.
+func (_d *ArchiveReplacementDelete) Where(ps ...predicate.ArchiveReplacement) *ArchiveReplacementDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ArchiveReplacementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ArchiveReplacementDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ArchiveReplacementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(archivereplacement.Table, sqlgraph.NewFieldSpec(archivereplacement.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ArchiveReplacementDeleteOne is the builder for deleting a single ArchiveReplacement entity.
+type ArchiveReplacementDeleteOne struct {
+	_d *ArchiveReplacementDelete
+}
+
+// Where appends a list predicates to the ArchiveReplacementDelete builder.
+func (_d *ArchiveReplacementDeleteOne) Where(ps ...predicate.ArchiveReplacement) *ArchiveReplacementDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ArchiveReplacementDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{archivereplacement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ArchiveReplacementDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
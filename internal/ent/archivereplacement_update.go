@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ArchiveReplacementUpdate is the builder for updating ArchiveReplacement entities.
+type ArchiveReplacementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveReplacementMutation
+}
+
+// Where appends a list predicates to the ArchiveReplacementUpdate builder.
+func (_u *ArchiveReplacementUpdate) Where(ps ...predicate.ArchiveReplacement) *ArchiveReplacementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ArchiveReplacementUpdate) SetUpdatedAt(v time.Time) *ArchiveReplacementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ArchiveReplacementMutation object of the builder.
+func (_u *ArchiveReplacementUpdate) Mutation() *ArchiveReplacementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ArchiveReplacementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchiveReplacementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ArchiveReplacementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchiveReplacementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ArchiveReplacementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := archivereplacement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ArchiveReplacementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(archivereplacement.Table, archivereplacement.Columns, sqlgraph.NewFieldSpec(archivereplacement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(archivereplacement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivereplacement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ArchiveReplacementUpdateOne is the builder for updating a single ArchiveReplacement entity.
+type ArchiveReplacementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchiveReplacementMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ArchiveReplacementUpdateOne) SetUpdatedAt(v time.Time) *ArchiveReplacementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ArchiveReplacementMutation object of the builder.
+func (_u *ArchiveReplacementUpdateOne) Mutation() *ArchiveReplacementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ArchiveReplacementUpdate builder.
+func (_u *ArchiveReplacementUpdateOne) Where(ps ...predicate.ArchiveReplacement) *ArchiveReplacementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ArchiveReplacementUpdateOne) Select(field string, fields ...string) *ArchiveReplacementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ArchiveReplacement entity.
+func (_u *ArchiveReplacementUpdateOne) Save(ctx context.Context) (*ArchiveReplacement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchiveReplacementUpdateOne) SaveX(ctx context.Context) *ArchiveReplacement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ArchiveReplacementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchiveReplacementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ArchiveReplacementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := archivereplacement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ArchiveReplacementUpdateOne) sqlSave(ctx context.Context) (_node *ArchiveReplacement, err error) {
+	_spec := sqlgraph.NewUpdateSpec(archivereplacement.Table, archivereplacement.Columns, sqlgraph.NewFieldSpec(archivereplacement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArchiveReplacement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivereplacement.FieldID)
+		for _, f := range fields {
+			if !archivereplacement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archivereplacement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(archivereplacement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ArchiveReplacement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivereplacement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
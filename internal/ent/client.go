@@ -16,10 +16,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
 	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 )
 
 // Client is the client that holds all ent builders.
@@ -29,14 +35,26 @@ type Client struct {
 	Schema *migrate.Schema
 	// Archive is the client for interacting with the Archive builders.
 	Archive *ArchiveClient
+	// ArchiveReplacement is the client for interacting with the ArchiveReplacement builders.
+	ArchiveReplacement *ArchiveReplacementClient
 	// Asset is the client for interacting with the Asset builders.
 	Asset *AssetClient
+	// Deprecation is the client for interacting with the Deprecation builders.
+	Deprecation *DeprecationClient
+	// Retraction is the client for interacting with the Retraction builders.
+	Retraction *RetractionClient
+	// SumDBCosignature is the client for interacting with the SumDBCosignature builders.
+	SumDBCosignature *SumDBCosignatureClient
 	// SumDBHash is the client for interacting with the SumDBHash builders.
 	SumDBHash *SumDBHashClient
+	// SumDBKey is the client for interacting with the SumDBKey builders.
+	SumDBKey *SumDBKeyClient
 	// SumDBRecord is the client for interacting with the SumDBRecord builders.
 	SumDBRecord *SumDBRecordClient
 	// SumDBTree is the client for interacting with the SumDBTree builders.
 	SumDBTree *SumDBTreeClient
+	// SumDBTreeHead is the client for interacting with the SumDBTreeHead builders.
+	SumDBTreeHead *SumDBTreeHeadClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -49,10 +67,16 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Archive = NewArchiveClient(c.config)
+	c.ArchiveReplacement = NewArchiveReplacementClient(c.config)
 	c.Asset = NewAssetClient(c.config)
+	c.Deprecation = NewDeprecationClient(c.config)
+	c.Retraction = NewRetractionClient(c.config)
+	c.SumDBCosignature = NewSumDBCosignatureClient(c.config)
 	c.SumDBHash = NewSumDBHashClient(c.config)
+	c.SumDBKey = NewSumDBKeyClient(c.config)
 	c.SumDBRecord = NewSumDBRecordClient(c.config)
 	c.SumDBTree = NewSumDBTreeClient(c.config)
+	c.SumDBTreeHead = NewSumDBTreeHeadClient(c.config)
 }
 
 type (
@@ -143,13 +167,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Archive:     NewArchiveClient(cfg),
-		Asset:       NewAssetClient(cfg),
-		SumDBHash:   NewSumDBHashClient(cfg),
-		SumDBRecord: NewSumDBRecordClient(cfg),
-		SumDBTree:   NewSumDBTreeClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Archive:            NewArchiveClient(cfg),
+		ArchiveReplacement: NewArchiveReplacementClient(cfg),
+		Asset:              NewAssetClient(cfg),
+		Deprecation:        NewDeprecationClient(cfg),
+		Retraction:         NewRetractionClient(cfg),
+		SumDBCosignature:   NewSumDBCosignatureClient(cfg),
+		SumDBHash:          NewSumDBHashClient(cfg),
+		SumDBKey:           NewSumDBKeyClient(cfg),
+		SumDBRecord:        NewSumDBRecordClient(cfg),
+		SumDBTree:          NewSumDBTreeClient(cfg),
+		SumDBTreeHead:      NewSumDBTreeHeadClient(cfg),
 	}, nil
 }
 
@@ -167,13 +197,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Archive:     NewArchiveClient(cfg),
-		Asset:       NewAssetClient(cfg),
-		SumDBHash:   NewSumDBHashClient(cfg),
-		SumDBRecord: NewSumDBRecordClient(cfg),
-		SumDBTree:   NewSumDBTreeClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Archive:            NewArchiveClient(cfg),
+		ArchiveReplacement: NewArchiveReplacementClient(cfg),
+		Asset:              NewAssetClient(cfg),
+		Deprecation:        NewDeprecationClient(cfg),
+		Retraction:         NewRetractionClient(cfg),
+		SumDBCosignature:   NewSumDBCosignatureClient(cfg),
+		SumDBHash:          NewSumDBHashClient(cfg),
+		SumDBKey:           NewSumDBKeyClient(cfg),
+		SumDBRecord:        NewSumDBRecordClient(cfg),
+		SumDBTree:          NewSumDBTreeClient(cfg),
+		SumDBTreeHead:      NewSumDBTreeHeadClient(cfg),
 	}, nil
 }
 
@@ -202,21 +238,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Archive.Use(hooks...)
-	c.Asset.Use(hooks...)
-	c.SumDBHash.Use(hooks...)
-	c.SumDBRecord.Use(hooks...)
-	c.SumDBTree.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Archive, c.ArchiveReplacement, c.Asset, c.Deprecation, c.Retraction,
+		c.SumDBCosignature, c.SumDBHash, c.SumDBKey, c.SumDBRecord, c.SumDBTree,
+		c.SumDBTreeHead,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Archive.Intercept(interceptors...)
-	c.Asset.Intercept(interceptors...)
-	c.SumDBHash.Intercept(interceptors...)
-	c.SumDBRecord.Intercept(interceptors...)
-	c.SumDBTree.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Archive, c.ArchiveReplacement, c.Asset, c.Deprecation, c.Retraction,
+		c.SumDBCosignature, c.SumDBHash, c.SumDBKey, c.SumDBRecord, c.SumDBTree,
+		c.SumDBTreeHead,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -224,14 +264,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ArchiveMutation:
 		return c.Archive.mutate(ctx, m)
+	case *ArchiveReplacementMutation:
+		return c.ArchiveReplacement.mutate(ctx, m)
 	case *AssetMutation:
 		return c.Asset.mutate(ctx, m)
+	case *DeprecationMutation:
+		return c.Deprecation.mutate(ctx, m)
+	case *RetractionMutation:
+		return c.Retraction.mutate(ctx, m)
+	case *SumDBCosignatureMutation:
+		return c.SumDBCosignature.mutate(ctx, m)
 	case *SumDBHashMutation:
 		return c.SumDBHash.mutate(ctx, m)
+	case *SumDBKeyMutation:
+		return c.SumDBKey.mutate(ctx, m)
 	case *SumDBRecordMutation:
 		return c.SumDBRecord.mutate(ctx, m)
 	case *SumDBTreeMutation:
 		return c.SumDBTree.mutate(ctx, m)
+	case *SumDBTreeHeadMutation:
+		return c.SumDBTreeHead.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -370,6 +422,139 @@ func (c *ArchiveClient) mutate(ctx context.Context, m *ArchiveMutation) (Value,
 	}
 }
 
+// ArchiveReplacementClient is a client for the ArchiveReplacement schema.
+type ArchiveReplacementClient struct {
+	config
+}
+
+// NewArchiveReplacementClient returns a client for the ArchiveReplacement from the given config.
+func NewArchiveReplacementClient(c config) *ArchiveReplacementClient {
+	return &ArchiveReplacementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archivereplacement.Hooks(f(g(h())))`.
+func (c *ArchiveReplacementClient) Use(hooks ...Hook) {
+	c.hooks.ArchiveReplacement = append(c.hooks.ArchiveReplacement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archivereplacement.Intercept(f(g(h())))`.
+func (c *ArchiveReplacementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchiveReplacement = append(c.inters.ArchiveReplacement, interceptors...)
+}
+
+// Create returns a builder for creating a ArchiveReplacement entity.
+func (c *ArchiveReplacementClient) Create() *ArchiveReplacementCreate {
+	mutation := newArchiveReplacementMutation(c.config, OpCreate)
+	return &ArchiveReplacementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArchiveReplacement entities.
+func (c *ArchiveReplacementClient) CreateBulk(builders ...*ArchiveReplacementCreate) *ArchiveReplacementCreateBulk {
+	return &ArchiveReplacementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchiveReplacementClient) MapCreateBulk(slice any, setFunc func(*ArchiveReplacementCreate, int)) *ArchiveReplacementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchiveReplacementCreateBulk{err: fmt.Errorf("calling to ArchiveReplacementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchiveReplacementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchiveReplacementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArchiveReplacement.
+func (c *ArchiveReplacementClient) Update() *ArchiveReplacementUpdate {
+	mutation := newArchiveReplacementMutation(c.config, OpUpdate)
+	return &ArchiveReplacementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchiveReplacementClient) UpdateOne(_m *ArchiveReplacement) *ArchiveReplacementUpdateOne {
+	mutation := newArchiveReplacementMutation(c.config, OpUpdateOne, withArchiveReplacement(_m))
+	return &ArchiveReplacementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchiveReplacementClient) UpdateOneID(id int) *ArchiveReplacementUpdateOne {
+	mutation := newArchiveReplacementMutation(c.config, OpUpdateOne, withArchiveReplacementID(id))
+	return &ArchiveReplacementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArchiveReplacement.
+func (c *ArchiveReplacementClient) Delete() *ArchiveReplacementDelete {
+	mutation := newArchiveReplacementMutation(c.config, OpDelete)
+	return &ArchiveReplacementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchiveReplacementClient) DeleteOne(_m *ArchiveReplacement) *ArchiveReplacementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchiveReplacementClient) DeleteOneID(id int) *ArchiveReplacementDeleteOne {
+	builder := c.Delete().Where(archivereplacement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchiveReplacementDeleteOne{builder}
+}
+
+// Query returns a query builder for ArchiveReplacement.
+func (c *ArchiveReplacementClient) Query() *ArchiveReplacementQuery {
+	return &ArchiveReplacementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchiveReplacement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArchiveReplacement entity by its id.
+func (c *ArchiveReplacementClient) Get(ctx context.Context, id int) (*ArchiveReplacement, error) {
+	return c.Query().Where(archivereplacement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchiveReplacementClient) GetX(ctx context.Context, id int) *ArchiveReplacement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ArchiveReplacementClient) Hooks() []Hook {
+	return c.hooks.ArchiveReplacement
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchiveReplacementClient) Interceptors() []Interceptor {
+	return c.inters.ArchiveReplacement
+}
+
+func (c *ArchiveReplacementClient) mutate(ctx context.Context, m *ArchiveReplacementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchiveReplacementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchiveReplacementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchiveReplacementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchiveReplacementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArchiveReplacement mutation op: %q", m.Op())
+	}
+}
+
 // AssetClient is a client for the Asset schema.
 type AssetClient struct {
 	config
@@ -398,79 +583,494 @@ func (c *AssetClient) Create() *AssetCreate {
 	return &AssetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Asset entities.
-func (c *AssetClient) CreateBulk(builders ...*AssetCreate) *AssetCreateBulk {
-	return &AssetCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Asset entities.
+func (c *AssetClient) CreateBulk(builders ...*AssetCreate) *AssetCreateBulk {
+	return &AssetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AssetClient) MapCreateBulk(slice any, setFunc func(*AssetCreate, int)) *AssetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AssetCreateBulk{err: fmt.Errorf("calling to AssetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AssetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AssetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Asset.
+func (c *AssetClient) Update() *AssetUpdate {
+	mutation := newAssetMutation(c.config, OpUpdate)
+	return &AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AssetClient) UpdateOne(_m *Asset) *AssetUpdateOne {
+	mutation := newAssetMutation(c.config, OpUpdateOne, withAsset(_m))
+	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AssetClient) UpdateOneID(id int) *AssetUpdateOne {
+	mutation := newAssetMutation(c.config, OpUpdateOne, withAssetID(id))
+	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Asset.
+func (c *AssetClient) Delete() *AssetDelete {
+	mutation := newAssetMutation(c.config, OpDelete)
+	return &AssetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AssetClient) DeleteOne(_m *Asset) *AssetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AssetClient) DeleteOneID(id int) *AssetDeleteOne {
+	builder := c.Delete().Where(asset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AssetDeleteOne{builder}
+}
+
+// Query returns a query builder for Asset.
+func (c *AssetClient) Query() *AssetQuery {
+	return &AssetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAsset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Asset entity by its id.
+func (c *AssetClient) Get(ctx context.Context, id int) (*Asset, error) {
+	return c.Query().Where(asset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AssetClient) GetX(ctx context.Context, id int) *Asset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySumdbRecords queries the sumdb_records edge of a Asset.
+func (c *AssetClient) QuerySumdbRecords(_m *Asset) *SumDBRecordQuery {
+	query := (&SumDBRecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(sumdbrecord.Table, sumdbrecord.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, asset.SumdbRecordsTable, asset.SumdbRecordsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AssetClient) Hooks() []Hook {
+	return c.hooks.Asset
+}
+
+// Interceptors returns the client interceptors.
+func (c *AssetClient) Interceptors() []Interceptor {
+	return c.inters.Asset
+}
+
+func (c *AssetClient) mutate(ctx context.Context, m *AssetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AssetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AssetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Asset mutation op: %q", m.Op())
+	}
+}
+
+// DeprecationClient is a client for the Deprecation schema.
+type DeprecationClient struct {
+	config
+}
+
+// NewDeprecationClient returns a client for the Deprecation from the given config.
+func NewDeprecationClient(c config) *DeprecationClient {
+	return &DeprecationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deprecation.Hooks(f(g(h())))`.
+func (c *DeprecationClient) Use(hooks ...Hook) {
+	c.hooks.Deprecation = append(c.hooks.Deprecation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deprecation.Intercept(f(g(h())))`.
+func (c *DeprecationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Deprecation = append(c.inters.Deprecation, interceptors...)
+}
+
+// Create returns a builder for creating a Deprecation entity.
+func (c *DeprecationClient) Create() *DeprecationCreate {
+	mutation := newDeprecationMutation(c.config, OpCreate)
+	return &DeprecationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Deprecation entities.
+func (c *DeprecationClient) CreateBulk(builders ...*DeprecationCreate) *DeprecationCreateBulk {
+	return &DeprecationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeprecationClient) MapCreateBulk(slice any, setFunc func(*DeprecationCreate, int)) *DeprecationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeprecationCreateBulk{err: fmt.Errorf("calling to DeprecationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeprecationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeprecationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Deprecation.
+func (c *DeprecationClient) Update() *DeprecationUpdate {
+	mutation := newDeprecationMutation(c.config, OpUpdate)
+	return &DeprecationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeprecationClient) UpdateOne(_m *Deprecation) *DeprecationUpdateOne {
+	mutation := newDeprecationMutation(c.config, OpUpdateOne, withDeprecation(_m))
+	return &DeprecationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeprecationClient) UpdateOneID(id int) *DeprecationUpdateOne {
+	mutation := newDeprecationMutation(c.config, OpUpdateOne, withDeprecationID(id))
+	return &DeprecationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Deprecation.
+func (c *DeprecationClient) Delete() *DeprecationDelete {
+	mutation := newDeprecationMutation(c.config, OpDelete)
+	return &DeprecationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeprecationClient) DeleteOne(_m *Deprecation) *DeprecationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeprecationClient) DeleteOneID(id int) *DeprecationDeleteOne {
+	builder := c.Delete().Where(deprecation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeprecationDeleteOne{builder}
+}
+
+// Query returns a query builder for Deprecation.
+func (c *DeprecationClient) Query() *DeprecationQuery {
+	return &DeprecationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeprecation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Deprecation entity by its id.
+func (c *DeprecationClient) Get(ctx context.Context, id int) (*Deprecation, error) {
+	return c.Query().Where(deprecation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeprecationClient) GetX(ctx context.Context, id int) *Deprecation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeprecationClient) Hooks() []Hook {
+	return c.hooks.Deprecation
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeprecationClient) Interceptors() []Interceptor {
+	return c.inters.Deprecation
+}
+
+func (c *DeprecationClient) mutate(ctx context.Context, m *DeprecationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeprecationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeprecationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeprecationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeprecationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Deprecation mutation op: %q", m.Op())
+	}
+}
+
+// RetractionClient is a client for the Retraction schema.
+type RetractionClient struct {
+	config
+}
+
+// NewRetractionClient returns a client for the Retraction from the given config.
+func NewRetractionClient(c config) *RetractionClient {
+	return &RetractionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `retraction.Hooks(f(g(h())))`.
+func (c *RetractionClient) Use(hooks ...Hook) {
+	c.hooks.Retraction = append(c.hooks.Retraction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `retraction.Intercept(f(g(h())))`.
+func (c *RetractionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Retraction = append(c.inters.Retraction, interceptors...)
+}
+
+// Create returns a builder for creating a Retraction entity.
+func (c *RetractionClient) Create() *RetractionCreate {
+	mutation := newRetractionMutation(c.config, OpCreate)
+	return &RetractionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Retraction entities.
+func (c *RetractionClient) CreateBulk(builders ...*RetractionCreate) *RetractionCreateBulk {
+	return &RetractionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RetractionClient) MapCreateBulk(slice any, setFunc func(*RetractionCreate, int)) *RetractionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RetractionCreateBulk{err: fmt.Errorf("calling to RetractionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RetractionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RetractionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Retraction.
+func (c *RetractionClient) Update() *RetractionUpdate {
+	mutation := newRetractionMutation(c.config, OpUpdate)
+	return &RetractionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RetractionClient) UpdateOne(_m *Retraction) *RetractionUpdateOne {
+	mutation := newRetractionMutation(c.config, OpUpdateOne, withRetraction(_m))
+	return &RetractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RetractionClient) UpdateOneID(id int) *RetractionUpdateOne {
+	mutation := newRetractionMutation(c.config, OpUpdateOne, withRetractionID(id))
+	return &RetractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Retraction.
+func (c *RetractionClient) Delete() *RetractionDelete {
+	mutation := newRetractionMutation(c.config, OpDelete)
+	return &RetractionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RetractionClient) DeleteOne(_m *Retraction) *RetractionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RetractionClient) DeleteOneID(id int) *RetractionDeleteOne {
+	builder := c.Delete().Where(retraction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RetractionDeleteOne{builder}
+}
+
+// Query returns a query builder for Retraction.
+func (c *RetractionClient) Query() *RetractionQuery {
+	return &RetractionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRetraction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Retraction entity by its id.
+func (c *RetractionClient) Get(ctx context.Context, id int) (*Retraction, error) {
+	return c.Query().Where(retraction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RetractionClient) GetX(ctx context.Context, id int) *Retraction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RetractionClient) Hooks() []Hook {
+	return c.hooks.Retraction
+}
+
+// Interceptors returns the client interceptors.
+func (c *RetractionClient) Interceptors() []Interceptor {
+	return c.inters.Retraction
+}
+
+func (c *RetractionClient) mutate(ctx context.Context, m *RetractionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RetractionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RetractionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RetractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RetractionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Retraction mutation op: %q", m.Op())
+	}
+}
+
+// SumDBCosignatureClient is a client for the SumDBCosignature schema.
+type SumDBCosignatureClient struct {
+	config
+}
+
+// NewSumDBCosignatureClient returns a client for the SumDBCosignature from the given config.
+func NewSumDBCosignatureClient(c config) *SumDBCosignatureClient {
+	return &SumDBCosignatureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sumdbcosignature.Hooks(f(g(h())))`.
+func (c *SumDBCosignatureClient) Use(hooks ...Hook) {
+	c.hooks.SumDBCosignature = append(c.hooks.SumDBCosignature, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sumdbcosignature.Intercept(f(g(h())))`.
+func (c *SumDBCosignatureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SumDBCosignature = append(c.inters.SumDBCosignature, interceptors...)
+}
+
+// Create returns a builder for creating a SumDBCosignature entity.
+func (c *SumDBCosignatureClient) Create() *SumDBCosignatureCreate {
+	mutation := newSumDBCosignatureMutation(c.config, OpCreate)
+	return &SumDBCosignatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SumDBCosignature entities.
+func (c *SumDBCosignatureClient) CreateBulk(builders ...*SumDBCosignatureCreate) *SumDBCosignatureCreateBulk {
+	return &SumDBCosignatureCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *AssetClient) MapCreateBulk(slice any, setFunc func(*AssetCreate, int)) *AssetCreateBulk {
+func (c *SumDBCosignatureClient) MapCreateBulk(slice any, setFunc func(*SumDBCosignatureCreate, int)) *SumDBCosignatureCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &AssetCreateBulk{err: fmt.Errorf("calling to AssetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SumDBCosignatureCreateBulk{err: fmt.Errorf("calling to SumDBCosignatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*AssetCreate, rv.Len())
+	builders := make([]*SumDBCosignatureCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &AssetCreateBulk{config: c.config, builders: builders}
+	return &SumDBCosignatureCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Asset.
-func (c *AssetClient) Update() *AssetUpdate {
-	mutation := newAssetMutation(c.config, OpUpdate)
-	return &AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SumDBCosignature.
+func (c *SumDBCosignatureClient) Update() *SumDBCosignatureUpdate {
+	mutation := newSumDBCosignatureMutation(c.config, OpUpdate)
+	return &SumDBCosignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AssetClient) UpdateOne(_m *Asset) *AssetUpdateOne {
-	mutation := newAssetMutation(c.config, OpUpdateOne, withAsset(_m))
-	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SumDBCosignatureClient) UpdateOne(_m *SumDBCosignature) *SumDBCosignatureUpdateOne {
+	mutation := newSumDBCosignatureMutation(c.config, OpUpdateOne, withSumDBCosignature(_m))
+	return &SumDBCosignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AssetClient) UpdateOneID(id int) *AssetUpdateOne {
-	mutation := newAssetMutation(c.config, OpUpdateOne, withAssetID(id))
-	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SumDBCosignatureClient) UpdateOneID(id int) *SumDBCosignatureUpdateOne {
+	mutation := newSumDBCosignatureMutation(c.config, OpUpdateOne, withSumDBCosignatureID(id))
+	return &SumDBCosignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Asset.
-func (c *AssetClient) Delete() *AssetDelete {
-	mutation := newAssetMutation(c.config, OpDelete)
-	return &AssetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SumDBCosignature.
+func (c *SumDBCosignatureClient) Delete() *SumDBCosignatureDelete {
+	mutation := newSumDBCosignatureMutation(c.config, OpDelete)
+	return &SumDBCosignatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AssetClient) DeleteOne(_m *Asset) *AssetDeleteOne {
+func (c *SumDBCosignatureClient) DeleteOne(_m *SumDBCosignature) *SumDBCosignatureDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AssetClient) DeleteOneID(id int) *AssetDeleteOne {
-	builder := c.Delete().Where(asset.ID(id))
+func (c *SumDBCosignatureClient) DeleteOneID(id int) *SumDBCosignatureDeleteOne {
+	builder := c.Delete().Where(sumdbcosignature.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AssetDeleteOne{builder}
+	return &SumDBCosignatureDeleteOne{builder}
 }
 
-// Query returns a query builder for Asset.
-func (c *AssetClient) Query() *AssetQuery {
-	return &AssetQuery{
+// Query returns a query builder for SumDBCosignature.
+func (c *SumDBCosignatureClient) Query() *SumDBCosignatureQuery {
+	return &SumDBCosignatureQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAsset},
+		ctx:    &QueryContext{Type: TypeSumDBCosignature},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Asset entity by its id.
-func (c *AssetClient) Get(ctx context.Context, id int) (*Asset, error) {
-	return c.Query().Where(asset.ID(id)).Only(ctx)
+// Get returns a SumDBCosignature entity by its id.
+func (c *SumDBCosignatureClient) Get(ctx context.Context, id int) (*SumDBCosignature, error) {
+	return c.Query().Where(sumdbcosignature.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AssetClient) GetX(ctx context.Context, id int) *Asset {
+func (c *SumDBCosignatureClient) GetX(ctx context.Context, id int) *SumDBCosignature {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -478,15 +1078,15 @@ func (c *AssetClient) GetX(ctx context.Context, id int) *Asset {
 	return obj
 }
 
-// QuerySumdbRecords queries the sumdb_records edge of a Asset.
-func (c *AssetClient) QuerySumdbRecords(_m *Asset) *SumDBRecordQuery {
-	query := (&SumDBRecordClient{config: c.config}).Query()
+// QueryHead queries the head edge of a SumDBCosignature.
+func (c *SumDBCosignatureClient) QueryHead(_m *SumDBCosignature) *SumDBTreeHeadQuery {
+	query := (&SumDBTreeHeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(sumdbrecord.Table, sumdbrecord.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, asset.SumdbRecordsTable, asset.SumdbRecordsPrimaryKey...),
+			sqlgraph.From(sumdbcosignature.Table, sumdbcosignature.FieldID, id),
+			sqlgraph.To(sumdbtreehead.Table, sumdbtreehead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sumdbcosignature.HeadTable, sumdbcosignature.HeadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -495,27 +1095,27 @@ func (c *AssetClient) QuerySumdbRecords(_m *Asset) *SumDBRecordQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *AssetClient) Hooks() []Hook {
-	return c.hooks.Asset
+func (c *SumDBCosignatureClient) Hooks() []Hook {
+	return c.hooks.SumDBCosignature
 }
 
 // Interceptors returns the client interceptors.
-func (c *AssetClient) Interceptors() []Interceptor {
-	return c.inters.Asset
+func (c *SumDBCosignatureClient) Interceptors() []Interceptor {
+	return c.inters.SumDBCosignature
 }
 
-func (c *AssetClient) mutate(ctx context.Context, m *AssetMutation) (Value, error) {
+func (c *SumDBCosignatureClient) mutate(ctx context.Context, m *SumDBCosignatureMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AssetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SumDBCosignatureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SumDBCosignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SumDBCosignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AssetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SumDBCosignatureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Asset mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SumDBCosignature mutation op: %q", m.Op())
 	}
 }
 
@@ -668,6 +1268,155 @@ func (c *SumDBHashClient) mutate(ctx context.Context, m *SumDBHashMutation) (Val
 	}
 }
 
+// SumDBKeyClient is a client for the SumDBKey schema.
+type SumDBKeyClient struct {
+	config
+}
+
+// NewSumDBKeyClient returns a client for the SumDBKey from the given config.
+func NewSumDBKeyClient(c config) *SumDBKeyClient {
+	return &SumDBKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sumdbkey.Hooks(f(g(h())))`.
+func (c *SumDBKeyClient) Use(hooks ...Hook) {
+	c.hooks.SumDBKey = append(c.hooks.SumDBKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sumdbkey.Intercept(f(g(h())))`.
+func (c *SumDBKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SumDBKey = append(c.inters.SumDBKey, interceptors...)
+}
+
+// Create returns a builder for creating a SumDBKey entity.
+func (c *SumDBKeyClient) Create() *SumDBKeyCreate {
+	mutation := newSumDBKeyMutation(c.config, OpCreate)
+	return &SumDBKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SumDBKey entities.
+func (c *SumDBKeyClient) CreateBulk(builders ...*SumDBKeyCreate) *SumDBKeyCreateBulk {
+	return &SumDBKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SumDBKeyClient) MapCreateBulk(slice any, setFunc func(*SumDBKeyCreate, int)) *SumDBKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SumDBKeyCreateBulk{err: fmt.Errorf("calling to SumDBKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SumDBKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SumDBKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SumDBKey.
+func (c *SumDBKeyClient) Update() *SumDBKeyUpdate {
+	mutation := newSumDBKeyMutation(c.config, OpUpdate)
+	return &SumDBKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SumDBKeyClient) UpdateOne(_m *SumDBKey) *SumDBKeyUpdateOne {
+	mutation := newSumDBKeyMutation(c.config, OpUpdateOne, withSumDBKey(_m))
+	return &SumDBKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SumDBKeyClient) UpdateOneID(id int) *SumDBKeyUpdateOne {
+	mutation := newSumDBKeyMutation(c.config, OpUpdateOne, withSumDBKeyID(id))
+	return &SumDBKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SumDBKey.
+func (c *SumDBKeyClient) Delete() *SumDBKeyDelete {
+	mutation := newSumDBKeyMutation(c.config, OpDelete)
+	return &SumDBKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SumDBKeyClient) DeleteOne(_m *SumDBKey) *SumDBKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SumDBKeyClient) DeleteOneID(id int) *SumDBKeyDeleteOne {
+	builder := c.Delete().Where(sumdbkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SumDBKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for SumDBKey.
+func (c *SumDBKeyClient) Query() *SumDBKeyQuery {
+	return &SumDBKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSumDBKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SumDBKey entity by its id.
+func (c *SumDBKeyClient) Get(ctx context.Context, id int) (*SumDBKey, error) {
+	return c.Query().Where(sumdbkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SumDBKeyClient) GetX(ctx context.Context, id int) *SumDBKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTree queries the tree edge of a SumDBKey.
+func (c *SumDBKeyClient) QueryTree(_m *SumDBKey) *SumDBTreeQuery {
+	query := (&SumDBTreeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbkey.Table, sumdbkey.FieldID, id),
+			sqlgraph.To(sumdbtree.Table, sumdbtree.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sumdbkey.TreeTable, sumdbkey.TreeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SumDBKeyClient) Hooks() []Hook {
+	return c.hooks.SumDBKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *SumDBKeyClient) Interceptors() []Interceptor {
+	return c.inters.SumDBKey
+}
+
+func (c *SumDBKeyClient) mutate(ctx context.Context, m *SumDBKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SumDBKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SumDBKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SumDBKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SumDBKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SumDBKey mutation op: %q", m.Op())
+	}
+}
+
 // SumDBRecordClient is a client for the SumDBRecord schema.
 type SumDBRecordClient struct {
 	config
@@ -973,6 +1722,38 @@ func (c *SumDBTreeClient) QueryRecords(_m *SumDBTree) *SumDBRecordQuery {
 	return query
 }
 
+// QueryKeys queries the keys edge of a SumDBTree.
+func (c *SumDBTreeClient) QueryKeys(_m *SumDBTree) *SumDBKeyQuery {
+	query := (&SumDBKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtree.Table, sumdbtree.FieldID, id),
+			sqlgraph.To(sumdbkey.Table, sumdbkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sumdbtree.KeysTable, sumdbtree.KeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHeads queries the heads edge of a SumDBTree.
+func (c *SumDBTreeClient) QueryHeads(_m *SumDBTree) *SumDBTreeHeadQuery {
+	query := (&SumDBTreeHeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtree.Table, sumdbtree.FieldID, id),
+			sqlgraph.To(sumdbtreehead.Table, sumdbtreehead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sumdbtree.HeadsTable, sumdbtree.HeadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SumDBTreeClient) Hooks() []Hook {
 	return c.hooks.SumDBTree
@@ -998,12 +1779,179 @@ func (c *SumDBTreeClient) mutate(ctx context.Context, m *SumDBTreeMutation) (Val
 	}
 }
 
+// SumDBTreeHeadClient is a client for the SumDBTreeHead schema.
+type SumDBTreeHeadClient struct {
+	config
+}
+
+// NewSumDBTreeHeadClient returns a client for the SumDBTreeHead from the given config.
+func NewSumDBTreeHeadClient(c config) *SumDBTreeHeadClient {
+	return &SumDBTreeHeadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sumdbtreehead.Hooks(f(g(h())))`.
+func (c *SumDBTreeHeadClient) Use(hooks ...Hook) {
+	c.hooks.SumDBTreeHead = append(c.hooks.SumDBTreeHead, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sumdbtreehead.Intercept(f(g(h())))`.
+func (c *SumDBTreeHeadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SumDBTreeHead = append(c.inters.SumDBTreeHead, interceptors...)
+}
+
+// Create returns a builder for creating a SumDBTreeHead entity.
+func (c *SumDBTreeHeadClient) Create() *SumDBTreeHeadCreate {
+	mutation := newSumDBTreeHeadMutation(c.config, OpCreate)
+	return &SumDBTreeHeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SumDBTreeHead entities.
+func (c *SumDBTreeHeadClient) CreateBulk(builders ...*SumDBTreeHeadCreate) *SumDBTreeHeadCreateBulk {
+	return &SumDBTreeHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SumDBTreeHeadClient) MapCreateBulk(slice any, setFunc func(*SumDBTreeHeadCreate, int)) *SumDBTreeHeadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SumDBTreeHeadCreateBulk{err: fmt.Errorf("calling to SumDBTreeHeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SumDBTreeHeadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SumDBTreeHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SumDBTreeHead.
+func (c *SumDBTreeHeadClient) Update() *SumDBTreeHeadUpdate {
+	mutation := newSumDBTreeHeadMutation(c.config, OpUpdate)
+	return &SumDBTreeHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SumDBTreeHeadClient) UpdateOne(_m *SumDBTreeHead) *SumDBTreeHeadUpdateOne {
+	mutation := newSumDBTreeHeadMutation(c.config, OpUpdateOne, withSumDBTreeHead(_m))
+	return &SumDBTreeHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SumDBTreeHeadClient) UpdateOneID(id int) *SumDBTreeHeadUpdateOne {
+	mutation := newSumDBTreeHeadMutation(c.config, OpUpdateOne, withSumDBTreeHeadID(id))
+	return &SumDBTreeHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SumDBTreeHead.
+func (c *SumDBTreeHeadClient) Delete() *SumDBTreeHeadDelete {
+	mutation := newSumDBTreeHeadMutation(c.config, OpDelete)
+	return &SumDBTreeHeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SumDBTreeHeadClient) DeleteOne(_m *SumDBTreeHead) *SumDBTreeHeadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SumDBTreeHeadClient) DeleteOneID(id int) *SumDBTreeHeadDeleteOne {
+	builder := c.Delete().Where(sumdbtreehead.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SumDBTreeHeadDeleteOne{builder}
+}
+
+// Query returns a query builder for SumDBTreeHead.
+func (c *SumDBTreeHeadClient) Query() *SumDBTreeHeadQuery {
+	return &SumDBTreeHeadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSumDBTreeHead},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SumDBTreeHead entity by its id.
+func (c *SumDBTreeHeadClient) Get(ctx context.Context, id int) (*SumDBTreeHead, error) {
+	return c.Query().Where(sumdbtreehead.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SumDBTreeHeadClient) GetX(ctx context.Context, id int) *SumDBTreeHead {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTree queries the tree edge of a SumDBTreeHead.
+func (c *SumDBTreeHeadClient) QueryTree(_m *SumDBTreeHead) *SumDBTreeQuery {
+	query := (&SumDBTreeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtreehead.Table, sumdbtreehead.FieldID, id),
+			sqlgraph.To(sumdbtree.Table, sumdbtree.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sumdbtreehead.TreeTable, sumdbtreehead.TreeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCosignatures queries the cosignatures edge of a SumDBTreeHead.
+func (c *SumDBTreeHeadClient) QueryCosignatures(_m *SumDBTreeHead) *SumDBCosignatureQuery {
+	query := (&SumDBCosignatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtreehead.Table, sumdbtreehead.FieldID, id),
+			sqlgraph.To(sumdbcosignature.Table, sumdbcosignature.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sumdbtreehead.CosignaturesTable, sumdbtreehead.CosignaturesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SumDBTreeHeadClient) Hooks() []Hook {
+	return c.hooks.SumDBTreeHead
+}
+
+// Interceptors returns the client interceptors.
+func (c *SumDBTreeHeadClient) Interceptors() []Interceptor {
+	return c.inters.SumDBTreeHead
+}
+
+func (c *SumDBTreeHeadClient) mutate(ctx context.Context, m *SumDBTreeHeadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SumDBTreeHeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SumDBTreeHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SumDBTreeHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SumDBTreeHeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SumDBTreeHead mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Archive, Asset, SumDBHash, SumDBRecord, SumDBTree []ent.Hook
+		Archive, ArchiveReplacement, Asset, Deprecation, Retraction, SumDBCosignature,
+		SumDBHash, SumDBKey, SumDBRecord, SumDBTree, SumDBTreeHead []ent.Hook
 	}
 	inters struct {
-		Archive, Asset, SumDBHash, SumDBRecord, SumDBTree []ent.Interceptor
+		Archive, ArchiveReplacement, Asset, Deprecation, Retraction, SumDBCosignature,
+		SumDBHash, SumDBKey, SumDBRecord, SumDBTree, SumDBTreeHead []ent.Interceptor
 	}
 )
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
+)
+
+// DeprecationCreate is the builder for creating a Deprecation entity.
+type DeprecationCreate struct {
+	config
+	mutation *DeprecationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeprecationCreate) SetCreatedAt(v time.Time) *DeprecationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeprecationCreate) SetNillableCreatedAt(v *time.Time) *DeprecationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeprecationCreate) SetUpdatedAt(v time.Time) *DeprecationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeprecationCreate) SetNillableUpdatedAt(v *time.Time) *DeprecationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *DeprecationCreate) SetPath(v string) *DeprecationCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *DeprecationCreate) SetMessage(v string) *DeprecationCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// Mutation returns the DeprecationMutation object of the builder.
+func (_c *DeprecationCreate) Mutation() *DeprecationMutation {
+	return _c.mutation
+}
+
+// Save creates the Deprecation in the database.
+func (_c *DeprecationCreate) Save(ctx context.Context) (*Deprecation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeprecationCreate) SaveX(ctx context.Context) *Deprecation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeprecationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeprecationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeprecationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := deprecation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := deprecation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeprecationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Deprecation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Deprecation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Deprecation.path"`)}
+	}
+	if v, ok := _c.mutation.Path(); ok {
+		if err := deprecation.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Deprecation.path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "Deprecation.message"`)}
+	}
+	return nil
+}
+
+func (_c *DeprecationCreate) sqlSave(ctx context.Context) (*Deprecation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeprecationCreate) createSpec() (*Deprecation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Deprecation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deprecation.Table, sqlgraph.NewFieldSpec(deprecation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deprecation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(deprecation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(deprecation.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(deprecation.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Deprecation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeprecationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeprecationCreate) OnConflict(opts ...sql.ConflictOption) *DeprecationUpsertOne {
+	_c.conflict = opts
+	return &DeprecationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Deprecation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeprecationCreate) OnConflictColumns(columns ...string) *DeprecationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeprecationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeprecationUpsertOne is the builder for "upsert"-ing
+	//  one Deprecation node.
+	DeprecationUpsertOne struct {
+		create *DeprecationCreate
+	}
+
+	// DeprecationUpsert is the "OnConflict" setter.
+	DeprecationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeprecationUpsert) SetUpdatedAt(v time.Time) *DeprecationUpsert {
+	u.Set(deprecation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeprecationUpsert) UpdateUpdatedAt() *DeprecationUpsert {
+	u.SetExcluded(deprecation.FieldUpdatedAt)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *DeprecationUpsert) SetPath(v string) *DeprecationUpsert {
+	u.Set(deprecation.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *DeprecationUpsert) UpdatePath() *DeprecationUpsert {
+	u.SetExcluded(deprecation.FieldPath)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *DeprecationUpsert) SetMessage(v string) *DeprecationUpsert {
+	u.Set(deprecation.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DeprecationUpsert) UpdateMessage() *DeprecationUpsert {
+	u.SetExcluded(deprecation.FieldMessage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Deprecation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeprecationUpsertOne) UpdateNewValues() *DeprecationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deprecation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Deprecation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeprecationUpsertOne) Ignore() *DeprecationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeprecationUpsertOne) DoNothing() *DeprecationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeprecationCreate.OnConflict
+// documentation for more info.
+func (u *DeprecationUpsertOne) Update(set func(*DeprecationUpsert)) *DeprecationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeprecationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeprecationUpsertOne) SetUpdatedAt(v time.Time) *DeprecationUpsertOne {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeprecationUpsertOne) UpdateUpdatedAt() *DeprecationUpsertOne {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *DeprecationUpsertOne) SetPath(v string) *DeprecationUpsertOne {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *DeprecationUpsertOne) UpdatePath() *DeprecationUpsertOne {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *DeprecationUpsertOne) SetMessage(v string) *DeprecationUpsertOne {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DeprecationUpsertOne) UpdateMessage() *DeprecationUpsertOne {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *DeprecationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeprecationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeprecationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeprecationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeprecationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeprecationCreateBulk is the builder for creating many Deprecation entities in bulk.
+type DeprecationCreateBulk struct {
+	config
+	err      error
+	builders []*DeprecationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Deprecation entities in the database.
+func (_c *DeprecationCreateBulk) Save(ctx context.Context) ([]*Deprecation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Deprecation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeprecationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeprecationCreateBulk) SaveX(ctx context.Context) []*Deprecation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeprecationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeprecationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Deprecation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeprecationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeprecationCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeprecationUpsertBulk {
+	_c.conflict = opts
+	return &DeprecationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Deprecation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeprecationCreateBulk) OnConflictColumns(columns ...string) *DeprecationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeprecationUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeprecationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Deprecation nodes.
+type DeprecationUpsertBulk struct {
+	create *DeprecationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Deprecation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeprecationUpsertBulk) UpdateNewValues() *DeprecationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deprecation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Deprecation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeprecationUpsertBulk) Ignore() *DeprecationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeprecationUpsertBulk) DoNothing() *DeprecationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeprecationCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeprecationUpsertBulk) Update(set func(*DeprecationUpsert)) *DeprecationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeprecationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeprecationUpsertBulk) SetUpdatedAt(v time.Time) *DeprecationUpsertBulk {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeprecationUpsertBulk) UpdateUpdatedAt() *DeprecationUpsertBulk {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *DeprecationUpsertBulk) SetPath(v string) *DeprecationUpsertBulk {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *DeprecationUpsertBulk) UpdatePath() *DeprecationUpsertBulk {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *DeprecationUpsertBulk) SetMessage(v string) *DeprecationUpsertBulk {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DeprecationUpsertBulk) UpdateMessage() *DeprecationUpsertBulk {
+	return u.Update(func(s *DeprecationUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *DeprecationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeprecationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeprecationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeprecationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
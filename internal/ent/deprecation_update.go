@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// DeprecationUpdate is the builder for updating Deprecation entities.
+type DeprecationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeprecationMutation
+}
+
+// Where appends a list predicates to the DeprecationUpdate builder.
+func (_u *DeprecationUpdate) Where(ps ...predicate.Deprecation) *DeprecationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeprecationUpdate) SetUpdatedAt(v time.Time) *DeprecationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *DeprecationUpdate) SetPath(v string) *DeprecationUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *DeprecationUpdate) SetNillablePath(v *string) *DeprecationUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *DeprecationUpdate) SetMessage(v string) *DeprecationUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *DeprecationUpdate) SetNillableMessage(v *string) *DeprecationUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DeprecationMutation object of the builder.
+func (_u *DeprecationUpdate) Mutation() *DeprecationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeprecationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeprecationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeprecationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeprecationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeprecationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deprecation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeprecationUpdate) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := deprecation.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Deprecation.path": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeprecationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deprecation.Table, deprecation.Columns, sqlgraph.NewFieldSpec(deprecation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deprecation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(deprecation.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(deprecation.FieldMessage, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deprecation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeprecationUpdateOne is the builder for updating a single Deprecation entity.
+type DeprecationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeprecationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeprecationUpdateOne) SetUpdatedAt(v time.Time) *DeprecationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *DeprecationUpdateOne) SetPath(v string) *DeprecationUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *DeprecationUpdateOne) SetNillablePath(v *string) *DeprecationUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *DeprecationUpdateOne) SetMessage(v string) *DeprecationUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *DeprecationUpdateOne) SetNillableMessage(v *string) *DeprecationUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DeprecationMutation object of the builder.
+func (_u *DeprecationUpdateOne) Mutation() *DeprecationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeprecationUpdate builder.
+func (_u *DeprecationUpdateOne) Where(ps ...predicate.Deprecation) *DeprecationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeprecationUpdateOne) Select(field string, fields ...string) *DeprecationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Deprecation entity.
+func (_u *DeprecationUpdateOne) Save(ctx context.Context) (*Deprecation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeprecationUpdateOne) SaveX(ctx context.Context) *Deprecation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeprecationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeprecationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeprecationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deprecation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeprecationUpdateOne) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := deprecation.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Deprecation.path": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeprecationUpdateOne) sqlSave(ctx context.Context) (_node *Deprecation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deprecation.Table, deprecation.Columns, sqlgraph.NewFieldSpec(deprecation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Deprecation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deprecation.FieldID)
+		for _, f := range fields {
+			if !deprecation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deprecation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deprecation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(deprecation.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(deprecation.FieldMessage, field.TypeString, value)
+	}
+	_node = &Deprecation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deprecation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,10 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
 	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			archive.Table:     archive.ValidColumn,
-			asset.Table:       asset.ValidColumn,
-			sumdbhash.Table:   sumdbhash.ValidColumn,
-			sumdbrecord.Table: sumdbrecord.ValidColumn,
-			sumdbtree.Table:   sumdbtree.ValidColumn,
+			archive.Table:            archive.ValidColumn,
+			archivereplacement.Table: archivereplacement.ValidColumn,
+			asset.Table:              asset.ValidColumn,
+			deprecation.Table:        deprecation.ValidColumn,
+			retraction.Table:         retraction.ValidColumn,
+			sumdbcosignature.Table:   sumdbcosignature.ValidColumn,
+			sumdbhash.Table:          sumdbhash.ValidColumn,
+			sumdbkey.Table:           sumdbkey.ValidColumn,
+			sumdbrecord.Table:        sumdbrecord.ValidColumn,
+			sumdbtree.Table:          sumdbtree.ValidColumn,
+			sumdbtreehead.Table:      sumdbtreehead.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -21,6 +21,18 @@ func (f ArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchiveMutation", m)
 }
 
+// The ArchiveReplacementFunc type is an adapter to allow the use of ordinary
+// function as ArchiveReplacement mutator.
+type ArchiveReplacementFunc func(context.Context, *ent.ArchiveReplacementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchiveReplacementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArchiveReplacementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchiveReplacementMutation", m)
+}
+
 // The AssetFunc type is an adapter to allow the use of ordinary
 // function as Asset mutator.
 type AssetFunc func(context.Context, *ent.AssetMutation) (ent.Value, error)
@@ -33,6 +45,42 @@ func (f AssetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AssetMutation", m)
 }
 
+// The DeprecationFunc type is an adapter to allow the use of ordinary
+// function as Deprecation mutator.
+type DeprecationFunc func(context.Context, *ent.DeprecationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeprecationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeprecationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeprecationMutation", m)
+}
+
+// The RetractionFunc type is an adapter to allow the use of ordinary
+// function as Retraction mutator.
+type RetractionFunc func(context.Context, *ent.RetractionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RetractionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RetractionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RetractionMutation", m)
+}
+
+// The SumDBCosignatureFunc type is an adapter to allow the use of ordinary
+// function as SumDBCosignature mutator.
+type SumDBCosignatureFunc func(context.Context, *ent.SumDBCosignatureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SumDBCosignatureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SumDBCosignatureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SumDBCosignatureMutation", m)
+}
+
 // The SumDBHashFunc type is an adapter to allow the use of ordinary
 // function as SumDBHash mutator.
 type SumDBHashFunc func(context.Context, *ent.SumDBHashMutation) (ent.Value, error)
@@ -45,6 +93,18 @@ func (f SumDBHashFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SumDBHashMutation", m)
 }
 
+// The SumDBKeyFunc type is an adapter to allow the use of ordinary
+// function as SumDBKey mutator.
+type SumDBKeyFunc func(context.Context, *ent.SumDBKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SumDBKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SumDBKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SumDBKeyMutation", m)
+}
+
 // The SumDBRecordFunc type is an adapter to allow the use of ordinary
 // function as SumDBRecord mutator.
 type SumDBRecordFunc func(context.Context, *ent.SumDBRecordMutation) (ent.Value, error)
@@ -69,6 +129,18 @@ func (f SumDBTreeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SumDBTreeMutation", m)
 }
 
+// The SumDBTreeHeadFunc type is an adapter to allow the use of ordinary
+// function as SumDBTreeHead mutator.
+type SumDBTreeHeadFunc func(context.Context, *ent.SumDBTreeHeadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SumDBTreeHeadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SumDBTreeHeadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SumDBTreeHeadMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
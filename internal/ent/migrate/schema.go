@@ -14,8 +14,12 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"gomod"}},
-		{Name: "coordinate", Type: field.TypeString, Size: 200},
+		{Name: "coordinate", Type: field.TypeString, Size: 701},
 		{Name: "assets", Type: field.TypeJSON},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "origin", Type: field.TypeJSON, Nullable: true},
+		{Name: "hash", Type: field.TypeString, Nullable: true},
+		{Name: "cached", Type: field.TypeBool, Default: false},
 	}
 	// ArchivesTable holds the schema information for the "archives" table.
 	ArchivesTable = &schema.Table{
@@ -45,6 +49,40 @@ var (
 			},
 		},
 	}
+	// ArchiveReplacementsColumns holds the columns for the "archive_replacements" table.
+	ArchiveReplacementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "coordinate", Type: field.TypeString, Size: 701},
+		{Name: "previous_hash", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "principal", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+	}
+	// ArchiveReplacementsTable holds the schema information for the "archive_replacements" table.
+	ArchiveReplacementsTable = &schema.Table{
+		Name:       "archive_replacements",
+		Columns:    ArchiveReplacementsColumns,
+		PrimaryKey: []*schema.Column{ArchiveReplacementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archivereplacement_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArchiveReplacementsColumns[1]},
+			},
+			{
+				Name:    "archivereplacement_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArchiveReplacementsColumns[2]},
+			},
+			{
+				Name:    "archivereplacement_coordinate",
+				Unique:  false,
+				Columns: []*schema.Column{ArchiveReplacementsColumns[3]},
+			},
+		},
+	}
 	// AssetsColumns holds the columns for the "assets" table.
 	AssetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -71,6 +109,110 @@ var (
 			},
 		},
 	}
+	// DeprecationsColumns holds the columns for the "deprecations" table.
+	DeprecationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "path", Type: field.TypeString, Unique: true, Size: 500},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+	}
+	// DeprecationsTable holds the schema information for the "deprecations" table.
+	DeprecationsTable = &schema.Table{
+		Name:       "deprecations",
+		Columns:    DeprecationsColumns,
+		PrimaryKey: []*schema.Column{DeprecationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deprecation_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeprecationsColumns[1]},
+			},
+			{
+				Name:    "deprecation_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeprecationsColumns[2]},
+			},
+		},
+	}
+	// RetractionsColumns holds the columns for the "retractions" table.
+	RetractionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "path", Type: field.TypeString, Size: 500},
+		{Name: "version", Type: field.TypeString, Size: 200},
+		{Name: "rationale", Type: field.TypeString, Size: 2147483647, Default: ""},
+	}
+	// RetractionsTable holds the schema information for the "retractions" table.
+	RetractionsTable = &schema.Table{
+		Name:       "retractions",
+		Columns:    RetractionsColumns,
+		PrimaryKey: []*schema.Column{RetractionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "retraction_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RetractionsColumns[1]},
+			},
+			{
+				Name:    "retraction_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{RetractionsColumns[2]},
+			},
+			{
+				Name:    "retraction_path_version",
+				Unique:  true,
+				Columns: []*schema.Column{RetractionsColumns[3], RetractionsColumns[4]},
+			},
+		},
+	}
+	// SumDbCosignaturesColumns holds the columns for the "sum_db_cosignatures" table.
+	SumDbCosignaturesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "witness", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeUint32},
+		{Name: "signature", Type: field.TypeString},
+		{Name: "head_id", Type: field.TypeInt},
+	}
+	// SumDbCosignaturesTable holds the schema information for the "sum_db_cosignatures" table.
+	SumDbCosignaturesTable = &schema.Table{
+		Name:       "sum_db_cosignatures",
+		Columns:    SumDbCosignaturesColumns,
+		PrimaryKey: []*schema.Column{SumDbCosignaturesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sum_db_cosignatures_sum_db_tree_heads_cosignatures",
+				Columns:    []*schema.Column{SumDbCosignaturesColumns[6]},
+				RefColumns: []*schema.Column{SumDbTreeHeadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sumdbcosignature_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbCosignaturesColumns[1]},
+			},
+			{
+				Name:    "sumdbcosignature_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbCosignaturesColumns[2]},
+			},
+			{
+				Name:    "sumdbcosignature_head_id",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbCosignaturesColumns[6]},
+			},
+			{
+				Name:    "sumdbcosignature_witness_key_hash_head_id",
+				Unique:  true,
+				Columns: []*schema.Column{SumDbCosignaturesColumns[3], SumDbCosignaturesColumns[4], SumDbCosignaturesColumns[6]},
+			},
+		},
+	}
 	// SumDbHashesColumns holds the columns for the "sum_db_hashes" table.
 	SumDbHashesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -116,14 +258,55 @@ var (
 			},
 		},
 	}
+	// SumDbKeysColumns holds the columns for the "sum_db_keys" table.
+	SumDbKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "signer_key", Type: field.TypeString, Size: 100},
+		{Name: "verifier_key", Type: field.TypeString, Size: 100},
+		{Name: "cosign_until", Type: field.TypeTime},
+		{Name: "tree_id", Type: field.TypeInt},
+	}
+	// SumDbKeysTable holds the schema information for the "sum_db_keys" table.
+	SumDbKeysTable = &schema.Table{
+		Name:       "sum_db_keys",
+		Columns:    SumDbKeysColumns,
+		PrimaryKey: []*schema.Column{SumDbKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sum_db_keys_sum_db_trees_keys",
+				Columns:    []*schema.Column{SumDbKeysColumns[6]},
+				RefColumns: []*schema.Column{SumDbTreesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sumdbkey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbKeysColumns[1]},
+			},
+			{
+				Name:    "sumdbkey_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbKeysColumns[2]},
+			},
+			{
+				Name:    "sumdbkey_tree_id",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbKeysColumns[6]},
+			},
+		},
+	}
 	// SumDbRecordsColumns holds the columns for the "sum_db_records" table.
 	SumDbRecordsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "record_id", Type: field.TypeInt64},
-		{Name: "path", Type: field.TypeString, Size: 200},
-		{Name: "version", Type: field.TypeString, Size: 20},
+		{Name: "path", Type: field.TypeString, Size: 500},
+		{Name: "version", Type: field.TypeString, Size: 200},
 		{Name: "data", Type: field.TypeBytes},
 		{Name: "tree_id", Type: field.TypeInt},
 	}
@@ -177,6 +360,7 @@ var (
 		{Name: "size", Type: field.TypeInt64},
 		{Name: "signer_key", Type: field.TypeString, Size: 100},
 		{Name: "verifier_key", Type: field.TypeString, Size: 100},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "frozen", "retired"}, Default: "active"},
 	}
 	// SumDbTreesTable holds the schema information for the "sum_db_trees" table.
 	SumDbTreesTable = &schema.Table{
@@ -196,6 +380,52 @@ var (
 			},
 		},
 	}
+	// SumDbTreeHeadsColumns holds the columns for the "sum_db_tree_heads" table.
+	SumDbTreeHeadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "hash", Type: field.TypeBytes},
+		{Name: "note", Type: field.TypeBytes},
+		{Name: "tree_id", Type: field.TypeInt},
+	}
+	// SumDbTreeHeadsTable holds the schema information for the "sum_db_tree_heads" table.
+	SumDbTreeHeadsTable = &schema.Table{
+		Name:       "sum_db_tree_heads",
+		Columns:    SumDbTreeHeadsColumns,
+		PrimaryKey: []*schema.Column{SumDbTreeHeadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sum_db_tree_heads_sum_db_trees_heads",
+				Columns:    []*schema.Column{SumDbTreeHeadsColumns[6]},
+				RefColumns: []*schema.Column{SumDbTreesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sumdbtreehead_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbTreeHeadsColumns[1]},
+			},
+			{
+				Name:    "sumdbtreehead_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbTreeHeadsColumns[2]},
+			},
+			{
+				Name:    "sumdbtreehead_tree_id",
+				Unique:  false,
+				Columns: []*schema.Column{SumDbTreeHeadsColumns[6]},
+			},
+			{
+				Name:    "sumdbtreehead_size_tree_id",
+				Unique:  true,
+				Columns: []*schema.Column{SumDbTreeHeadsColumns[3], SumDbTreeHeadsColumns[6]},
+			},
+		},
+	}
 	// SumDbRecordAssetsColumns holds the columns for the "sum_db_record_assets" table.
 	SumDbRecordAssetsColumns = []*schema.Column{
 		{Name: "sum_db_record_id", Type: field.TypeInt},
@@ -224,17 +454,26 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ArchivesTable,
+		ArchiveReplacementsTable,
 		AssetsTable,
+		DeprecationsTable,
+		RetractionsTable,
+		SumDbCosignaturesTable,
 		SumDbHashesTable,
+		SumDbKeysTable,
 		SumDbRecordsTable,
 		SumDbTreesTable,
+		SumDbTreeHeadsTable,
 		SumDbRecordAssetsTable,
 	}
 )
 
 func init() {
+	SumDbCosignaturesTable.ForeignKeys[0].RefTable = SumDbTreeHeadsTable
 	SumDbHashesTable.ForeignKeys[0].RefTable = SumDbTreesTable
+	SumDbKeysTable.ForeignKeys[0].RefTable = SumDbTreesTable
 	SumDbRecordsTable.ForeignKeys[0].RefTable = SumDbTreesTable
+	SumDbTreeHeadsTable.ForeignKeys[0].RefTable = SumDbTreesTable
 	SumDbRecordAssetsTable.ForeignKeys[0].RefTable = SumDbRecordsTable
 	SumDbRecordAssetsTable.ForeignKeys[1].RefTable = AssetsTable
 }
@@ -13,12 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
 	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
 	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
 	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 	"github.com/pseudomuto/pacman/internal/types"
 )
 
@@ -31,11 +37,17 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeArchive     = "Archive"
-	TypeAsset       = "Asset"
-	TypeSumDBHash   = "SumDBHash"
-	TypeSumDBRecord = "SumDBRecord"
-	TypeSumDBTree   = "SumDBTree"
+	TypeArchive            = "Archive"
+	TypeArchiveReplacement = "ArchiveReplacement"
+	TypeAsset              = "Asset"
+	TypeDeprecation        = "Deprecation"
+	TypeRetraction         = "Retraction"
+	TypeSumDBCosignature   = "SumDBCosignature"
+	TypeSumDBHash          = "SumDBHash"
+	TypeSumDBKey           = "SumDBKey"
+	TypeSumDBRecord        = "SumDBRecord"
+	TypeSumDBTree          = "SumDBTree"
+	TypeSumDBTreeHead      = "SumDBTreeHead"
 )
 
 // ArchiveMutation represents an operation that mutates the Archive nodes in the graph.
@@ -50,6 +62,10 @@ type ArchiveMutation struct {
 	coordinate    *string
 	assets        *[]schema.AssetURL
 	appendassets  []schema.AssetURL
+	released_at   *time.Time
+	origin        **schema.Origin
+	hash          *string
+	cached        *bool
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*Archive, error)
@@ -349,6 +365,189 @@ func (m *ArchiveMutation) ResetAssets() {
 	m.appendassets = nil
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (m *ArchiveMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
+}
+
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *ArchiveMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedAt returns the old "released_at" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *ArchiveMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[archive.FieldReleasedAt] = struct{}{}
+}
+
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *ArchiveMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[archive.FieldReleasedAt]
+	return ok
+}
+
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *ArchiveMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, archive.FieldReleasedAt)
+}
+
+// SetOrigin sets the "origin" field.
+func (m *ArchiveMutation) SetOrigin(s *schema.Origin) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *ArchiveMutation) Origin() (r *schema.Origin, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldOrigin(ctx context.Context) (v *schema.Origin, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (m *ArchiveMutation) ClearOrigin() {
+	m.origin = nil
+	m.clearedFields[archive.FieldOrigin] = struct{}{}
+}
+
+// OriginCleared returns if the "origin" field was cleared in this mutation.
+func (m *ArchiveMutation) OriginCleared() bool {
+	_, ok := m.clearedFields[archive.FieldOrigin]
+	return ok
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *ArchiveMutation) ResetOrigin() {
+	m.origin = nil
+	delete(m.clearedFields, archive.FieldOrigin)
+}
+
+// SetHash sets the "hash" field.
+func (m *ArchiveMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ArchiveMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ClearHash clears the value of the "hash" field.
+func (m *ArchiveMutation) ClearHash() {
+	m.hash = nil
+	m.clearedFields[archive.FieldHash] = struct{}{}
+}
+
+// HashCleared returns if the "hash" field was cleared in this mutation.
+func (m *ArchiveMutation) HashCleared() bool {
+	_, ok := m.clearedFields[archive.FieldHash]
+	return ok
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *ArchiveMutation) ResetHash() {
+	m.hash = nil
+	delete(m.clearedFields, archive.FieldHash)
+}
+
+// SetCached sets the "cached" field.
+func (m *ArchiveMutation) SetCached(b bool) {
+	m.cached = &b
+}
+
+// Cached returns the value of the "cached" field in the mutation.
+func (m *ArchiveMutation) Cached() (r bool, exists bool) {
+	v := m.cached
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCached returns the old "cached" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldCached(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCached is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCached requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCached: %w", err)
+	}
+	return oldValue.Cached, nil
+}
+
+// ResetCached resets all changes to the "cached" field.
+func (m *ArchiveMutation) ResetCached() {
+	m.cached = nil
+}
+
 // Where appends a list predicates to the ArchiveMutation builder.
 func (m *ArchiveMutation) Where(ps ...predicate.Archive) {
 	m.predicates = append(m.predicates, ps...)
@@ -383,7 +582,7 @@ func (m *ArchiveMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ArchiveMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
 		fields = append(fields, archive.FieldCreatedAt)
 	}
@@ -399,6 +598,18 @@ func (m *ArchiveMutation) Fields() []string {
 	if m.assets != nil {
 		fields = append(fields, archive.FieldAssets)
 	}
+	if m.released_at != nil {
+		fields = append(fields, archive.FieldReleasedAt)
+	}
+	if m.origin != nil {
+		fields = append(fields, archive.FieldOrigin)
+	}
+	if m.hash != nil {
+		fields = append(fields, archive.FieldHash)
+	}
+	if m.cached != nil {
+		fields = append(fields, archive.FieldCached)
+	}
 	return fields
 }
 
@@ -417,6 +628,14 @@ func (m *ArchiveMutation) Field(name string) (ent.Value, bool) {
 		return m.Coordinate()
 	case archive.FieldAssets:
 		return m.Assets()
+	case archive.FieldReleasedAt:
+		return m.ReleasedAt()
+	case archive.FieldOrigin:
+		return m.Origin()
+	case archive.FieldHash:
+		return m.Hash()
+	case archive.FieldCached:
+		return m.Cached()
 	}
 	return nil, false
 }
@@ -436,6 +655,14 @@ func (m *ArchiveMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCoordinate(ctx)
 	case archive.FieldAssets:
 		return m.OldAssets(ctx)
+	case archive.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	case archive.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case archive.FieldHash:
+		return m.OldHash(ctx)
+	case archive.FieldCached:
+		return m.OldCached(ctx)
 	}
 	return nil, fmt.Errorf("unknown Archive field %s", name)
 }
@@ -480,6 +707,34 @@ func (m *ArchiveMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAssets(v)
 		return nil
+	case archive.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	case archive.FieldOrigin:
+		v, ok := value.(*schema.Origin)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case archive.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case archive.FieldCached:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCached(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Archive field %s", name)
 }
@@ -509,7 +764,17 @@ func (m *ArchiveMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ArchiveMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(archive.FieldReleasedAt) {
+		fields = append(fields, archive.FieldReleasedAt)
+	}
+	if m.FieldCleared(archive.FieldOrigin) {
+		fields = append(fields, archive.FieldOrigin)
+	}
+	if m.FieldCleared(archive.FieldHash) {
+		fields = append(fields, archive.FieldHash)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -522,6 +787,17 @@ func (m *ArchiveMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ArchiveMutation) ClearField(name string) error {
+	switch name {
+	case archive.FieldReleasedAt:
+		m.ClearReleasedAt()
+		return nil
+	case archive.FieldOrigin:
+		m.ClearOrigin()
+		return nil
+	case archive.FieldHash:
+		m.ClearHash()
+		return nil
+	}
 	return fmt.Errorf("unknown Archive nullable field %s", name)
 }
 
@@ -544,6 +820,18 @@ func (m *ArchiveMutation) ResetField(name string) error {
 	case archive.FieldAssets:
 		m.ResetAssets()
 		return nil
+	case archive.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	case archive.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case archive.FieldHash:
+		m.ResetHash()
+		return nil
+	case archive.FieldCached:
+		m.ResetCached()
+		return nil
 	}
 	return fmt.Errorf("unknown Archive field %s", name)
 }
@@ -596,36 +884,36 @@ func (m *ArchiveMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Archive edge %s", name)
 }
 
-// AssetMutation represents an operation that mutates the Asset nodes in the graph.
-type AssetMutation struct {
+// ArchiveReplacementMutation represents an operation that mutates the ArchiveReplacement nodes in the graph.
+type ArchiveReplacementMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	created_at           *time.Time
-	updated_at           *time.Time
-	_type                *types.AssetType
-	uri                  *string
-	clearedFields        map[string]struct{}
-	sumdb_records        map[int]struct{}
-	removedsumdb_records map[int]struct{}
-	clearedsumdb_records bool
-	done                 bool
-	oldValue             func(context.Context) (*Asset, error)
-	predicates           []predicate.Asset
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	coordinate    *string
+	previous_hash *string
+	hash          *string
+	principal     *string
+	reason        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ArchiveReplacement, error)
+	predicates    []predicate.ArchiveReplacement
 }
 
-var _ ent.Mutation = (*AssetMutation)(nil)
+var _ ent.Mutation = (*ArchiveReplacementMutation)(nil)
 
-// assetOption allows management of the mutation configuration using functional options.
-type assetOption func(*AssetMutation)
+// archivereplacementOption allows management of the mutation configuration using functional options.
+type archivereplacementOption func(*ArchiveReplacementMutation)
 
-// newAssetMutation creates new mutation for the Asset entity.
-func newAssetMutation(c config, op Op, opts ...assetOption) *AssetMutation {
-	m := &AssetMutation{
+// newArchiveReplacementMutation creates new mutation for the ArchiveReplacement entity.
+func newArchiveReplacementMutation(c config, op Op, opts ...archivereplacementOption) *ArchiveReplacementMutation {
+	m := &ArchiveReplacementMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAsset,
+		typ:           TypeArchiveReplacement,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -634,20 +922,20 @@ func newAssetMutation(c config, op Op, opts ...assetOption) *AssetMutation {
 	return m
 }
 
-// withAssetID sets the ID field of the mutation.
-func withAssetID(id int) assetOption {
-	return func(m *AssetMutation) {
+// withArchiveReplacementID sets the ID field of the mutation.
+func withArchiveReplacementID(id int) archivereplacementOption {
+	return func(m *ArchiveReplacementMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Asset
+			value *ArchiveReplacement
 		)
-		m.oldValue = func(ctx context.Context) (*Asset, error) {
+		m.oldValue = func(ctx context.Context) (*ArchiveReplacement, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Asset.Get(ctx, id)
+					value, err = m.Client().ArchiveReplacement.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -656,10 +944,10 @@ func withAssetID(id int) assetOption {
 	}
 }
 
-// withAsset sets the old Asset of the mutation.
-func withAsset(node *Asset) assetOption {
-	return func(m *AssetMutation) {
-		m.oldValue = func(context.Context) (*Asset, error) {
+// withArchiveReplacement sets the old ArchiveReplacement of the mutation.
+func withArchiveReplacement(node *ArchiveReplacement) archivereplacementOption {
+	return func(m *ArchiveReplacementMutation) {
+		m.oldValue = func(context.Context) (*ArchiveReplacement, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -668,7 +956,7 @@ func withAsset(node *Asset) assetOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AssetMutation) Client() *Client {
+func (m ArchiveReplacementMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -676,7 +964,7 @@ func (m AssetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AssetMutation) Tx() (*Tx, error) {
+func (m ArchiveReplacementMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -687,7 +975,7 @@ func (m AssetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AssetMutation) ID() (id int, exists bool) {
+func (m *ArchiveReplacementMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -698,7 +986,7 @@ func (m *AssetMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AssetMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ArchiveReplacementMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -707,19 +995,19 @@ func (m *AssetMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Asset.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchiveReplacement.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AssetMutation) SetCreatedAt(t time.Time) {
+func (m *ArchiveReplacementMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AssetMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ArchiveReplacementMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -727,10 +1015,10 @@ func (m *AssetMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Asset entity.
-// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AssetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveReplacementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -745,17 +1033,17 @@ func (m *AssetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AssetMutation) ResetCreatedAt() {
+func (m *ArchiveReplacementMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *AssetMutation) SetUpdatedAt(t time.Time) {
+func (m *ArchiveReplacementMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AssetMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ArchiveReplacementMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -763,10 +1051,10 @@ func (m *AssetMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Asset entity.
-// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AssetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveReplacementMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -781,145 +1069,199 @@ func (m *AssetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AssetMutation) ResetUpdatedAt() {
+func (m *ArchiveReplacementMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetType sets the "type" field.
-func (m *AssetMutation) SetType(tt types.AssetType) {
-	m._type = &tt
+// SetCoordinate sets the "coordinate" field.
+func (m *ArchiveReplacementMutation) SetCoordinate(s string) {
+	m.coordinate = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *AssetMutation) GetType() (r types.AssetType, exists bool) {
-	v := m._type
+// Coordinate returns the value of the "coordinate" field in the mutation.
+func (m *ArchiveReplacementMutation) Coordinate() (r string, exists bool) {
+	v := m.coordinate
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Asset entity.
-// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// OldCoordinate returns the old "coordinate" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AssetMutation) OldType(ctx context.Context) (v types.AssetType, err error) {
+func (m *ArchiveReplacementMutation) OldCoordinate(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCoordinate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldCoordinate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCoordinate: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Coordinate, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *AssetMutation) ResetType() {
-	m._type = nil
+// ResetCoordinate resets all changes to the "coordinate" field.
+func (m *ArchiveReplacementMutation) ResetCoordinate() {
+	m.coordinate = nil
 }
 
-// SetURI sets the "uri" field.
-func (m *AssetMutation) SetURI(s string) {
-	m.uri = &s
+// SetPreviousHash sets the "previous_hash" field.
+func (m *ArchiveReplacementMutation) SetPreviousHash(s string) {
+	m.previous_hash = &s
 }
 
-// URI returns the value of the "uri" field in the mutation.
-func (m *AssetMutation) URI() (r string, exists bool) {
-	v := m.uri
+// PreviousHash returns the value of the "previous_hash" field in the mutation.
+func (m *ArchiveReplacementMutation) PreviousHash() (r string, exists bool) {
+	v := m.previous_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURI returns the old "uri" field's value of the Asset entity.
-// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousHash returns the old "previous_hash" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AssetMutation) OldURI(ctx context.Context) (v string, err error) {
+func (m *ArchiveReplacementMutation) OldPreviousHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURI requires an ID field in the mutation")
+		return v, errors.New("OldPreviousHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousHash: %w", err)
 	}
-	return oldValue.URI, nil
+	return oldValue.PreviousHash, nil
 }
 
-// ResetURI resets all changes to the "uri" field.
-func (m *AssetMutation) ResetURI() {
-	m.uri = nil
+// ResetPreviousHash resets all changes to the "previous_hash" field.
+func (m *ArchiveReplacementMutation) ResetPreviousHash() {
+	m.previous_hash = nil
 }
 
-// AddSumdbRecordIDs adds the "sumdb_records" edge to the SumDBRecord entity by ids.
-func (m *AssetMutation) AddSumdbRecordIDs(ids ...int) {
-	if m.sumdb_records == nil {
-		m.sumdb_records = make(map[int]struct{})
+// SetHash sets the "hash" field.
+func (m *ArchiveReplacementMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ArchiveReplacementMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.sumdb_records[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveReplacementMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
+	return oldValue.Hash, nil
 }
 
-// ClearSumdbRecords clears the "sumdb_records" edge to the SumDBRecord entity.
-func (m *AssetMutation) ClearSumdbRecords() {
-	m.clearedsumdb_records = true
+// ResetHash resets all changes to the "hash" field.
+func (m *ArchiveReplacementMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SumdbRecordsCleared reports if the "sumdb_records" edge to the SumDBRecord entity was cleared.
-func (m *AssetMutation) SumdbRecordsCleared() bool {
-	return m.clearedsumdb_records
+// SetPrincipal sets the "principal" field.
+func (m *ArchiveReplacementMutation) SetPrincipal(s string) {
+	m.principal = &s
 }
 
-// RemoveSumdbRecordIDs removes the "sumdb_records" edge to the SumDBRecord entity by IDs.
-func (m *AssetMutation) RemoveSumdbRecordIDs(ids ...int) {
-	if m.removedsumdb_records == nil {
-		m.removedsumdb_records = make(map[int]struct{})
+// Principal returns the value of the "principal" field in the mutation.
+func (m *ArchiveReplacementMutation) Principal() (r string, exists bool) {
+	v := m.principal
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.sumdb_records, ids[i])
-		m.removedsumdb_records[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldPrincipal returns the old "principal" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveReplacementMutation) OldPrincipal(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrincipal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrincipal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrincipal: %w", err)
 	}
+	return oldValue.Principal, nil
 }
 
-// RemovedSumdbRecords returns the removed IDs of the "sumdb_records" edge to the SumDBRecord entity.
-func (m *AssetMutation) RemovedSumdbRecordsIDs() (ids []int) {
-	for id := range m.removedsumdb_records {
-		ids = append(ids, id)
+// ResetPrincipal resets all changes to the "principal" field.
+func (m *ArchiveReplacementMutation) ResetPrincipal() {
+	m.principal = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ArchiveReplacementMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ArchiveReplacementMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SumdbRecordsIDs returns the "sumdb_records" edge IDs in the mutation.
-func (m *AssetMutation) SumdbRecordsIDs() (ids []int) {
-	for id := range m.sumdb_records {
-		ids = append(ids, id)
+// OldReason returns the old "reason" field's value of the ArchiveReplacement entity.
+// If the ArchiveReplacement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveReplacementMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// ResetSumdbRecords resets all changes to the "sumdb_records" edge.
-func (m *AssetMutation) ResetSumdbRecords() {
-	m.sumdb_records = nil
-	m.clearedsumdb_records = false
-	m.removedsumdb_records = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *ArchiveReplacementMutation) ResetReason() {
+	m.reason = nil
 }
 
-// Where appends a list predicates to the AssetMutation builder.
-func (m *AssetMutation) Where(ps ...predicate.Asset) {
+// Where appends a list predicates to the ArchiveReplacementMutation builder.
+func (m *ArchiveReplacementMutation) Where(ps ...predicate.ArchiveReplacement) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AssetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ArchiveReplacementMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AssetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Asset, len(ps))
+func (m *ArchiveReplacementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchiveReplacement, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -927,36 +1269,45 @@ func (m *AssetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AssetMutation) Op() Op {
+func (m *ArchiveReplacementMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AssetMutation) SetOp(op Op) {
+func (m *ArchiveReplacementMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Asset).
-func (m *AssetMutation) Type() string {
+// Type returns the node type of this mutation (ArchiveReplacement).
+func (m *ArchiveReplacementMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AssetMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *ArchiveReplacementMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, asset.FieldCreatedAt)
+		fields = append(fields, archivereplacement.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, asset.FieldUpdatedAt)
+		fields = append(fields, archivereplacement.FieldUpdatedAt)
 	}
-	if m._type != nil {
-		fields = append(fields, asset.FieldType)
+	if m.coordinate != nil {
+		fields = append(fields, archivereplacement.FieldCoordinate)
 	}
-	if m.uri != nil {
-		fields = append(fields, asset.FieldURI)
+	if m.previous_hash != nil {
+		fields = append(fields, archivereplacement.FieldPreviousHash)
+	}
+	if m.hash != nil {
+		fields = append(fields, archivereplacement.FieldHash)
+	}
+	if m.principal != nil {
+		fields = append(fields, archivereplacement.FieldPrincipal)
+	}
+	if m.reason != nil {
+		fields = append(fields, archivereplacement.FieldReason)
 	}
 	return fields
 }
@@ -964,16 +1315,22 @@ func (m *AssetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AssetMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchiveReplacementMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case asset.FieldCreatedAt:
+	case archivereplacement.FieldCreatedAt:
 		return m.CreatedAt()
-	case asset.FieldUpdatedAt:
+	case archivereplacement.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case asset.FieldType:
-		return m.GetType()
-	case asset.FieldURI:
-		return m.URI()
+	case archivereplacement.FieldCoordinate:
+		return m.Coordinate()
+	case archivereplacement.FieldPreviousHash:
+		return m.PreviousHash()
+	case archivereplacement.FieldHash:
+		return m.Hash()
+	case archivereplacement.FieldPrincipal:
+		return m.Principal()
+	case archivereplacement.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -981,232 +1338,232 @@ func (m *AssetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AssetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchiveReplacementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case asset.FieldCreatedAt:
+	case archivereplacement.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case asset.FieldUpdatedAt:
+	case archivereplacement.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case asset.FieldType:
-		return m.OldType(ctx)
-	case asset.FieldURI:
-		return m.OldURI(ctx)
+	case archivereplacement.FieldCoordinate:
+		return m.OldCoordinate(ctx)
+	case archivereplacement.FieldPreviousHash:
+		return m.OldPreviousHash(ctx)
+	case archivereplacement.FieldHash:
+		return m.OldHash(ctx)
+	case archivereplacement.FieldPrincipal:
+		return m.OldPrincipal(ctx)
+	case archivereplacement.FieldReason:
+		return m.OldReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown Asset field %s", name)
+	return nil, fmt.Errorf("unknown ArchiveReplacement field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AssetMutation) SetField(name string, value ent.Value) error {
+func (m *ArchiveReplacementMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case asset.FieldCreatedAt:
+	case archivereplacement.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case asset.FieldUpdatedAt:
+	case archivereplacement.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case asset.FieldType:
-		v, ok := value.(types.AssetType)
+	case archivereplacement.FieldCoordinate:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetCoordinate(v)
 		return nil
-	case asset.FieldURI:
+	case archivereplacement.FieldPreviousHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURI(v)
+		m.SetPreviousHash(v)
+		return nil
+	case archivereplacement.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case archivereplacement.FieldPrincipal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrincipal(v)
+		return nil
+	case archivereplacement.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Asset field %s", name)
+	return fmt.Errorf("unknown ArchiveReplacement field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AssetMutation) AddedFields() []string {
+func (m *ArchiveReplacementMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AssetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchiveReplacementMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AssetMutation) AddField(name string, value ent.Value) error {
+func (m *ArchiveReplacementMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Asset numeric field %s", name)
+	return fmt.Errorf("unknown ArchiveReplacement numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AssetMutation) ClearedFields() []string {
+func (m *ArchiveReplacementMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AssetMutation) FieldCleared(name string) bool {
+func (m *ArchiveReplacementMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AssetMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Asset nullable field %s", name)
+func (m *ArchiveReplacementMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ArchiveReplacement nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AssetMutation) ResetField(name string) error {
+func (m *ArchiveReplacementMutation) ResetField(name string) error {
 	switch name {
-	case asset.FieldCreatedAt:
+	case archivereplacement.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case asset.FieldUpdatedAt:
+	case archivereplacement.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case asset.FieldType:
-		m.ResetType()
+	case archivereplacement.FieldCoordinate:
+		m.ResetCoordinate()
 		return nil
-	case asset.FieldURI:
-		m.ResetURI()
+	case archivereplacement.FieldPreviousHash:
+		m.ResetPreviousHash()
+		return nil
+	case archivereplacement.FieldHash:
+		m.ResetHash()
+		return nil
+	case archivereplacement.FieldPrincipal:
+		m.ResetPrincipal()
+		return nil
+	case archivereplacement.FieldReason:
+		m.ResetReason()
 		return nil
 	}
-	return fmt.Errorf("unknown Asset field %s", name)
+	return fmt.Errorf("unknown ArchiveReplacement field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AssetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.sumdb_records != nil {
-		edges = append(edges, asset.EdgeSumdbRecords)
-	}
+func (m *ArchiveReplacementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AssetMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case asset.EdgeSumdbRecords:
-		ids := make([]ent.Value, 0, len(m.sumdb_records))
-		for id := range m.sumdb_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ArchiveReplacementMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AssetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedsumdb_records != nil {
-		edges = append(edges, asset.EdgeSumdbRecords)
-	}
+func (m *ArchiveReplacementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AssetMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case asset.EdgeSumdbRecords:
-		ids := make([]ent.Value, 0, len(m.removedsumdb_records))
-		for id := range m.removedsumdb_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ArchiveReplacementMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AssetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsumdb_records {
-		edges = append(edges, asset.EdgeSumdbRecords)
-	}
+func (m *ArchiveReplacementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AssetMutation) EdgeCleared(name string) bool {
-	switch name {
-	case asset.EdgeSumdbRecords:
-		return m.clearedsumdb_records
-	}
+func (m *ArchiveReplacementMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AssetMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Asset unique edge %s", name)
+func (m *ArchiveReplacementMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ArchiveReplacement unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AssetMutation) ResetEdge(name string) error {
-	switch name {
-	case asset.EdgeSumdbRecords:
-		m.ResetSumdbRecords()
-		return nil
-	}
-	return fmt.Errorf("unknown Asset edge %s", name)
+func (m *ArchiveReplacementMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ArchiveReplacement edge %s", name)
 }
 
-// SumDBHashMutation represents an operation that mutates the SumDBHash nodes in the graph.
-type SumDBHashMutation struct {
+// AssetMutation represents an operation that mutates the Asset nodes in the graph.
+type AssetMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	index         *int64
-	addindex      *int64
-	hash          *[]byte
-	clearedFields map[string]struct{}
-	tree          *int
-	clearedtree   bool
-	done          bool
-	oldValue      func(context.Context) (*SumDBHash, error)
-	predicates    []predicate.SumDBHash
+	op                   Op
+	typ                  string
+	id                   *int
+	created_at           *time.Time
+	updated_at           *time.Time
+	_type                *types.AssetType
+	uri                  *string
+	clearedFields        map[string]struct{}
+	sumdb_records        map[int]struct{}
+	removedsumdb_records map[int]struct{}
+	clearedsumdb_records bool
+	done                 bool
+	oldValue             func(context.Context) (*Asset, error)
+	predicates           []predicate.Asset
 }
 
-var _ ent.Mutation = (*SumDBHashMutation)(nil)
+var _ ent.Mutation = (*AssetMutation)(nil)
 
-// sumdbhashOption allows management of the mutation configuration using functional options.
-type sumdbhashOption func(*SumDBHashMutation)
+// assetOption allows management of the mutation configuration using functional options.
+type assetOption func(*AssetMutation)
 
-// newSumDBHashMutation creates new mutation for the SumDBHash entity.
-func newSumDBHashMutation(c config, op Op, opts ...sumdbhashOption) *SumDBHashMutation {
-	m := &SumDBHashMutation{
+// newAssetMutation creates new mutation for the Asset entity.
+func newAssetMutation(c config, op Op, opts ...assetOption) *AssetMutation {
+	m := &AssetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSumDBHash,
+		typ:           TypeAsset,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1215,20 +1572,20 @@ func newSumDBHashMutation(c config, op Op, opts ...sumdbhashOption) *SumDBHashMu
 	return m
 }
 
-// withSumDBHashID sets the ID field of the mutation.
-func withSumDBHashID(id int) sumdbhashOption {
-	return func(m *SumDBHashMutation) {
+// withAssetID sets the ID field of the mutation.
+func withAssetID(id int) assetOption {
+	return func(m *AssetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SumDBHash
+			value *Asset
 		)
-		m.oldValue = func(ctx context.Context) (*SumDBHash, error) {
+		m.oldValue = func(ctx context.Context) (*Asset, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SumDBHash.Get(ctx, id)
+					value, err = m.Client().Asset.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1237,10 +1594,10 @@ func withSumDBHashID(id int) sumdbhashOption {
 	}
 }
 
-// withSumDBHash sets the old SumDBHash of the mutation.
-func withSumDBHash(node *SumDBHash) sumdbhashOption {
-	return func(m *SumDBHashMutation) {
-		m.oldValue = func(context.Context) (*SumDBHash, error) {
+// withAsset sets the old Asset of the mutation.
+func withAsset(node *Asset) assetOption {
+	return func(m *AssetMutation) {
+		m.oldValue = func(context.Context) (*Asset, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1249,7 +1606,7 @@ func withSumDBHash(node *SumDBHash) sumdbhashOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SumDBHashMutation) Client() *Client {
+func (m AssetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1257,7 +1614,7 @@ func (m SumDBHashMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SumDBHashMutation) Tx() (*Tx, error) {
+func (m AssetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1268,7 +1625,7 @@ func (m SumDBHashMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SumDBHashMutation) ID() (id int, exists bool) {
+func (m *AssetMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1279,7 +1636,7 @@ func (m *SumDBHashMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SumDBHashMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AssetMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1288,19 +1645,19 @@ func (m *SumDBHashMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SumDBHash.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Asset.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SumDBHashMutation) SetCreatedAt(t time.Time) {
+func (m *AssetMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SumDBHashMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AssetMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1308,10 +1665,10 @@ func (m *SumDBHashMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SumDBHash entity.
-// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBHashMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AssetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1326,17 +1683,17 @@ func (m *SumDBHashMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SumDBHashMutation) ResetCreatedAt() {
+func (m *AssetMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SumDBHashMutation) SetUpdatedAt(t time.Time) {
+func (m *AssetMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SumDBHashMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AssetMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1344,10 +1701,10 @@ func (m *SumDBHashMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SumDBHash entity.
-// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBHashMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AssetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1362,150 +1719,145 @@ func (m *SumDBHashMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SumDBHashMutation) ResetUpdatedAt() {
+func (m *AssetMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetIndex sets the "index" field.
-func (m *SumDBHashMutation) SetIndex(i int64) {
-	m.index = &i
-	m.addindex = nil
+// SetType sets the "type" field.
+func (m *AssetMutation) SetType(tt types.AssetType) {
+	m._type = &tt
 }
 
-// Index returns the value of the "index" field in the mutation.
-func (m *SumDBHashMutation) Index() (r int64, exists bool) {
-	v := m.index
+// GetType returns the value of the "type" field in the mutation.
+func (m *AssetMutation) GetType() (r types.AssetType, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIndex returns the old "index" field's value of the SumDBHash entity.
-// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBHashMutation) OldIndex(ctx context.Context) (v int64, err error) {
+func (m *AssetMutation) OldType(ctx context.Context) (v types.AssetType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndex requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
-	}
-	return oldValue.Index, nil
-}
-
-// AddIndex adds i to the "index" field.
-func (m *SumDBHashMutation) AddIndex(i int64) {
-	if m.addindex != nil {
-		*m.addindex += i
-	} else {
-		m.addindex = &i
-	}
-}
-
-// AddedIndex returns the value that was added to the "index" field in this mutation.
-func (m *SumDBHashMutation) AddedIndex() (r int64, exists bool) {
-	v := m.addindex
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return *v, true
+	return oldValue.Type, nil
 }
 
-// ResetIndex resets all changes to the "index" field.
-func (m *SumDBHashMutation) ResetIndex() {
-	m.index = nil
-	m.addindex = nil
+// ResetType resets all changes to the "type" field.
+func (m *AssetMutation) ResetType() {
+	m._type = nil
 }
 
-// SetHash sets the "hash" field.
-func (m *SumDBHashMutation) SetHash(b []byte) {
-	m.hash = &b
+// SetURI sets the "uri" field.
+func (m *AssetMutation) SetURI(s string) {
+	m.uri = &s
 }
 
-// Hash returns the value of the "hash" field in the mutation.
-func (m *SumDBHashMutation) Hash() (r []byte, exists bool) {
-	v := m.hash
+// URI returns the value of the "uri" field in the mutation.
+func (m *AssetMutation) URI() (r string, exists bool) {
+	v := m.uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the SumDBHash entity.
-// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// OldURI returns the old "uri" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBHashMutation) OldHash(ctx context.Context) (v []byte, err error) {
+func (m *AssetMutation) OldURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHash requires an ID field in the mutation")
+		return v, errors.New("OldURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
 	}
-	return oldValue.Hash, nil
+	return oldValue.URI, nil
 }
 
-// ResetHash resets all changes to the "hash" field.
-func (m *SumDBHashMutation) ResetHash() {
-	m.hash = nil
+// ResetURI resets all changes to the "uri" field.
+func (m *AssetMutation) ResetURI() {
+	m.uri = nil
 }
 
-// SetTreeID sets the "tree" edge to the SumDBTree entity by id.
-func (m *SumDBHashMutation) SetTreeID(id int) {
-	m.tree = &id
+// AddSumdbRecordIDs adds the "sumdb_records" edge to the SumDBRecord entity by ids.
+func (m *AssetMutation) AddSumdbRecordIDs(ids ...int) {
+	if m.sumdb_records == nil {
+		m.sumdb_records = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sumdb_records[ids[i]] = struct{}{}
+	}
 }
 
-// ClearTree clears the "tree" edge to the SumDBTree entity.
-func (m *SumDBHashMutation) ClearTree() {
-	m.clearedtree = true
+// ClearSumdbRecords clears the "sumdb_records" edge to the SumDBRecord entity.
+func (m *AssetMutation) ClearSumdbRecords() {
+	m.clearedsumdb_records = true
 }
 
-// TreeCleared reports if the "tree" edge to the SumDBTree entity was cleared.
-func (m *SumDBHashMutation) TreeCleared() bool {
-	return m.clearedtree
+// SumdbRecordsCleared reports if the "sumdb_records" edge to the SumDBRecord entity was cleared.
+func (m *AssetMutation) SumdbRecordsCleared() bool {
+	return m.clearedsumdb_records
 }
 
-// TreeID returns the "tree" edge ID in the mutation.
-func (m *SumDBHashMutation) TreeID() (id int, exists bool) {
-	if m.tree != nil {
-		return *m.tree, true
+// RemoveSumdbRecordIDs removes the "sumdb_records" edge to the SumDBRecord entity by IDs.
+func (m *AssetMutation) RemoveSumdbRecordIDs(ids ...int) {
+	if m.removedsumdb_records == nil {
+		m.removedsumdb_records = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sumdb_records, ids[i])
+		m.removedsumdb_records[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSumdbRecords returns the removed IDs of the "sumdb_records" edge to the SumDBRecord entity.
+func (m *AssetMutation) RemovedSumdbRecordsIDs() (ids []int) {
+	for id := range m.removedsumdb_records {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// TreeIDs returns the "tree" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TreeID instead. It exists only for internal usage by the builders.
-func (m *SumDBHashMutation) TreeIDs() (ids []int) {
-	if id := m.tree; id != nil {
-		ids = append(ids, *id)
+// SumdbRecordsIDs returns the "sumdb_records" edge IDs in the mutation.
+func (m *AssetMutation) SumdbRecordsIDs() (ids []int) {
+	for id := range m.sumdb_records {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTree resets all changes to the "tree" edge.
-func (m *SumDBHashMutation) ResetTree() {
-	m.tree = nil
-	m.clearedtree = false
+// ResetSumdbRecords resets all changes to the "sumdb_records" edge.
+func (m *AssetMutation) ResetSumdbRecords() {
+	m.sumdb_records = nil
+	m.clearedsumdb_records = false
+	m.removedsumdb_records = nil
 }
 
-// Where appends a list predicates to the SumDBHashMutation builder.
-func (m *SumDBHashMutation) Where(ps ...predicate.SumDBHash) {
+// Where appends a list predicates to the AssetMutation builder.
+func (m *AssetMutation) Where(ps ...predicate.Asset) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SumDBHashMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AssetMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SumDBHashMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SumDBHash, len(ps))
+func (m *AssetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Asset, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1513,36 +1865,36 @@ func (m *SumDBHashMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SumDBHashMutation) Op() Op {
+func (m *AssetMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SumDBHashMutation) SetOp(op Op) {
+func (m *AssetMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SumDBHash).
-func (m *SumDBHashMutation) Type() string {
+// Type returns the node type of this mutation (Asset).
+func (m *AssetMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SumDBHashMutation) Fields() []string {
+func (m *AssetMutation) Fields() []string {
 	fields := make([]string, 0, 4)
 	if m.created_at != nil {
-		fields = append(fields, sumdbhash.FieldCreatedAt)
+		fields = append(fields, asset.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, sumdbhash.FieldUpdatedAt)
+		fields = append(fields, asset.FieldUpdatedAt)
 	}
-	if m.index != nil {
-		fields = append(fields, sumdbhash.FieldIndex)
+	if m._type != nil {
+		fields = append(fields, asset.FieldType)
 	}
-	if m.hash != nil {
-		fields = append(fields, sumdbhash.FieldHash)
+	if m.uri != nil {
+		fields = append(fields, asset.FieldURI)
 	}
 	return fields
 }
@@ -1550,16 +1902,16 @@ func (m *SumDBHashMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SumDBHashMutation) Field(name string) (ent.Value, bool) {
+func (m *AssetMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sumdbhash.FieldCreatedAt:
+	case asset.FieldCreatedAt:
 		return m.CreatedAt()
-	case sumdbhash.FieldUpdatedAt:
+	case asset.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case sumdbhash.FieldIndex:
-		return m.Index()
-	case sumdbhash.FieldHash:
-		return m.Hash()
+	case asset.FieldType:
+		return m.GetType()
+	case asset.FieldURI:
+		return m.URI()
 	}
 	return nil, false
 }
@@ -1567,242 +1919,229 @@ func (m *SumDBHashMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SumDBHashMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AssetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sumdbhash.FieldCreatedAt:
+	case asset.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case sumdbhash.FieldUpdatedAt:
+	case asset.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case sumdbhash.FieldIndex:
-		return m.OldIndex(ctx)
-	case sumdbhash.FieldHash:
-		return m.OldHash(ctx)
+	case asset.FieldType:
+		return m.OldType(ctx)
+	case asset.FieldURI:
+		return m.OldURI(ctx)
 	}
-	return nil, fmt.Errorf("unknown SumDBHash field %s", name)
+	return nil, fmt.Errorf("unknown Asset field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SumDBHashMutation) SetField(name string, value ent.Value) error {
+func (m *AssetMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sumdbhash.FieldCreatedAt:
+	case asset.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case sumdbhash.FieldUpdatedAt:
+	case asset.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case sumdbhash.FieldIndex:
-		v, ok := value.(int64)
+	case asset.FieldType:
+		v, ok := value.(types.AssetType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIndex(v)
+		m.SetType(v)
 		return nil
-	case sumdbhash.FieldHash:
-		v, ok := value.([]byte)
+	case asset.FieldURI:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHash(v)
+		m.SetURI(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBHash field %s", name)
+	return fmt.Errorf("unknown Asset field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SumDBHashMutation) AddedFields() []string {
-	var fields []string
-	if m.addindex != nil {
-		fields = append(fields, sumdbhash.FieldIndex)
-	}
-	return fields
+func (m *AssetMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SumDBHashMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case sumdbhash.FieldIndex:
-		return m.AddedIndex()
-	}
+func (m *AssetMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SumDBHashMutation) AddField(name string, value ent.Value) error {
+func (m *AssetMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sumdbhash.FieldIndex:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIndex(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SumDBHash numeric field %s", name)
+	return fmt.Errorf("unknown Asset numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SumDBHashMutation) ClearedFields() []string {
+func (m *AssetMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SumDBHashMutation) FieldCleared(name string) bool {
+func (m *AssetMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SumDBHashMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SumDBHash nullable field %s", name)
+func (m *AssetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Asset nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SumDBHashMutation) ResetField(name string) error {
+func (m *AssetMutation) ResetField(name string) error {
 	switch name {
-	case sumdbhash.FieldCreatedAt:
+	case asset.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case sumdbhash.FieldUpdatedAt:
+	case asset.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case sumdbhash.FieldIndex:
-		m.ResetIndex()
+	case asset.FieldType:
+		m.ResetType()
 		return nil
-	case sumdbhash.FieldHash:
-		m.ResetHash()
+	case asset.FieldURI:
+		m.ResetURI()
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBHash field %s", name)
+	return fmt.Errorf("unknown Asset field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SumDBHashMutation) AddedEdges() []string {
+func (m *AssetMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.tree != nil {
-		edges = append(edges, sumdbhash.EdgeTree)
+	if m.sumdb_records != nil {
+		edges = append(edges, asset.EdgeSumdbRecords)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SumDBHashMutation) AddedIDs(name string) []ent.Value {
+func (m *AssetMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case sumdbhash.EdgeTree:
-		if id := m.tree; id != nil {
-			return []ent.Value{*id}
+	case asset.EdgeSumdbRecords:
+		ids := make([]ent.Value, 0, len(m.sumdb_records))
+		for id := range m.sumdb_records {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SumDBHashMutation) RemovedEdges() []string {
+func (m *AssetMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedsumdb_records != nil {
+		edges = append(edges, asset.EdgeSumdbRecords)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SumDBHashMutation) RemovedIDs(name string) []ent.Value {
+func (m *AssetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case asset.EdgeSumdbRecords:
+		ids := make([]ent.Value, 0, len(m.removedsumdb_records))
+		for id := range m.removedsumdb_records {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SumDBHashMutation) ClearedEdges() []string {
+func (m *AssetMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtree {
-		edges = append(edges, sumdbhash.EdgeTree)
+	if m.clearedsumdb_records {
+		edges = append(edges, asset.EdgeSumdbRecords)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SumDBHashMutation) EdgeCleared(name string) bool {
+func (m *AssetMutation) EdgeCleared(name string) bool {
 	switch name {
-	case sumdbhash.EdgeTree:
-		return m.clearedtree
+	case asset.EdgeSumdbRecords:
+		return m.clearedsumdb_records
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SumDBHashMutation) ClearEdge(name string) error {
+func (m *AssetMutation) ClearEdge(name string) error {
 	switch name {
-	case sumdbhash.EdgeTree:
-		m.ClearTree()
-		return nil
 	}
-	return fmt.Errorf("unknown SumDBHash unique edge %s", name)
+	return fmt.Errorf("unknown Asset unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SumDBHashMutation) ResetEdge(name string) error {
+func (m *AssetMutation) ResetEdge(name string) error {
 	switch name {
-	case sumdbhash.EdgeTree:
-		m.ResetTree()
+	case asset.EdgeSumdbRecords:
+		m.ResetSumdbRecords()
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBHash edge %s", name)
+	return fmt.Errorf("unknown Asset edge %s", name)
 }
 
-// SumDBRecordMutation represents an operation that mutates the SumDBRecord nodes in the graph.
-type SumDBRecordMutation struct {
+// DeprecationMutation represents an operation that mutates the Deprecation nodes in the graph.
+type DeprecationMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	created_at    *time.Time
 	updated_at    *time.Time
-	record_id     *int64
-	addrecord_id  *int64
 	_path         *string
-	version       *string
-	data          *[]byte
+	message       *string
 	clearedFields map[string]struct{}
-	assets        map[int]struct{}
-	removedassets map[int]struct{}
-	clearedassets bool
-	tree          *int
-	clearedtree   bool
 	done          bool
-	oldValue      func(context.Context) (*SumDBRecord, error)
-	predicates    []predicate.SumDBRecord
+	oldValue      func(context.Context) (*Deprecation, error)
+	predicates    []predicate.Deprecation
 }
 
-var _ ent.Mutation = (*SumDBRecordMutation)(nil)
+var _ ent.Mutation = (*DeprecationMutation)(nil)
 
-// sumdbrecordOption allows management of the mutation configuration using functional options.
-type sumdbrecordOption func(*SumDBRecordMutation)
+// deprecationOption allows management of the mutation configuration using functional options.
+type deprecationOption func(*DeprecationMutation)
 
-// newSumDBRecordMutation creates new mutation for the SumDBRecord entity.
-func newSumDBRecordMutation(c config, op Op, opts ...sumdbrecordOption) *SumDBRecordMutation {
-	m := &SumDBRecordMutation{
+// newDeprecationMutation creates new mutation for the Deprecation entity.
+func newDeprecationMutation(c config, op Op, opts ...deprecationOption) *DeprecationMutation {
+	m := &DeprecationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSumDBRecord,
+		typ:           TypeDeprecation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1811,20 +2150,20 @@ func newSumDBRecordMutation(c config, op Op, opts ...sumdbrecordOption) *SumDBRe
 	return m
 }
 
-// withSumDBRecordID sets the ID field of the mutation.
-func withSumDBRecordID(id int) sumdbrecordOption {
-	return func(m *SumDBRecordMutation) {
+// withDeprecationID sets the ID field of the mutation.
+func withDeprecationID(id int) deprecationOption {
+	return func(m *DeprecationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SumDBRecord
+			value *Deprecation
 		)
-		m.oldValue = func(ctx context.Context) (*SumDBRecord, error) {
+		m.oldValue = func(ctx context.Context) (*Deprecation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SumDBRecord.Get(ctx, id)
+					value, err = m.Client().Deprecation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1833,10 +2172,10 @@ func withSumDBRecordID(id int) sumdbrecordOption {
 	}
 }
 
-// withSumDBRecord sets the old SumDBRecord of the mutation.
-func withSumDBRecord(node *SumDBRecord) sumdbrecordOption {
-	return func(m *SumDBRecordMutation) {
-		m.oldValue = func(context.Context) (*SumDBRecord, error) {
+// withDeprecation sets the old Deprecation of the mutation.
+func withDeprecation(node *Deprecation) deprecationOption {
+	return func(m *DeprecationMutation) {
+		m.oldValue = func(context.Context) (*Deprecation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1845,7 +2184,7 @@ func withSumDBRecord(node *SumDBRecord) sumdbrecordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SumDBRecordMutation) Client() *Client {
+func (m DeprecationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1853,7 +2192,7 @@ func (m SumDBRecordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SumDBRecordMutation) Tx() (*Tx, error) {
+func (m DeprecationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1864,7 +2203,7 @@ func (m SumDBRecordMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SumDBRecordMutation) ID() (id int, exists bool) {
+func (m *DeprecationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1875,7 +2214,7 @@ func (m *SumDBRecordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SumDBRecordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeprecationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1884,19 +2223,19 @@ func (m *SumDBRecordMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SumDBRecord.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Deprecation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SumDBRecordMutation) SetCreatedAt(t time.Time) {
+func (m *DeprecationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SumDBRecordMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeprecationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1904,10 +2243,10 @@ func (m *SumDBRecordMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SumDBRecord entity.
-// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Deprecation entity.
+// If the Deprecation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeprecationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1922,17 +2261,17 @@ func (m *SumDBRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SumDBRecordMutation) ResetCreatedAt() {
+func (m *DeprecationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SumDBRecordMutation) SetUpdatedAt(t time.Time) {
+func (m *DeprecationMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SumDBRecordMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DeprecationMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1940,10 +2279,10 @@ func (m *SumDBRecordMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SumDBRecord entity.
-// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Deprecation entity.
+// If the Deprecation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBRecordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeprecationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1958,108 +2297,2991 @@ func (m *SumDBRecordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SumDBRecordMutation) ResetUpdatedAt() {
+func (m *DeprecationMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetRecordID sets the "record_id" field.
-func (m *SumDBRecordMutation) SetRecordID(i int64) {
-	m.record_id = &i
-	m.addrecord_id = nil
+// SetPath sets the "path" field.
+func (m *DeprecationMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// RecordID returns the value of the "record_id" field in the mutation.
-func (m *SumDBRecordMutation) RecordID() (r int64, exists bool) {
-	v := m.record_id
+// Path returns the value of the "path" field in the mutation.
+func (m *DeprecationMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRecordID returns the old "record_id" field's value of the SumDBRecord entity.
-// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Deprecation entity.
+// If the Deprecation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBRecordMutation) OldRecordID(ctx context.Context) (v int64, err error) {
+func (m *DeprecationMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRecordID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRecordID requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRecordID: %w", err)
-	}
-	return oldValue.RecordID, nil
-}
-
-// AddRecordID adds i to the "record_id" field.
-func (m *SumDBRecordMutation) AddRecordID(i int64) {
-	if m.addrecord_id != nil {
-		*m.addrecord_id += i
-	} else {
-		m.addrecord_id = &i
-	}
-}
-
-// AddedRecordID returns the value that was added to the "record_id" field in this mutation.
-func (m *SumDBRecordMutation) AddedRecordID() (r int64, exists bool) {
-	v := m.addrecord_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return *v, true
+	return oldValue.Path, nil
 }
 
-// ResetRecordID resets all changes to the "record_id" field.
-func (m *SumDBRecordMutation) ResetRecordID() {
-	m.record_id = nil
-	m.addrecord_id = nil
+// ResetPath resets all changes to the "path" field.
+func (m *DeprecationMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetPath sets the "path" field.
-func (m *SumDBRecordMutation) SetPath(s string) {
-	m._path = &s
+// SetMessage sets the "message" field.
+func (m *DeprecationMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *SumDBRecordMutation) Path() (r string, exists bool) {
-	v := m._path
+// Message returns the value of the "message" field in the mutation.
+func (m *DeprecationMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the SumDBRecord entity.
-// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the Deprecation entity.
+// If the Deprecation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBRecordMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *DeprecationMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.Message, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *SumDBRecordMutation) ResetPath() {
-	m._path = nil
+// ResetMessage resets all changes to the "message" field.
+func (m *DeprecationMutation) ResetMessage() {
+	m.message = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *SumDBRecordMutation) SetVersion(s string) {
-	m.version = &s
+// Where appends a list predicates to the DeprecationMutation builder.
+func (m *DeprecationMutation) Where(ps ...predicate.Deprecation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Version returns the value of the "version" field in the mutation.
+// WhereP appends storage-level predicates to the DeprecationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeprecationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Deprecation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeprecationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeprecationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Deprecation).
+func (m *DeprecationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeprecationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, deprecation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deprecation.FieldUpdatedAt)
+	}
+	if m._path != nil {
+		fields = append(fields, deprecation.FieldPath)
+	}
+	if m.message != nil {
+		fields = append(fields, deprecation.FieldMessage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeprecationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deprecation.FieldCreatedAt:
+		return m.CreatedAt()
+	case deprecation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case deprecation.FieldPath:
+		return m.Path()
+	case deprecation.FieldMessage:
+		return m.Message()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeprecationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deprecation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deprecation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case deprecation.FieldPath:
+		return m.OldPath(ctx)
+	case deprecation.FieldMessage:
+		return m.OldMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown Deprecation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeprecationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deprecation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deprecation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case deprecation.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case deprecation.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Deprecation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeprecationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeprecationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeprecationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Deprecation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeprecationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeprecationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeprecationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Deprecation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeprecationMutation) ResetField(name string) error {
+	switch name {
+	case deprecation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deprecation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case deprecation.FieldPath:
+		m.ResetPath()
+		return nil
+	case deprecation.FieldMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Deprecation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeprecationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeprecationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeprecationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeprecationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeprecationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeprecationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeprecationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Deprecation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeprecationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Deprecation edge %s", name)
+}
+
+// RetractionMutation represents an operation that mutates the Retraction nodes in the graph.
+type RetractionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	_path         *string
+	version       *string
+	rationale     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Retraction, error)
+	predicates    []predicate.Retraction
+}
+
+var _ ent.Mutation = (*RetractionMutation)(nil)
+
+// retractionOption allows management of the mutation configuration using functional options.
+type retractionOption func(*RetractionMutation)
+
+// newRetractionMutation creates new mutation for the Retraction entity.
+func newRetractionMutation(c config, op Op, opts ...retractionOption) *RetractionMutation {
+	m := &RetractionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRetraction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRetractionID sets the ID field of the mutation.
+func withRetractionID(id int) retractionOption {
+	return func(m *RetractionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Retraction
+		)
+		m.oldValue = func(ctx context.Context) (*Retraction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Retraction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRetraction sets the old Retraction of the mutation.
+func withRetraction(node *Retraction) retractionOption {
+	return func(m *RetractionMutation) {
+		m.oldValue = func(context.Context) (*Retraction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RetractionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RetractionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RetractionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RetractionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Retraction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RetractionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RetractionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Retraction entity.
+// If the Retraction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetractionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RetractionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RetractionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RetractionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Retraction entity.
+// If the Retraction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetractionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RetractionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPath sets the "path" field.
+func (m *RetractionMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *RetractionMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Retraction entity.
+// If the Retraction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetractionMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *RetractionMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *RetractionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *RetractionMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Retraction entity.
+// If the Retraction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetractionMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *RetractionMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetRationale sets the "rationale" field.
+func (m *RetractionMutation) SetRationale(s string) {
+	m.rationale = &s
+}
+
+// Rationale returns the value of the "rationale" field in the mutation.
+func (m *RetractionMutation) Rationale() (r string, exists bool) {
+	v := m.rationale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRationale returns the old "rationale" field's value of the Retraction entity.
+// If the Retraction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetractionMutation) OldRationale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRationale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRationale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRationale: %w", err)
+	}
+	return oldValue.Rationale, nil
+}
+
+// ResetRationale resets all changes to the "rationale" field.
+func (m *RetractionMutation) ResetRationale() {
+	m.rationale = nil
+}
+
+// Where appends a list predicates to the RetractionMutation builder.
+func (m *RetractionMutation) Where(ps ...predicate.Retraction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RetractionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RetractionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Retraction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RetractionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RetractionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Retraction).
+func (m *RetractionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RetractionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, retraction.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, retraction.FieldUpdatedAt)
+	}
+	if m._path != nil {
+		fields = append(fields, retraction.FieldPath)
+	}
+	if m.version != nil {
+		fields = append(fields, retraction.FieldVersion)
+	}
+	if m.rationale != nil {
+		fields = append(fields, retraction.FieldRationale)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RetractionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case retraction.FieldCreatedAt:
+		return m.CreatedAt()
+	case retraction.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case retraction.FieldPath:
+		return m.Path()
+	case retraction.FieldVersion:
+		return m.Version()
+	case retraction.FieldRationale:
+		return m.Rationale()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RetractionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case retraction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case retraction.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case retraction.FieldPath:
+		return m.OldPath(ctx)
+	case retraction.FieldVersion:
+		return m.OldVersion(ctx)
+	case retraction.FieldRationale:
+		return m.OldRationale(ctx)
+	}
+	return nil, fmt.Errorf("unknown Retraction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetractionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case retraction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case retraction.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case retraction.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case retraction.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case retraction.FieldRationale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRationale(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Retraction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RetractionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RetractionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetractionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Retraction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RetractionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RetractionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RetractionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Retraction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RetractionMutation) ResetField(name string) error {
+	switch name {
+	case retraction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case retraction.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case retraction.FieldPath:
+		m.ResetPath()
+		return nil
+	case retraction.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case retraction.FieldRationale:
+		m.ResetRationale()
+		return nil
+	}
+	return fmt.Errorf("unknown Retraction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RetractionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RetractionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RetractionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RetractionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RetractionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RetractionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RetractionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Retraction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RetractionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Retraction edge %s", name)
+}
+
+// SumDBCosignatureMutation represents an operation that mutates the SumDBCosignature nodes in the graph.
+type SumDBCosignatureMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	witness       *string
+	key_hash      *uint32
+	addkey_hash   *int32
+	signature     *string
+	clearedFields map[string]struct{}
+	head          *int
+	clearedhead   bool
+	done          bool
+	oldValue      func(context.Context) (*SumDBCosignature, error)
+	predicates    []predicate.SumDBCosignature
+}
+
+var _ ent.Mutation = (*SumDBCosignatureMutation)(nil)
+
+// sumdbcosignatureOption allows management of the mutation configuration using functional options.
+type sumdbcosignatureOption func(*SumDBCosignatureMutation)
+
+// newSumDBCosignatureMutation creates new mutation for the SumDBCosignature entity.
+func newSumDBCosignatureMutation(c config, op Op, opts ...sumdbcosignatureOption) *SumDBCosignatureMutation {
+	m := &SumDBCosignatureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSumDBCosignature,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSumDBCosignatureID sets the ID field of the mutation.
+func withSumDBCosignatureID(id int) sumdbcosignatureOption {
+	return func(m *SumDBCosignatureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SumDBCosignature
+		)
+		m.oldValue = func(ctx context.Context) (*SumDBCosignature, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SumDBCosignature.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSumDBCosignature sets the old SumDBCosignature of the mutation.
+func withSumDBCosignature(node *SumDBCosignature) sumdbcosignatureOption {
+	return func(m *SumDBCosignatureMutation) {
+		m.oldValue = func(context.Context) (*SumDBCosignature, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SumDBCosignatureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SumDBCosignatureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SumDBCosignatureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SumDBCosignatureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SumDBCosignature.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SumDBCosignatureMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SumDBCosignatureMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SumDBCosignature entity.
+// If the SumDBCosignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBCosignatureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SumDBCosignatureMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SumDBCosignatureMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SumDBCosignatureMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SumDBCosignature entity.
+// If the SumDBCosignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBCosignatureMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SumDBCosignatureMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetWitness sets the "witness" field.
+func (m *SumDBCosignatureMutation) SetWitness(s string) {
+	m.witness = &s
+}
+
+// Witness returns the value of the "witness" field in the mutation.
+func (m *SumDBCosignatureMutation) Witness() (r string, exists bool) {
+	v := m.witness
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWitness returns the old "witness" field's value of the SumDBCosignature entity.
+// If the SumDBCosignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBCosignatureMutation) OldWitness(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWitness is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWitness requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWitness: %w", err)
+	}
+	return oldValue.Witness, nil
+}
+
+// ResetWitness resets all changes to the "witness" field.
+func (m *SumDBCosignatureMutation) ResetWitness() {
+	m.witness = nil
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (m *SumDBCosignatureMutation) SetKeyHash(u uint32) {
+	m.key_hash = &u
+	m.addkey_hash = nil
+}
+
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *SumDBCosignatureMutation) KeyHash() (r uint32, exists bool) {
+	v := m.key_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyHash returns the old "key_hash" field's value of the SumDBCosignature entity.
+// If the SumDBCosignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBCosignatureMutation) OldKeyHash(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
+	}
+	return oldValue.KeyHash, nil
+}
+
+// AddKeyHash adds u to the "key_hash" field.
+func (m *SumDBCosignatureMutation) AddKeyHash(u int32) {
+	if m.addkey_hash != nil {
+		*m.addkey_hash += u
+	} else {
+		m.addkey_hash = &u
+	}
+}
+
+// AddedKeyHash returns the value that was added to the "key_hash" field in this mutation.
+func (m *SumDBCosignatureMutation) AddedKeyHash() (r int32, exists bool) {
+	v := m.addkey_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *SumDBCosignatureMutation) ResetKeyHash() {
+	m.key_hash = nil
+	m.addkey_hash = nil
+}
+
+// SetSignature sets the "signature" field.
+func (m *SumDBCosignatureMutation) SetSignature(s string) {
+	m.signature = &s
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *SumDBCosignatureMutation) Signature() (r string, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the SumDBCosignature entity.
+// If the SumDBCosignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBCosignatureMutation) OldSignature(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *SumDBCosignatureMutation) ResetSignature() {
+	m.signature = nil
+}
+
+// SetHeadID sets the "head" edge to the SumDBTreeHead entity by id.
+func (m *SumDBCosignatureMutation) SetHeadID(id int) {
+	m.head = &id
+}
+
+// ClearHead clears the "head" edge to the SumDBTreeHead entity.
+func (m *SumDBCosignatureMutation) ClearHead() {
+	m.clearedhead = true
+}
+
+// HeadCleared reports if the "head" edge to the SumDBTreeHead entity was cleared.
+func (m *SumDBCosignatureMutation) HeadCleared() bool {
+	return m.clearedhead
+}
+
+// HeadID returns the "head" edge ID in the mutation.
+func (m *SumDBCosignatureMutation) HeadID() (id int, exists bool) {
+	if m.head != nil {
+		return *m.head, true
+	}
+	return
+}
+
+// HeadIDs returns the "head" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HeadID instead. It exists only for internal usage by the builders.
+func (m *SumDBCosignatureMutation) HeadIDs() (ids []int) {
+	if id := m.head; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHead resets all changes to the "head" edge.
+func (m *SumDBCosignatureMutation) ResetHead() {
+	m.head = nil
+	m.clearedhead = false
+}
+
+// Where appends a list predicates to the SumDBCosignatureMutation builder.
+func (m *SumDBCosignatureMutation) Where(ps ...predicate.SumDBCosignature) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SumDBCosignatureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SumDBCosignatureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SumDBCosignature, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SumDBCosignatureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SumDBCosignatureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SumDBCosignature).
+func (m *SumDBCosignatureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SumDBCosignatureMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, sumdbcosignature.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sumdbcosignature.FieldUpdatedAt)
+	}
+	if m.witness != nil {
+		fields = append(fields, sumdbcosignature.FieldWitness)
+	}
+	if m.key_hash != nil {
+		fields = append(fields, sumdbcosignature.FieldKeyHash)
+	}
+	if m.signature != nil {
+		fields = append(fields, sumdbcosignature.FieldSignature)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SumDBCosignatureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbcosignature.FieldCreatedAt:
+		return m.CreatedAt()
+	case sumdbcosignature.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case sumdbcosignature.FieldWitness:
+		return m.Witness()
+	case sumdbcosignature.FieldKeyHash:
+		return m.KeyHash()
+	case sumdbcosignature.FieldSignature:
+		return m.Signature()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SumDBCosignatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sumdbcosignature.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sumdbcosignature.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case sumdbcosignature.FieldWitness:
+		return m.OldWitness(ctx)
+	case sumdbcosignature.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case sumdbcosignature.FieldSignature:
+		return m.OldSignature(ctx)
+	}
+	return nil, fmt.Errorf("unknown SumDBCosignature field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBCosignatureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sumdbcosignature.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sumdbcosignature.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case sumdbcosignature.FieldWitness:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWitness(v)
+		return nil
+	case sumdbcosignature.FieldKeyHash:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyHash(v)
+		return nil
+	case sumdbcosignature.FieldSignature:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBCosignature field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SumDBCosignatureMutation) AddedFields() []string {
+	var fields []string
+	if m.addkey_hash != nil {
+		fields = append(fields, sumdbcosignature.FieldKeyHash)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SumDBCosignatureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbcosignature.FieldKeyHash:
+		return m.AddedKeyHash()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBCosignatureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sumdbcosignature.FieldKeyHash:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeyHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBCosignature numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SumDBCosignatureMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SumDBCosignatureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SumDBCosignatureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SumDBCosignature nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SumDBCosignatureMutation) ResetField(name string) error {
+	switch name {
+	case sumdbcosignature.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sumdbcosignature.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case sumdbcosignature.FieldWitness:
+		m.ResetWitness()
+		return nil
+	case sumdbcosignature.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case sumdbcosignature.FieldSignature:
+		m.ResetSignature()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBCosignature field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SumDBCosignatureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.head != nil {
+		edges = append(edges, sumdbcosignature.EdgeHead)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SumDBCosignatureMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sumdbcosignature.EdgeHead:
+		if id := m.head; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SumDBCosignatureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SumDBCosignatureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SumDBCosignatureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedhead {
+		edges = append(edges, sumdbcosignature.EdgeHead)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SumDBCosignatureMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sumdbcosignature.EdgeHead:
+		return m.clearedhead
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SumDBCosignatureMutation) ClearEdge(name string) error {
+	switch name {
+	case sumdbcosignature.EdgeHead:
+		m.ClearHead()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBCosignature unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SumDBCosignatureMutation) ResetEdge(name string) error {
+	switch name {
+	case sumdbcosignature.EdgeHead:
+		m.ResetHead()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBCosignature edge %s", name)
+}
+
+// SumDBHashMutation represents an operation that mutates the SumDBHash nodes in the graph.
+type SumDBHashMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	index         *int64
+	addindex      *int64
+	hash          *[]byte
+	clearedFields map[string]struct{}
+	tree          *int
+	clearedtree   bool
+	done          bool
+	oldValue      func(context.Context) (*SumDBHash, error)
+	predicates    []predicate.SumDBHash
+}
+
+var _ ent.Mutation = (*SumDBHashMutation)(nil)
+
+// sumdbhashOption allows management of the mutation configuration using functional options.
+type sumdbhashOption func(*SumDBHashMutation)
+
+// newSumDBHashMutation creates new mutation for the SumDBHash entity.
+func newSumDBHashMutation(c config, op Op, opts ...sumdbhashOption) *SumDBHashMutation {
+	m := &SumDBHashMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSumDBHash,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSumDBHashID sets the ID field of the mutation.
+func withSumDBHashID(id int) sumdbhashOption {
+	return func(m *SumDBHashMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SumDBHash
+		)
+		m.oldValue = func(ctx context.Context) (*SumDBHash, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SumDBHash.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSumDBHash sets the old SumDBHash of the mutation.
+func withSumDBHash(node *SumDBHash) sumdbhashOption {
+	return func(m *SumDBHashMutation) {
+		m.oldValue = func(context.Context) (*SumDBHash, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SumDBHashMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SumDBHashMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SumDBHashMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SumDBHashMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SumDBHash.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SumDBHashMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SumDBHashMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SumDBHash entity.
+// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBHashMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SumDBHashMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SumDBHashMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SumDBHashMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SumDBHash entity.
+// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBHashMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SumDBHashMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetIndex sets the "index" field.
+func (m *SumDBHashMutation) SetIndex(i int64) {
+	m.index = &i
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *SumDBHashMutation) Index() (r int64, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the SumDBHash entity.
+// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBHashMutation) OldIndex(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds i to the "index" field.
+func (m *SumDBHashMutation) AddIndex(i int64) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *SumDBHashMutation) AddedIndex() (r int64, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *SumDBHashMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *SumDBHashMutation) SetHash(b []byte) {
+	m.hash = &b
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *SumDBHashMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the SumDBHash entity.
+// If the SumDBHash object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBHashMutation) OldHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *SumDBHashMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetTreeID sets the "tree" edge to the SumDBTree entity by id.
+func (m *SumDBHashMutation) SetTreeID(id int) {
+	m.tree = &id
+}
+
+// ClearTree clears the "tree" edge to the SumDBTree entity.
+func (m *SumDBHashMutation) ClearTree() {
+	m.clearedtree = true
+}
+
+// TreeCleared reports if the "tree" edge to the SumDBTree entity was cleared.
+func (m *SumDBHashMutation) TreeCleared() bool {
+	return m.clearedtree
+}
+
+// TreeID returns the "tree" edge ID in the mutation.
+func (m *SumDBHashMutation) TreeID() (id int, exists bool) {
+	if m.tree != nil {
+		return *m.tree, true
+	}
+	return
+}
+
+// TreeIDs returns the "tree" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TreeID instead. It exists only for internal usage by the builders.
+func (m *SumDBHashMutation) TreeIDs() (ids []int) {
+	if id := m.tree; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTree resets all changes to the "tree" edge.
+func (m *SumDBHashMutation) ResetTree() {
+	m.tree = nil
+	m.clearedtree = false
+}
+
+// Where appends a list predicates to the SumDBHashMutation builder.
+func (m *SumDBHashMutation) Where(ps ...predicate.SumDBHash) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SumDBHashMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SumDBHashMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SumDBHash, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SumDBHashMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SumDBHashMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SumDBHash).
+func (m *SumDBHashMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SumDBHashMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, sumdbhash.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sumdbhash.FieldUpdatedAt)
+	}
+	if m.index != nil {
+		fields = append(fields, sumdbhash.FieldIndex)
+	}
+	if m.hash != nil {
+		fields = append(fields, sumdbhash.FieldHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SumDBHashMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbhash.FieldCreatedAt:
+		return m.CreatedAt()
+	case sumdbhash.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case sumdbhash.FieldIndex:
+		return m.Index()
+	case sumdbhash.FieldHash:
+		return m.Hash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SumDBHashMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sumdbhash.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sumdbhash.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case sumdbhash.FieldIndex:
+		return m.OldIndex(ctx)
+	case sumdbhash.FieldHash:
+		return m.OldHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown SumDBHash field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBHashMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sumdbhash.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sumdbhash.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case sumdbhash.FieldIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case sumdbhash.FieldHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBHash field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SumDBHashMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, sumdbhash.FieldIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SumDBHashMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbhash.FieldIndex:
+		return m.AddedIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBHashMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sumdbhash.FieldIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBHash numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SumDBHashMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SumDBHashMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SumDBHashMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SumDBHash nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SumDBHashMutation) ResetField(name string) error {
+	switch name {
+	case sumdbhash.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sumdbhash.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case sumdbhash.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case sumdbhash.FieldHash:
+		m.ResetHash()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBHash field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SumDBHashMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tree != nil {
+		edges = append(edges, sumdbhash.EdgeTree)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SumDBHashMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sumdbhash.EdgeTree:
+		if id := m.tree; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SumDBHashMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SumDBHashMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SumDBHashMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtree {
+		edges = append(edges, sumdbhash.EdgeTree)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SumDBHashMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sumdbhash.EdgeTree:
+		return m.clearedtree
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SumDBHashMutation) ClearEdge(name string) error {
+	switch name {
+	case sumdbhash.EdgeTree:
+		m.ClearTree()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBHash unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SumDBHashMutation) ResetEdge(name string) error {
+	switch name {
+	case sumdbhash.EdgeTree:
+		m.ResetTree()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBHash edge %s", name)
+}
+
+// SumDBKeyMutation represents an operation that mutates the SumDBKey nodes in the graph.
+type SumDBKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	signer_key    *crypto.Secret
+	verifier_key  *string
+	cosign_until  *time.Time
+	clearedFields map[string]struct{}
+	tree          *int
+	clearedtree   bool
+	done          bool
+	oldValue      func(context.Context) (*SumDBKey, error)
+	predicates    []predicate.SumDBKey
+}
+
+var _ ent.Mutation = (*SumDBKeyMutation)(nil)
+
+// sumdbkeyOption allows management of the mutation configuration using functional options.
+type sumdbkeyOption func(*SumDBKeyMutation)
+
+// newSumDBKeyMutation creates new mutation for the SumDBKey entity.
+func newSumDBKeyMutation(c config, op Op, opts ...sumdbkeyOption) *SumDBKeyMutation {
+	m := &SumDBKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSumDBKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSumDBKeyID sets the ID field of the mutation.
+func withSumDBKeyID(id int) sumdbkeyOption {
+	return func(m *SumDBKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SumDBKey
+		)
+		m.oldValue = func(ctx context.Context) (*SumDBKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SumDBKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSumDBKey sets the old SumDBKey of the mutation.
+func withSumDBKey(node *SumDBKey) sumdbkeyOption {
+	return func(m *SumDBKeyMutation) {
+		m.oldValue = func(context.Context) (*SumDBKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SumDBKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SumDBKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SumDBKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SumDBKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SumDBKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SumDBKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SumDBKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SumDBKey entity.
+// If the SumDBKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SumDBKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SumDBKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SumDBKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SumDBKey entity.
+// If the SumDBKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SumDBKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSignerKey sets the "signer_key" field.
+func (m *SumDBKeyMutation) SetSignerKey(c crypto.Secret) {
+	m.signer_key = &c
+}
+
+// SignerKey returns the value of the "signer_key" field in the mutation.
+func (m *SumDBKeyMutation) SignerKey() (r crypto.Secret, exists bool) {
+	v := m.signer_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignerKey returns the old "signer_key" field's value of the SumDBKey entity.
+// If the SumDBKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBKeyMutation) OldSignerKey(ctx context.Context) (v crypto.Secret, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignerKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignerKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignerKey: %w", err)
+	}
+	return oldValue.SignerKey, nil
+}
+
+// ResetSignerKey resets all changes to the "signer_key" field.
+func (m *SumDBKeyMutation) ResetSignerKey() {
+	m.signer_key = nil
+}
+
+// SetVerifierKey sets the "verifier_key" field.
+func (m *SumDBKeyMutation) SetVerifierKey(s string) {
+	m.verifier_key = &s
+}
+
+// VerifierKey returns the value of the "verifier_key" field in the mutation.
+func (m *SumDBKeyMutation) VerifierKey() (r string, exists bool) {
+	v := m.verifier_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifierKey returns the old "verifier_key" field's value of the SumDBKey entity.
+// If the SumDBKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBKeyMutation) OldVerifierKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifierKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifierKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifierKey: %w", err)
+	}
+	return oldValue.VerifierKey, nil
+}
+
+// ResetVerifierKey resets all changes to the "verifier_key" field.
+func (m *SumDBKeyMutation) ResetVerifierKey() {
+	m.verifier_key = nil
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (m *SumDBKeyMutation) SetCosignUntil(t time.Time) {
+	m.cosign_until = &t
+}
+
+// CosignUntil returns the value of the "cosign_until" field in the mutation.
+func (m *SumDBKeyMutation) CosignUntil() (r time.Time, exists bool) {
+	v := m.cosign_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCosignUntil returns the old "cosign_until" field's value of the SumDBKey entity.
+// If the SumDBKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBKeyMutation) OldCosignUntil(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCosignUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCosignUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCosignUntil: %w", err)
+	}
+	return oldValue.CosignUntil, nil
+}
+
+// ResetCosignUntil resets all changes to the "cosign_until" field.
+func (m *SumDBKeyMutation) ResetCosignUntil() {
+	m.cosign_until = nil
+}
+
+// SetTreeID sets the "tree" edge to the SumDBTree entity by id.
+func (m *SumDBKeyMutation) SetTreeID(id int) {
+	m.tree = &id
+}
+
+// ClearTree clears the "tree" edge to the SumDBTree entity.
+func (m *SumDBKeyMutation) ClearTree() {
+	m.clearedtree = true
+}
+
+// TreeCleared reports if the "tree" edge to the SumDBTree entity was cleared.
+func (m *SumDBKeyMutation) TreeCleared() bool {
+	return m.clearedtree
+}
+
+// TreeID returns the "tree" edge ID in the mutation.
+func (m *SumDBKeyMutation) TreeID() (id int, exists bool) {
+	if m.tree != nil {
+		return *m.tree, true
+	}
+	return
+}
+
+// TreeIDs returns the "tree" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TreeID instead. It exists only for internal usage by the builders.
+func (m *SumDBKeyMutation) TreeIDs() (ids []int) {
+	if id := m.tree; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTree resets all changes to the "tree" edge.
+func (m *SumDBKeyMutation) ResetTree() {
+	m.tree = nil
+	m.clearedtree = false
+}
+
+// Where appends a list predicates to the SumDBKeyMutation builder.
+func (m *SumDBKeyMutation) Where(ps ...predicate.SumDBKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SumDBKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SumDBKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SumDBKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SumDBKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SumDBKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SumDBKey).
+func (m *SumDBKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SumDBKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, sumdbkey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sumdbkey.FieldUpdatedAt)
+	}
+	if m.signer_key != nil {
+		fields = append(fields, sumdbkey.FieldSignerKey)
+	}
+	if m.verifier_key != nil {
+		fields = append(fields, sumdbkey.FieldVerifierKey)
+	}
+	if m.cosign_until != nil {
+		fields = append(fields, sumdbkey.FieldCosignUntil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SumDBKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbkey.FieldCreatedAt:
+		return m.CreatedAt()
+	case sumdbkey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case sumdbkey.FieldSignerKey:
+		return m.SignerKey()
+	case sumdbkey.FieldVerifierKey:
+		return m.VerifierKey()
+	case sumdbkey.FieldCosignUntil:
+		return m.CosignUntil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SumDBKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sumdbkey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sumdbkey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case sumdbkey.FieldSignerKey:
+		return m.OldSignerKey(ctx)
+	case sumdbkey.FieldVerifierKey:
+		return m.OldVerifierKey(ctx)
+	case sumdbkey.FieldCosignUntil:
+		return m.OldCosignUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown SumDBKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sumdbkey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sumdbkey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case sumdbkey.FieldSignerKey:
+		v, ok := value.(crypto.Secret)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignerKey(v)
+		return nil
+	case sumdbkey.FieldVerifierKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifierKey(v)
+		return nil
+	case sumdbkey.FieldCosignUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCosignUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SumDBKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SumDBKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SumDBKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SumDBKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SumDBKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SumDBKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SumDBKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SumDBKeyMutation) ResetField(name string) error {
+	switch name {
+	case sumdbkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sumdbkey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case sumdbkey.FieldSignerKey:
+		m.ResetSignerKey()
+		return nil
+	case sumdbkey.FieldVerifierKey:
+		m.ResetVerifierKey()
+		return nil
+	case sumdbkey.FieldCosignUntil:
+		m.ResetCosignUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SumDBKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tree != nil {
+		edges = append(edges, sumdbkey.EdgeTree)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SumDBKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sumdbkey.EdgeTree:
+		if id := m.tree; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SumDBKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SumDBKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SumDBKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtree {
+		edges = append(edges, sumdbkey.EdgeTree)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SumDBKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sumdbkey.EdgeTree:
+		return m.clearedtree
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SumDBKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case sumdbkey.EdgeTree:
+		m.ClearTree()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SumDBKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case sumdbkey.EdgeTree:
+		m.ResetTree()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBKey edge %s", name)
+}
+
+// SumDBRecordMutation represents an operation that mutates the SumDBRecord nodes in the graph.
+type SumDBRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	record_id     *int64
+	addrecord_id  *int64
+	_path         *string
+	version       *string
+	data          *[]byte
+	clearedFields map[string]struct{}
+	assets        map[int]struct{}
+	removedassets map[int]struct{}
+	clearedassets bool
+	tree          *int
+	clearedtree   bool
+	done          bool
+	oldValue      func(context.Context) (*SumDBRecord, error)
+	predicates    []predicate.SumDBRecord
+}
+
+var _ ent.Mutation = (*SumDBRecordMutation)(nil)
+
+// sumdbrecordOption allows management of the mutation configuration using functional options.
+type sumdbrecordOption func(*SumDBRecordMutation)
+
+// newSumDBRecordMutation creates new mutation for the SumDBRecord entity.
+func newSumDBRecordMutation(c config, op Op, opts ...sumdbrecordOption) *SumDBRecordMutation {
+	m := &SumDBRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSumDBRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSumDBRecordID sets the ID field of the mutation.
+func withSumDBRecordID(id int) sumdbrecordOption {
+	return func(m *SumDBRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SumDBRecord
+		)
+		m.oldValue = func(ctx context.Context) (*SumDBRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SumDBRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSumDBRecord sets the old SumDBRecord of the mutation.
+func withSumDBRecord(node *SumDBRecord) sumdbrecordOption {
+	return func(m *SumDBRecordMutation) {
+		m.oldValue = func(context.Context) (*SumDBRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SumDBRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SumDBRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SumDBRecordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SumDBRecordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SumDBRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SumDBRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SumDBRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SumDBRecord entity.
+// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SumDBRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SumDBRecordMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SumDBRecordMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SumDBRecord entity.
+// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBRecordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SumDBRecordMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRecordID sets the "record_id" field.
+func (m *SumDBRecordMutation) SetRecordID(i int64) {
+	m.record_id = &i
+	m.addrecord_id = nil
+}
+
+// RecordID returns the value of the "record_id" field in the mutation.
+func (m *SumDBRecordMutation) RecordID() (r int64, exists bool) {
+	v := m.record_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecordID returns the old "record_id" field's value of the SumDBRecord entity.
+// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBRecordMutation) OldRecordID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordID: %w", err)
+	}
+	return oldValue.RecordID, nil
+}
+
+// AddRecordID adds i to the "record_id" field.
+func (m *SumDBRecordMutation) AddRecordID(i int64) {
+	if m.addrecord_id != nil {
+		*m.addrecord_id += i
+	} else {
+		m.addrecord_id = &i
+	}
+}
+
+// AddedRecordID returns the value that was added to the "record_id" field in this mutation.
+func (m *SumDBRecordMutation) AddedRecordID() (r int64, exists bool) {
+	v := m.addrecord_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRecordID resets all changes to the "record_id" field.
+func (m *SumDBRecordMutation) ResetRecordID() {
+	m.record_id = nil
+	m.addrecord_id = nil
+}
+
+// SetPath sets the "path" field.
+func (m *SumDBRecordMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *SumDBRecordMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the SumDBRecord entity.
+// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBRecordMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *SumDBRecordMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *SumDBRecordMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
 func (m *SumDBRecordMutation) Version() (r string, exists bool) {
 	v := m.version
 	if v == nil {
@@ -2068,166 +5290,1117 @@ func (m *SumDBRecordMutation) Version() (r string, exists bool) {
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the SumDBRecord entity.
-// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the SumDBRecord entity.
+// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBRecordMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *SumDBRecordMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetData sets the "data" field.
+func (m *SumDBRecordMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *SumDBRecordMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the SumDBRecord entity.
+// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBRecordMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *SumDBRecordMutation) ResetData() {
+	m.data = nil
+}
+
+// AddAssetIDs adds the "assets" edge to the Asset entity by ids.
+func (m *SumDBRecordMutation) AddAssetIDs(ids ...int) {
+	if m.assets == nil {
+		m.assets = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.assets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssets clears the "assets" edge to the Asset entity.
+func (m *SumDBRecordMutation) ClearAssets() {
+	m.clearedassets = true
+}
+
+// AssetsCleared reports if the "assets" edge to the Asset entity was cleared.
+func (m *SumDBRecordMutation) AssetsCleared() bool {
+	return m.clearedassets
+}
+
+// RemoveAssetIDs removes the "assets" edge to the Asset entity by IDs.
+func (m *SumDBRecordMutation) RemoveAssetIDs(ids ...int) {
+	if m.removedassets == nil {
+		m.removedassets = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.assets, ids[i])
+		m.removedassets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssets returns the removed IDs of the "assets" edge to the Asset entity.
+func (m *SumDBRecordMutation) RemovedAssetsIDs() (ids []int) {
+	for id := range m.removedassets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssetsIDs returns the "assets" edge IDs in the mutation.
+func (m *SumDBRecordMutation) AssetsIDs() (ids []int) {
+	for id := range m.assets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssets resets all changes to the "assets" edge.
+func (m *SumDBRecordMutation) ResetAssets() {
+	m.assets = nil
+	m.clearedassets = false
+	m.removedassets = nil
+}
+
+// SetTreeID sets the "tree" edge to the SumDBTree entity by id.
+func (m *SumDBRecordMutation) SetTreeID(id int) {
+	m.tree = &id
+}
+
+// ClearTree clears the "tree" edge to the SumDBTree entity.
+func (m *SumDBRecordMutation) ClearTree() {
+	m.clearedtree = true
+}
+
+// TreeCleared reports if the "tree" edge to the SumDBTree entity was cleared.
+func (m *SumDBRecordMutation) TreeCleared() bool {
+	return m.clearedtree
+}
+
+// TreeID returns the "tree" edge ID in the mutation.
+func (m *SumDBRecordMutation) TreeID() (id int, exists bool) {
+	if m.tree != nil {
+		return *m.tree, true
+	}
+	return
+}
+
+// TreeIDs returns the "tree" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TreeID instead. It exists only for internal usage by the builders.
+func (m *SumDBRecordMutation) TreeIDs() (ids []int) {
+	if id := m.tree; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTree resets all changes to the "tree" edge.
+func (m *SumDBRecordMutation) ResetTree() {
+	m.tree = nil
+	m.clearedtree = false
+}
+
+// Where appends a list predicates to the SumDBRecordMutation builder.
+func (m *SumDBRecordMutation) Where(ps ...predicate.SumDBRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SumDBRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SumDBRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SumDBRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SumDBRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SumDBRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SumDBRecord).
+func (m *SumDBRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SumDBRecordMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, sumdbrecord.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sumdbrecord.FieldUpdatedAt)
+	}
+	if m.record_id != nil {
+		fields = append(fields, sumdbrecord.FieldRecordID)
+	}
+	if m._path != nil {
+		fields = append(fields, sumdbrecord.FieldPath)
+	}
+	if m.version != nil {
+		fields = append(fields, sumdbrecord.FieldVersion)
+	}
+	if m.data != nil {
+		fields = append(fields, sumdbrecord.FieldData)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SumDBRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbrecord.FieldCreatedAt:
+		return m.CreatedAt()
+	case sumdbrecord.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case sumdbrecord.FieldRecordID:
+		return m.RecordID()
+	case sumdbrecord.FieldPath:
+		return m.Path()
+	case sumdbrecord.FieldVersion:
+		return m.Version()
+	case sumdbrecord.FieldData:
+		return m.Data()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SumDBRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sumdbrecord.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sumdbrecord.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case sumdbrecord.FieldRecordID:
+		return m.OldRecordID(ctx)
+	case sumdbrecord.FieldPath:
+		return m.OldPath(ctx)
+	case sumdbrecord.FieldVersion:
+		return m.OldVersion(ctx)
+	case sumdbrecord.FieldData:
+		return m.OldData(ctx)
+	}
+	return nil, fmt.Errorf("unknown SumDBRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sumdbrecord.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sumdbrecord.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case sumdbrecord.FieldRecordID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecordID(v)
+		return nil
+	case sumdbrecord.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case sumdbrecord.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case sumdbrecord.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SumDBRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addrecord_id != nil {
+		fields = append(fields, sumdbrecord.FieldRecordID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SumDBRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sumdbrecord.FieldRecordID:
+		return m.AddedRecordID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SumDBRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sumdbrecord.FieldRecordID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecordID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SumDBRecordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SumDBRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SumDBRecordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SumDBRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SumDBRecordMutation) ResetField(name string) error {
+	switch name {
+	case sumdbrecord.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sumdbrecord.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case sumdbrecord.FieldRecordID:
+		m.ResetRecordID()
+		return nil
+	case sumdbrecord.FieldPath:
+		m.ResetPath()
+		return nil
+	case sumdbrecord.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case sumdbrecord.FieldData:
+		m.ResetData()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SumDBRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.assets != nil {
+		edges = append(edges, sumdbrecord.EdgeAssets)
+	}
+	if m.tree != nil {
+		edges = append(edges, sumdbrecord.EdgeTree)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SumDBRecordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sumdbrecord.EdgeAssets:
+		ids := make([]ent.Value, 0, len(m.assets))
+		for id := range m.assets {
+			ids = append(ids, id)
+		}
+		return ids
+	case sumdbrecord.EdgeTree:
+		if id := m.tree; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SumDBRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedassets != nil {
+		edges = append(edges, sumdbrecord.EdgeAssets)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SumDBRecordMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sumdbrecord.EdgeAssets:
+		ids := make([]ent.Value, 0, len(m.removedassets))
+		for id := range m.removedassets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SumDBRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedassets {
+		edges = append(edges, sumdbrecord.EdgeAssets)
+	}
+	if m.clearedtree {
+		edges = append(edges, sumdbrecord.EdgeTree)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SumDBRecordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sumdbrecord.EdgeAssets:
+		return m.clearedassets
+	case sumdbrecord.EdgeTree:
+		return m.clearedtree
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SumDBRecordMutation) ClearEdge(name string) error {
+	switch name {
+	case sumdbrecord.EdgeTree:
+		m.ClearTree()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SumDBRecordMutation) ResetEdge(name string) error {
+	switch name {
+	case sumdbrecord.EdgeAssets:
+		m.ResetAssets()
+		return nil
+	case sumdbrecord.EdgeTree:
+		m.ResetTree()
+		return nil
+	}
+	return fmt.Errorf("unknown SumDBRecord edge %s", name)
+}
+
+// SumDBTreeMutation represents an operation that mutates the SumDBTree nodes in the graph.
+type SumDBTreeMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	name           *string
+	size           *int64
+	addsize        *int64
+	signer_key     *crypto.Secret
+	verifier_key   *string
+	status         *sumdbtree.Status
+	clearedFields  map[string]struct{}
+	hashes         map[int]struct{}
+	removedhashes  map[int]struct{}
+	clearedhashes  bool
+	records        map[int]struct{}
+	removedrecords map[int]struct{}
+	clearedrecords bool
+	keys           map[int]struct{}
+	removedkeys    map[int]struct{}
+	clearedkeys    bool
+	heads          map[int]struct{}
+	removedheads   map[int]struct{}
+	clearedheads   bool
+	done           bool
+	oldValue       func(context.Context) (*SumDBTree, error)
+	predicates     []predicate.SumDBTree
+}
+
+var _ ent.Mutation = (*SumDBTreeMutation)(nil)
+
+// sumdbtreeOption allows management of the mutation configuration using functional options.
+type sumdbtreeOption func(*SumDBTreeMutation)
+
+// newSumDBTreeMutation creates new mutation for the SumDBTree entity.
+func newSumDBTreeMutation(c config, op Op, opts ...sumdbtreeOption) *SumDBTreeMutation {
+	m := &SumDBTreeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSumDBTree,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSumDBTreeID sets the ID field of the mutation.
+func withSumDBTreeID(id int) sumdbtreeOption {
+	return func(m *SumDBTreeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SumDBTree
+		)
+		m.oldValue = func(ctx context.Context) (*SumDBTree, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SumDBTree.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSumDBTree sets the old SumDBTree of the mutation.
+func withSumDBTree(node *SumDBTree) sumdbtreeOption {
+	return func(m *SumDBTreeMutation) {
+		m.oldValue = func(context.Context) (*SumDBTree, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SumDBTreeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SumDBTreeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SumDBTreeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SumDBTreeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SumDBTree.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SumDBTreeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SumDBTreeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBTreeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SumDBTreeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SumDBTreeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SumDBTreeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBTreeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SumDBTreeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *SumDBTreeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SumDBTreeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBRecordMutation) OldVersion(ctx context.Context) (v string, err error) {
+func (m *SumDBTreeMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.Name, nil
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *SumDBRecordMutation) ResetVersion() {
-	m.version = nil
+// ResetName resets all changes to the "name" field.
+func (m *SumDBTreeMutation) ResetName() {
+	m.name = nil
 }
 
-// SetData sets the "data" field.
-func (m *SumDBRecordMutation) SetData(b []byte) {
-	m.data = &b
+// SetSize sets the "size" field.
+func (m *SumDBTreeMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *SumDBTreeMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBTreeMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *SumDBTreeMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *SumDBTreeMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *SumDBTreeMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetSignerKey sets the "signer_key" field.
+func (m *SumDBTreeMutation) SetSignerKey(c crypto.Secret) {
+	m.signer_key = &c
+}
+
+// SignerKey returns the value of the "signer_key" field in the mutation.
+func (m *SumDBTreeMutation) SignerKey() (r crypto.Secret, exists bool) {
+	v := m.signer_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignerKey returns the old "signer_key" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBTreeMutation) OldSignerKey(ctx context.Context) (v crypto.Secret, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignerKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignerKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignerKey: %w", err)
+	}
+	return oldValue.SignerKey, nil
+}
+
+// ResetSignerKey resets all changes to the "signer_key" field.
+func (m *SumDBTreeMutation) ResetSignerKey() {
+	m.signer_key = nil
+}
+
+// SetVerifierKey sets the "verifier_key" field.
+func (m *SumDBTreeMutation) SetVerifierKey(s string) {
+	m.verifier_key = &s
+}
+
+// VerifierKey returns the value of the "verifier_key" field in the mutation.
+func (m *SumDBTreeMutation) VerifierKey() (r string, exists bool) {
+	v := m.verifier_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifierKey returns the old "verifier_key" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBTreeMutation) OldVerifierKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifierKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifierKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifierKey: %w", err)
+	}
+	return oldValue.VerifierKey, nil
+}
+
+// ResetVerifierKey resets all changes to the "verifier_key" field.
+func (m *SumDBTreeMutation) ResetVerifierKey() {
+	m.verifier_key = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SumDBTreeMutation) SetStatus(s sumdbtree.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SumDBTreeMutation) Status() (r sumdbtree.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SumDBTree entity.
+// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SumDBTreeMutation) OldStatus(ctx context.Context) (v sumdbtree.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SumDBTreeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// AddHashIDs adds the "hashes" edge to the SumDBHash entity by ids.
+func (m *SumDBTreeMutation) AddHashIDs(ids ...int) {
+	if m.hashes == nil {
+		m.hashes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.hashes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHashes clears the "hashes" edge to the SumDBHash entity.
+func (m *SumDBTreeMutation) ClearHashes() {
+	m.clearedhashes = true
+}
+
+// HashesCleared reports if the "hashes" edge to the SumDBHash entity was cleared.
+func (m *SumDBTreeMutation) HashesCleared() bool {
+	return m.clearedhashes
+}
+
+// RemoveHashIDs removes the "hashes" edge to the SumDBHash entity by IDs.
+func (m *SumDBTreeMutation) RemoveHashIDs(ids ...int) {
+	if m.removedhashes == nil {
+		m.removedhashes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.hashes, ids[i])
+		m.removedhashes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHashes returns the removed IDs of the "hashes" edge to the SumDBHash entity.
+func (m *SumDBTreeMutation) RemovedHashesIDs() (ids []int) {
+	for id := range m.removedhashes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HashesIDs returns the "hashes" edge IDs in the mutation.
+func (m *SumDBTreeMutation) HashesIDs() (ids []int) {
+	for id := range m.hashes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHashes resets all changes to the "hashes" edge.
+func (m *SumDBTreeMutation) ResetHashes() {
+	m.hashes = nil
+	m.clearedhashes = false
+	m.removedhashes = nil
+}
+
+// AddRecordIDs adds the "records" edge to the SumDBRecord entity by ids.
+func (m *SumDBTreeMutation) AddRecordIDs(ids ...int) {
+	if m.records == nil {
+		m.records = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.records[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecords clears the "records" edge to the SumDBRecord entity.
+func (m *SumDBTreeMutation) ClearRecords() {
+	m.clearedrecords = true
+}
+
+// RecordsCleared reports if the "records" edge to the SumDBRecord entity was cleared.
+func (m *SumDBTreeMutation) RecordsCleared() bool {
+	return m.clearedrecords
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *SumDBRecordMutation) Data() (r []byte, exists bool) {
-	v := m.data
-	if v == nil {
-		return
+// RemoveRecordIDs removes the "records" edge to the SumDBRecord entity by IDs.
+func (m *SumDBTreeMutation) RemoveRecordIDs(ids ...int) {
+	if m.removedrecords == nil {
+		m.removedrecords = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.records, ids[i])
+		m.removedrecords[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldData returns the old "data" field's value of the SumDBRecord entity.
-// If the SumDBRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBRecordMutation) OldData(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+// RemovedRecords returns the removed IDs of the "records" edge to the SumDBRecord entity.
+func (m *SumDBTreeMutation) RemovedRecordsIDs() (ids []int) {
+	for id := range m.removedrecords {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	return
+}
+
+// RecordsIDs returns the "records" edge IDs in the mutation.
+func (m *SumDBTreeMutation) RecordsIDs() (ids []int) {
+	for id := range m.records {
+		ids = append(ids, id)
 	}
-	return oldValue.Data, nil
+	return
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *SumDBRecordMutation) ResetData() {
-	m.data = nil
+// ResetRecords resets all changes to the "records" edge.
+func (m *SumDBTreeMutation) ResetRecords() {
+	m.records = nil
+	m.clearedrecords = false
+	m.removedrecords = nil
 }
 
-// AddAssetIDs adds the "assets" edge to the Asset entity by ids.
-func (m *SumDBRecordMutation) AddAssetIDs(ids ...int) {
-	if m.assets == nil {
-		m.assets = make(map[int]struct{})
+// AddKeyIDs adds the "keys" edge to the SumDBKey entity by ids.
+func (m *SumDBTreeMutation) AddKeyIDs(ids ...int) {
+	if m.keys == nil {
+		m.keys = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.assets[ids[i]] = struct{}{}
+		m.keys[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAssets clears the "assets" edge to the Asset entity.
-func (m *SumDBRecordMutation) ClearAssets() {
-	m.clearedassets = true
+// ClearKeys clears the "keys" edge to the SumDBKey entity.
+func (m *SumDBTreeMutation) ClearKeys() {
+	m.clearedkeys = true
 }
 
-// AssetsCleared reports if the "assets" edge to the Asset entity was cleared.
-func (m *SumDBRecordMutation) AssetsCleared() bool {
-	return m.clearedassets
+// KeysCleared reports if the "keys" edge to the SumDBKey entity was cleared.
+func (m *SumDBTreeMutation) KeysCleared() bool {
+	return m.clearedkeys
 }
 
-// RemoveAssetIDs removes the "assets" edge to the Asset entity by IDs.
-func (m *SumDBRecordMutation) RemoveAssetIDs(ids ...int) {
-	if m.removedassets == nil {
-		m.removedassets = make(map[int]struct{})
+// RemoveKeyIDs removes the "keys" edge to the SumDBKey entity by IDs.
+func (m *SumDBTreeMutation) RemoveKeyIDs(ids ...int) {
+	if m.removedkeys == nil {
+		m.removedkeys = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.assets, ids[i])
-		m.removedassets[ids[i]] = struct{}{}
+		delete(m.keys, ids[i])
+		m.removedkeys[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAssets returns the removed IDs of the "assets" edge to the Asset entity.
-func (m *SumDBRecordMutation) RemovedAssetsIDs() (ids []int) {
-	for id := range m.removedassets {
+// RemovedKeys returns the removed IDs of the "keys" edge to the SumDBKey entity.
+func (m *SumDBTreeMutation) RemovedKeysIDs() (ids []int) {
+	for id := range m.removedkeys {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AssetsIDs returns the "assets" edge IDs in the mutation.
-func (m *SumDBRecordMutation) AssetsIDs() (ids []int) {
-	for id := range m.assets {
+// KeysIDs returns the "keys" edge IDs in the mutation.
+func (m *SumDBTreeMutation) KeysIDs() (ids []int) {
+	for id := range m.keys {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAssets resets all changes to the "assets" edge.
-func (m *SumDBRecordMutation) ResetAssets() {
-	m.assets = nil
-	m.clearedassets = false
-	m.removedassets = nil
+// ResetKeys resets all changes to the "keys" edge.
+func (m *SumDBTreeMutation) ResetKeys() {
+	m.keys = nil
+	m.clearedkeys = false
+	m.removedkeys = nil
 }
 
-// SetTreeID sets the "tree" edge to the SumDBTree entity by id.
-func (m *SumDBRecordMutation) SetTreeID(id int) {
-	m.tree = &id
+// AddHeadIDs adds the "heads" edge to the SumDBTreeHead entity by ids.
+func (m *SumDBTreeMutation) AddHeadIDs(ids ...int) {
+	if m.heads == nil {
+		m.heads = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.heads[ids[i]] = struct{}{}
+	}
 }
 
-// ClearTree clears the "tree" edge to the SumDBTree entity.
-func (m *SumDBRecordMutation) ClearTree() {
-	m.clearedtree = true
+// ClearHeads clears the "heads" edge to the SumDBTreeHead entity.
+func (m *SumDBTreeMutation) ClearHeads() {
+	m.clearedheads = true
 }
 
-// TreeCleared reports if the "tree" edge to the SumDBTree entity was cleared.
-func (m *SumDBRecordMutation) TreeCleared() bool {
-	return m.clearedtree
+// HeadsCleared reports if the "heads" edge to the SumDBTreeHead entity was cleared.
+func (m *SumDBTreeMutation) HeadsCleared() bool {
+	return m.clearedheads
 }
 
-// TreeID returns the "tree" edge ID in the mutation.
-func (m *SumDBRecordMutation) TreeID() (id int, exists bool) {
-	if m.tree != nil {
-		return *m.tree, true
+// RemoveHeadIDs removes the "heads" edge to the SumDBTreeHead entity by IDs.
+func (m *SumDBTreeMutation) RemoveHeadIDs(ids ...int) {
+	if m.removedheads == nil {
+		m.removedheads = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.heads, ids[i])
+		m.removedheads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHeads returns the removed IDs of the "heads" edge to the SumDBTreeHead entity.
+func (m *SumDBTreeMutation) RemovedHeadsIDs() (ids []int) {
+	for id := range m.removedheads {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// TreeIDs returns the "tree" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TreeID instead. It exists only for internal usage by the builders.
-func (m *SumDBRecordMutation) TreeIDs() (ids []int) {
-	if id := m.tree; id != nil {
-		ids = append(ids, *id)
+// HeadsIDs returns the "heads" edge IDs in the mutation.
+func (m *SumDBTreeMutation) HeadsIDs() (ids []int) {
+	for id := range m.heads {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTree resets all changes to the "tree" edge.
-func (m *SumDBRecordMutation) ResetTree() {
-	m.tree = nil
-	m.clearedtree = false
+// ResetHeads resets all changes to the "heads" edge.
+func (m *SumDBTreeMutation) ResetHeads() {
+	m.heads = nil
+	m.clearedheads = false
+	m.removedheads = nil
 }
 
-// Where appends a list predicates to the SumDBRecordMutation builder.
-func (m *SumDBRecordMutation) Where(ps ...predicate.SumDBRecord) {
+// Where appends a list predicates to the SumDBTreeMutation builder.
+func (m *SumDBTreeMutation) Where(ps ...predicate.SumDBTree) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SumDBRecordMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SumDBTreeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SumDBRecordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SumDBRecord, len(ps))
+func (m *SumDBTreeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SumDBTree, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2235,42 +6408,45 @@ func (m *SumDBRecordMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SumDBRecordMutation) Op() Op {
+func (m *SumDBTreeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SumDBRecordMutation) SetOp(op Op) {
+func (m *SumDBTreeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SumDBRecord).
-func (m *SumDBRecordMutation) Type() string {
+// Type returns the node type of this mutation (SumDBTree).
+func (m *SumDBTreeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SumDBRecordMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *SumDBTreeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, sumdbrecord.FieldCreatedAt)
+		fields = append(fields, sumdbtree.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, sumdbrecord.FieldUpdatedAt)
+		fields = append(fields, sumdbtree.FieldUpdatedAt)
 	}
-	if m.record_id != nil {
-		fields = append(fields, sumdbrecord.FieldRecordID)
+	if m.name != nil {
+		fields = append(fields, sumdbtree.FieldName)
 	}
-	if m._path != nil {
-		fields = append(fields, sumdbrecord.FieldPath)
+	if m.size != nil {
+		fields = append(fields, sumdbtree.FieldSize)
 	}
-	if m.version != nil {
-		fields = append(fields, sumdbrecord.FieldVersion)
+	if m.signer_key != nil {
+		fields = append(fields, sumdbtree.FieldSignerKey)
 	}
-	if m.data != nil {
-		fields = append(fields, sumdbrecord.FieldData)
+	if m.verifier_key != nil {
+		fields = append(fields, sumdbtree.FieldVerifierKey)
+	}
+	if m.status != nil {
+		fields = append(fields, sumdbtree.FieldStatus)
 	}
 	return fields
 }
@@ -2278,20 +6454,22 @@ func (m *SumDBRecordMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SumDBRecordMutation) Field(name string) (ent.Value, bool) {
+func (m *SumDBTreeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sumdbrecord.FieldCreatedAt:
+	case sumdbtree.FieldCreatedAt:
 		return m.CreatedAt()
-	case sumdbrecord.FieldUpdatedAt:
+	case sumdbtree.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case sumdbrecord.FieldRecordID:
-		return m.RecordID()
-	case sumdbrecord.FieldPath:
-		return m.Path()
-	case sumdbrecord.FieldVersion:
-		return m.Version()
-	case sumdbrecord.FieldData:
-		return m.Data()
+	case sumdbtree.FieldName:
+		return m.Name()
+	case sumdbtree.FieldSize:
+		return m.Size()
+	case sumdbtree.FieldSignerKey:
+		return m.SignerKey()
+	case sumdbtree.FieldVerifierKey:
+		return m.VerifierKey()
+	case sumdbtree.FieldStatus:
+		return m.Status()
 	}
 	return nil, false
 }
@@ -2299,81 +6477,90 @@ func (m *SumDBRecordMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SumDBRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SumDBTreeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sumdbrecord.FieldCreatedAt:
+	case sumdbtree.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case sumdbrecord.FieldUpdatedAt:
+	case sumdbtree.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case sumdbrecord.FieldRecordID:
-		return m.OldRecordID(ctx)
-	case sumdbrecord.FieldPath:
-		return m.OldPath(ctx)
-	case sumdbrecord.FieldVersion:
-		return m.OldVersion(ctx)
-	case sumdbrecord.FieldData:
-		return m.OldData(ctx)
+	case sumdbtree.FieldName:
+		return m.OldName(ctx)
+	case sumdbtree.FieldSize:
+		return m.OldSize(ctx)
+	case sumdbtree.FieldSignerKey:
+		return m.OldSignerKey(ctx)
+	case sumdbtree.FieldVerifierKey:
+		return m.OldVerifierKey(ctx)
+	case sumdbtree.FieldStatus:
+		return m.OldStatus(ctx)
 	}
-	return nil, fmt.Errorf("unknown SumDBRecord field %s", name)
+	return nil, fmt.Errorf("unknown SumDBTree field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SumDBRecordMutation) SetField(name string, value ent.Value) error {
+func (m *SumDBTreeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sumdbrecord.FieldCreatedAt:
+	case sumdbtree.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case sumdbrecord.FieldUpdatedAt:
+	case sumdbtree.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case sumdbrecord.FieldRecordID:
+	case sumdbtree.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sumdbtree.FieldSize:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRecordID(v)
+		m.SetSize(v)
 		return nil
-	case sumdbrecord.FieldPath:
-		v, ok := value.(string)
+	case sumdbtree.FieldSignerKey:
+		v, ok := value.(crypto.Secret)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetSignerKey(v)
 		return nil
-	case sumdbrecord.FieldVersion:
+	case sumdbtree.FieldVerifierKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetVerifierKey(v)
 		return nil
-	case sumdbrecord.FieldData:
-		v, ok := value.([]byte)
+	case sumdbtree.FieldStatus:
+		v, ok := value.(sumdbtree.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetData(v)
+		m.SetStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBRecord field %s", name)
+	return fmt.Errorf("unknown SumDBTree field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SumDBRecordMutation) AddedFields() []string {
+func (m *SumDBTreeMutation) AddedFields() []string {
 	var fields []string
-	if m.addrecord_id != nil {
-		fields = append(fields, sumdbrecord.FieldRecordID)
+	if m.addsize != nil {
+		fields = append(fields, sumdbtree.FieldSize)
 	}
 	return fields
 }
@@ -2381,10 +6568,10 @@ func (m *SumDBRecordMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SumDBRecordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SumDBTreeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case sumdbrecord.FieldRecordID:
-		return m.AddedRecordID()
+	case sumdbtree.FieldSize:
+		return m.AddedSize()
 	}
 	return nil, false
 }
@@ -2392,110 +6579,160 @@ func (m *SumDBRecordMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SumDBRecordMutation) AddField(name string, value ent.Value) error {
+func (m *SumDBTreeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sumdbrecord.FieldRecordID:
+	case sumdbtree.FieldSize:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRecordID(v)
+		m.AddSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBRecord numeric field %s", name)
+	return fmt.Errorf("unknown SumDBTree numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SumDBRecordMutation) ClearedFields() []string {
+func (m *SumDBTreeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SumDBRecordMutation) FieldCleared(name string) bool {
+func (m *SumDBTreeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SumDBRecordMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SumDBRecord nullable field %s", name)
+func (m *SumDBTreeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SumDBTree nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SumDBRecordMutation) ResetField(name string) error {
+func (m *SumDBTreeMutation) ResetField(name string) error {
 	switch name {
-	case sumdbrecord.FieldCreatedAt:
+	case sumdbtree.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case sumdbrecord.FieldUpdatedAt:
+	case sumdbtree.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case sumdbrecord.FieldRecordID:
-		m.ResetRecordID()
+	case sumdbtree.FieldName:
+		m.ResetName()
 		return nil
-	case sumdbrecord.FieldPath:
-		m.ResetPath()
+	case sumdbtree.FieldSize:
+		m.ResetSize()
 		return nil
-	case sumdbrecord.FieldVersion:
-		m.ResetVersion()
+	case sumdbtree.FieldSignerKey:
+		m.ResetSignerKey()
 		return nil
-	case sumdbrecord.FieldData:
-		m.ResetData()
+	case sumdbtree.FieldVerifierKey:
+		m.ResetVerifierKey()
+		return nil
+	case sumdbtree.FieldStatus:
+		m.ResetStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBRecord field %s", name)
+	return fmt.Errorf("unknown SumDBTree field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SumDBRecordMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.assets != nil {
-		edges = append(edges, sumdbrecord.EdgeAssets)
+func (m *SumDBTreeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.hashes != nil {
+		edges = append(edges, sumdbtree.EdgeHashes)
 	}
-	if m.tree != nil {
-		edges = append(edges, sumdbrecord.EdgeTree)
+	if m.records != nil {
+		edges = append(edges, sumdbtree.EdgeRecords)
+	}
+	if m.keys != nil {
+		edges = append(edges, sumdbtree.EdgeKeys)
+	}
+	if m.heads != nil {
+		edges = append(edges, sumdbtree.EdgeHeads)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SumDBRecordMutation) AddedIDs(name string) []ent.Value {
+func (m *SumDBTreeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case sumdbrecord.EdgeAssets:
-		ids := make([]ent.Value, 0, len(m.assets))
-		for id := range m.assets {
+	case sumdbtree.EdgeHashes:
+		ids := make([]ent.Value, 0, len(m.hashes))
+		for id := range m.hashes {
 			ids = append(ids, id)
 		}
 		return ids
-	case sumdbrecord.EdgeTree:
-		if id := m.tree; id != nil {
-			return []ent.Value{*id}
+	case sumdbtree.EdgeRecords:
+		ids := make([]ent.Value, 0, len(m.records))
+		for id := range m.records {
+			ids = append(ids, id)
+		}
+		return ids
+	case sumdbtree.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.keys))
+		for id := range m.keys {
+			ids = append(ids, id)
 		}
+		return ids
+	case sumdbtree.EdgeHeads:
+		ids := make([]ent.Value, 0, len(m.heads))
+		for id := range m.heads {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SumDBRecordMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedassets != nil {
-		edges = append(edges, sumdbrecord.EdgeAssets)
+func (m *SumDBTreeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedhashes != nil {
+		edges = append(edges, sumdbtree.EdgeHashes)
+	}
+	if m.removedrecords != nil {
+		edges = append(edges, sumdbtree.EdgeRecords)
+	}
+	if m.removedkeys != nil {
+		edges = append(edges, sumdbtree.EdgeKeys)
+	}
+	if m.removedheads != nil {
+		edges = append(edges, sumdbtree.EdgeHeads)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SumDBRecordMutation) RemovedIDs(name string) []ent.Value {
+func (m *SumDBTreeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case sumdbrecord.EdgeAssets:
-		ids := make([]ent.Value, 0, len(m.removedassets))
-		for id := range m.removedassets {
+	case sumdbtree.EdgeHashes:
+		ids := make([]ent.Value, 0, len(m.removedhashes))
+		for id := range m.removedhashes {
+			ids = append(ids, id)
+		}
+		return ids
+	case sumdbtree.EdgeRecords:
+		ids := make([]ent.Value, 0, len(m.removedrecords))
+		for id := range m.removedrecords {
+			ids = append(ids, id)
+		}
+		return ids
+	case sumdbtree.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.removedkeys))
+		for id := range m.removedkeys {
+			ids = append(ids, id)
+		}
+		return ids
+	case sumdbtree.EdgeHeads:
+		ids := make([]ent.Value, 0, len(m.removedheads))
+		for id := range m.removedheads {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2504,90 +6741,101 @@ func (m *SumDBRecordMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SumDBRecordMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedassets {
-		edges = append(edges, sumdbrecord.EdgeAssets)
+func (m *SumDBTreeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedhashes {
+		edges = append(edges, sumdbtree.EdgeHashes)
 	}
-	if m.clearedtree {
-		edges = append(edges, sumdbrecord.EdgeTree)
+	if m.clearedrecords {
+		edges = append(edges, sumdbtree.EdgeRecords)
+	}
+	if m.clearedkeys {
+		edges = append(edges, sumdbtree.EdgeKeys)
+	}
+	if m.clearedheads {
+		edges = append(edges, sumdbtree.EdgeHeads)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SumDBRecordMutation) EdgeCleared(name string) bool {
+func (m *SumDBTreeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case sumdbrecord.EdgeAssets:
-		return m.clearedassets
-	case sumdbrecord.EdgeTree:
-		return m.clearedtree
+	case sumdbtree.EdgeHashes:
+		return m.clearedhashes
+	case sumdbtree.EdgeRecords:
+		return m.clearedrecords
+	case sumdbtree.EdgeKeys:
+		return m.clearedkeys
+	case sumdbtree.EdgeHeads:
+		return m.clearedheads
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SumDBRecordMutation) ClearEdge(name string) error {
+func (m *SumDBTreeMutation) ClearEdge(name string) error {
 	switch name {
-	case sumdbrecord.EdgeTree:
-		m.ClearTree()
-		return nil
 	}
-	return fmt.Errorf("unknown SumDBRecord unique edge %s", name)
+	return fmt.Errorf("unknown SumDBTree unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SumDBRecordMutation) ResetEdge(name string) error {
+func (m *SumDBTreeMutation) ResetEdge(name string) error {
 	switch name {
-	case sumdbrecord.EdgeAssets:
-		m.ResetAssets()
+	case sumdbtree.EdgeHashes:
+		m.ResetHashes()
 		return nil
-	case sumdbrecord.EdgeTree:
-		m.ResetTree()
+	case sumdbtree.EdgeRecords:
+		m.ResetRecords()
+		return nil
+	case sumdbtree.EdgeKeys:
+		m.ResetKeys()
+		return nil
+	case sumdbtree.EdgeHeads:
+		m.ResetHeads()
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBRecord edge %s", name)
+	return fmt.Errorf("unknown SumDBTree edge %s", name)
 }
 
-// SumDBTreeMutation represents an operation that mutates the SumDBTree nodes in the graph.
-type SumDBTreeMutation struct {
+// SumDBTreeHeadMutation represents an operation that mutates the SumDBTreeHead nodes in the graph.
+type SumDBTreeHeadMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	created_at     *time.Time
-	updated_at     *time.Time
-	name           *string
-	size           *int64
-	addsize        *int64
-	signer_key     *crypto.Secret
-	verifier_key   *string
-	clearedFields  map[string]struct{}
-	hashes         map[int]struct{}
-	removedhashes  map[int]struct{}
-	clearedhashes  bool
-	records        map[int]struct{}
-	removedrecords map[int]struct{}
-	clearedrecords bool
-	done           bool
-	oldValue       func(context.Context) (*SumDBTree, error)
-	predicates     []predicate.SumDBTree
-}
-
-var _ ent.Mutation = (*SumDBTreeMutation)(nil)
-
-// sumdbtreeOption allows management of the mutation configuration using functional options.
-type sumdbtreeOption func(*SumDBTreeMutation)
-
-// newSumDBTreeMutation creates new mutation for the SumDBTree entity.
-func newSumDBTreeMutation(c config, op Op, opts ...sumdbtreeOption) *SumDBTreeMutation {
-	m := &SumDBTreeMutation{
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	size                *int64
+	addsize             *int64
+	hash                *[]byte
+	note                *[]byte
+	clearedFields       map[string]struct{}
+	tree                *int
+	clearedtree         bool
+	cosignatures        map[int]struct{}
+	removedcosignatures map[int]struct{}
+	clearedcosignatures bool
+	done                bool
+	oldValue            func(context.Context) (*SumDBTreeHead, error)
+	predicates          []predicate.SumDBTreeHead
+}
+
+var _ ent.Mutation = (*SumDBTreeHeadMutation)(nil)
+
+// sumdbtreeheadOption allows management of the mutation configuration using functional options.
+type sumdbtreeheadOption func(*SumDBTreeHeadMutation)
+
+// newSumDBTreeHeadMutation creates new mutation for the SumDBTreeHead entity.
+func newSumDBTreeHeadMutation(c config, op Op, opts ...sumdbtreeheadOption) *SumDBTreeHeadMutation {
+	m := &SumDBTreeHeadMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSumDBTree,
+		typ:           TypeSumDBTreeHead,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2596,20 +6844,20 @@ func newSumDBTreeMutation(c config, op Op, opts ...sumdbtreeOption) *SumDBTreeMu
 	return m
 }
 
-// withSumDBTreeID sets the ID field of the mutation.
-func withSumDBTreeID(id int) sumdbtreeOption {
-	return func(m *SumDBTreeMutation) {
+// withSumDBTreeHeadID sets the ID field of the mutation.
+func withSumDBTreeHeadID(id int) sumdbtreeheadOption {
+	return func(m *SumDBTreeHeadMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SumDBTree
+			value *SumDBTreeHead
 		)
-		m.oldValue = func(ctx context.Context) (*SumDBTree, error) {
+		m.oldValue = func(ctx context.Context) (*SumDBTreeHead, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SumDBTree.Get(ctx, id)
+					value, err = m.Client().SumDBTreeHead.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2618,10 +6866,10 @@ func withSumDBTreeID(id int) sumdbtreeOption {
 	}
 }
 
-// withSumDBTree sets the old SumDBTree of the mutation.
-func withSumDBTree(node *SumDBTree) sumdbtreeOption {
-	return func(m *SumDBTreeMutation) {
-		m.oldValue = func(context.Context) (*SumDBTree, error) {
+// withSumDBTreeHead sets the old SumDBTreeHead of the mutation.
+func withSumDBTreeHead(node *SumDBTreeHead) sumdbtreeheadOption {
+	return func(m *SumDBTreeHeadMutation) {
+		m.oldValue = func(context.Context) (*SumDBTreeHead, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2630,7 +6878,7 @@ func withSumDBTree(node *SumDBTree) sumdbtreeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SumDBTreeMutation) Client() *Client {
+func (m SumDBTreeHeadMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2638,7 +6886,7 @@ func (m SumDBTreeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SumDBTreeMutation) Tx() (*Tx, error) {
+func (m SumDBTreeHeadMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2649,7 +6897,7 @@ func (m SumDBTreeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SumDBTreeMutation) ID() (id int, exists bool) {
+func (m *SumDBTreeHeadMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2660,7 +6908,7 @@ func (m *SumDBTreeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SumDBTreeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SumDBTreeHeadMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2669,19 +6917,19 @@ func (m *SumDBTreeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SumDBTree.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SumDBTreeHead.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SumDBTreeMutation) SetCreatedAt(t time.Time) {
+func (m *SumDBTreeHeadMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SumDBTreeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SumDBTreeHeadMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2689,10 +6937,10 @@ func (m *SumDBTreeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SumDBTree entity.
-// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SumDBTreeHead entity.
+// If the SumDBTreeHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBTreeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SumDBTreeHeadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2707,17 +6955,17 @@ func (m *SumDBTreeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SumDBTreeMutation) ResetCreatedAt() {
+func (m *SumDBTreeHeadMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SumDBTreeMutation) SetUpdatedAt(t time.Time) {
+func (m *SumDBTreeHeadMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SumDBTreeMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SumDBTreeHeadMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2725,10 +6973,10 @@ func (m *SumDBTreeMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SumDBTree entity.
-// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SumDBTreeHead entity.
+// If the SumDBTreeHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBTreeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SumDBTreeHeadMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2743,54 +6991,18 @@ func (m *SumDBTreeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SumDBTreeMutation) ResetUpdatedAt() {
+func (m *SumDBTreeHeadMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *SumDBTreeMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *SumDBTreeMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the SumDBTree entity.
-// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBTreeMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *SumDBTreeMutation) ResetName() {
-	m.name = nil
-}
-
 // SetSize sets the "size" field.
-func (m *SumDBTreeMutation) SetSize(i int64) {
+func (m *SumDBTreeHeadMutation) SetSize(i int64) {
 	m.size = &i
 	m.addsize = nil
 }
 
 // Size returns the value of the "size" field in the mutation.
-func (m *SumDBTreeMutation) Size() (r int64, exists bool) {
+func (m *SumDBTreeHeadMutation) Size() (r int64, exists bool) {
 	v := m.size
 	if v == nil {
 		return
@@ -2798,10 +7010,10 @@ func (m *SumDBTreeMutation) Size() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the SumDBTree entity.
-// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the SumDBTreeHead entity.
+// If the SumDBTreeHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBTreeMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *SumDBTreeHeadMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
@@ -2816,7 +7028,7 @@ func (m *SumDBTreeMutation) OldSize(ctx context.Context) (v int64, err error) {
 }
 
 // AddSize adds i to the "size" field.
-func (m *SumDBTreeMutation) AddSize(i int64) {
+func (m *SumDBTreeHeadMutation) AddSize(i int64) {
 	if m.addsize != nil {
 		*m.addsize += i
 	} else {
@@ -2825,7 +7037,7 @@ func (m *SumDBTreeMutation) AddSize(i int64) {
 }
 
 // AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *SumDBTreeMutation) AddedSize() (r int64, exists bool) {
+func (m *SumDBTreeHeadMutation) AddedSize() (r int64, exists bool) {
 	v := m.addsize
 	if v == nil {
 		return
@@ -2834,200 +7046,185 @@ func (m *SumDBTreeMutation) AddedSize() (r int64, exists bool) {
 }
 
 // ResetSize resets all changes to the "size" field.
-func (m *SumDBTreeMutation) ResetSize() {
+func (m *SumDBTreeHeadMutation) ResetSize() {
 	m.size = nil
 	m.addsize = nil
 }
 
-// SetSignerKey sets the "signer_key" field.
-func (m *SumDBTreeMutation) SetSignerKey(c crypto.Secret) {
-	m.signer_key = &c
+// SetHash sets the "hash" field.
+func (m *SumDBTreeHeadMutation) SetHash(b []byte) {
+	m.hash = &b
 }
 
-// SignerKey returns the value of the "signer_key" field in the mutation.
-func (m *SumDBTreeMutation) SignerKey() (r crypto.Secret, exists bool) {
-	v := m.signer_key
+// Hash returns the value of the "hash" field in the mutation.
+func (m *SumDBTreeHeadMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSignerKey returns the old "signer_key" field's value of the SumDBTree entity.
-// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the SumDBTreeHead entity.
+// If the SumDBTreeHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBTreeMutation) OldSignerKey(ctx context.Context) (v crypto.Secret, err error) {
+func (m *SumDBTreeHeadMutation) OldHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignerKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignerKey requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignerKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.SignerKey, nil
+	return oldValue.Hash, nil
 }
 
-// ResetSignerKey resets all changes to the "signer_key" field.
-func (m *SumDBTreeMutation) ResetSignerKey() {
-	m.signer_key = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *SumDBTreeHeadMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetVerifierKey sets the "verifier_key" field.
-func (m *SumDBTreeMutation) SetVerifierKey(s string) {
-	m.verifier_key = &s
+// SetNote sets the "note" field.
+func (m *SumDBTreeHeadMutation) SetNote(b []byte) {
+	m.note = &b
 }
 
-// VerifierKey returns the value of the "verifier_key" field in the mutation.
-func (m *SumDBTreeMutation) VerifierKey() (r string, exists bool) {
-	v := m.verifier_key
+// Note returns the value of the "note" field in the mutation.
+func (m *SumDBTreeHeadMutation) Note() (r []byte, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVerifierKey returns the old "verifier_key" field's value of the SumDBTree entity.
-// If the SumDBTree object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the SumDBTreeHead entity.
+// If the SumDBTreeHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SumDBTreeMutation) OldVerifierKey(ctx context.Context) (v string, err error) {
+func (m *SumDBTreeHeadMutation) OldNote(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerifierKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerifierKey requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerifierKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.VerifierKey, nil
-}
-
-// ResetVerifierKey resets all changes to the "verifier_key" field.
-func (m *SumDBTreeMutation) ResetVerifierKey() {
-	m.verifier_key = nil
+	return oldValue.Note, nil
 }
 
-// AddHashIDs adds the "hashes" edge to the SumDBHash entity by ids.
-func (m *SumDBTreeMutation) AddHashIDs(ids ...int) {
-	if m.hashes == nil {
-		m.hashes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.hashes[ids[i]] = struct{}{}
-	}
+// ResetNote resets all changes to the "note" field.
+func (m *SumDBTreeHeadMutation) ResetNote() {
+	m.note = nil
 }
 
-// ClearHashes clears the "hashes" edge to the SumDBHash entity.
-func (m *SumDBTreeMutation) ClearHashes() {
-	m.clearedhashes = true
+// SetTreeID sets the "tree" edge to the SumDBTree entity by id.
+func (m *SumDBTreeHeadMutation) SetTreeID(id int) {
+	m.tree = &id
 }
 
-// HashesCleared reports if the "hashes" edge to the SumDBHash entity was cleared.
-func (m *SumDBTreeMutation) HashesCleared() bool {
-	return m.clearedhashes
+// ClearTree clears the "tree" edge to the SumDBTree entity.
+func (m *SumDBTreeHeadMutation) ClearTree() {
+	m.clearedtree = true
 }
 
-// RemoveHashIDs removes the "hashes" edge to the SumDBHash entity by IDs.
-func (m *SumDBTreeMutation) RemoveHashIDs(ids ...int) {
-	if m.removedhashes == nil {
-		m.removedhashes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.hashes, ids[i])
-		m.removedhashes[ids[i]] = struct{}{}
-	}
+// TreeCleared reports if the "tree" edge to the SumDBTree entity was cleared.
+func (m *SumDBTreeHeadMutation) TreeCleared() bool {
+	return m.clearedtree
 }
 
-// RemovedHashes returns the removed IDs of the "hashes" edge to the SumDBHash entity.
-func (m *SumDBTreeMutation) RemovedHashesIDs() (ids []int) {
-	for id := range m.removedhashes {
-		ids = append(ids, id)
+// TreeID returns the "tree" edge ID in the mutation.
+func (m *SumDBTreeHeadMutation) TreeID() (id int, exists bool) {
+	if m.tree != nil {
+		return *m.tree, true
 	}
 	return
 }
 
-// HashesIDs returns the "hashes" edge IDs in the mutation.
-func (m *SumDBTreeMutation) HashesIDs() (ids []int) {
-	for id := range m.hashes {
-		ids = append(ids, id)
+// TreeIDs returns the "tree" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TreeID instead. It exists only for internal usage by the builders.
+func (m *SumDBTreeHeadMutation) TreeIDs() (ids []int) {
+	if id := m.tree; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetHashes resets all changes to the "hashes" edge.
-func (m *SumDBTreeMutation) ResetHashes() {
-	m.hashes = nil
-	m.clearedhashes = false
-	m.removedhashes = nil
+// ResetTree resets all changes to the "tree" edge.
+func (m *SumDBTreeHeadMutation) ResetTree() {
+	m.tree = nil
+	m.clearedtree = false
 }
 
-// AddRecordIDs adds the "records" edge to the SumDBRecord entity by ids.
-func (m *SumDBTreeMutation) AddRecordIDs(ids ...int) {
-	if m.records == nil {
-		m.records = make(map[int]struct{})
+// AddCosignatureIDs adds the "cosignatures" edge to the SumDBCosignature entity by ids.
+func (m *SumDBTreeHeadMutation) AddCosignatureIDs(ids ...int) {
+	if m.cosignatures == nil {
+		m.cosignatures = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.records[ids[i]] = struct{}{}
+		m.cosignatures[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRecords clears the "records" edge to the SumDBRecord entity.
-func (m *SumDBTreeMutation) ClearRecords() {
-	m.clearedrecords = true
+// ClearCosignatures clears the "cosignatures" edge to the SumDBCosignature entity.
+func (m *SumDBTreeHeadMutation) ClearCosignatures() {
+	m.clearedcosignatures = true
 }
 
-// RecordsCleared reports if the "records" edge to the SumDBRecord entity was cleared.
-func (m *SumDBTreeMutation) RecordsCleared() bool {
-	return m.clearedrecords
+// CosignaturesCleared reports if the "cosignatures" edge to the SumDBCosignature entity was cleared.
+func (m *SumDBTreeHeadMutation) CosignaturesCleared() bool {
+	return m.clearedcosignatures
 }
 
-// RemoveRecordIDs removes the "records" edge to the SumDBRecord entity by IDs.
-func (m *SumDBTreeMutation) RemoveRecordIDs(ids ...int) {
-	if m.removedrecords == nil {
-		m.removedrecords = make(map[int]struct{})
+// RemoveCosignatureIDs removes the "cosignatures" edge to the SumDBCosignature entity by IDs.
+func (m *SumDBTreeHeadMutation) RemoveCosignatureIDs(ids ...int) {
+	if m.removedcosignatures == nil {
+		m.removedcosignatures = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.records, ids[i])
-		m.removedrecords[ids[i]] = struct{}{}
+		delete(m.cosignatures, ids[i])
+		m.removedcosignatures[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRecords returns the removed IDs of the "records" edge to the SumDBRecord entity.
-func (m *SumDBTreeMutation) RemovedRecordsIDs() (ids []int) {
-	for id := range m.removedrecords {
+// RemovedCosignatures returns the removed IDs of the "cosignatures" edge to the SumDBCosignature entity.
+func (m *SumDBTreeHeadMutation) RemovedCosignaturesIDs() (ids []int) {
+	for id := range m.removedcosignatures {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RecordsIDs returns the "records" edge IDs in the mutation.
-func (m *SumDBTreeMutation) RecordsIDs() (ids []int) {
-	for id := range m.records {
+// CosignaturesIDs returns the "cosignatures" edge IDs in the mutation.
+func (m *SumDBTreeHeadMutation) CosignaturesIDs() (ids []int) {
+	for id := range m.cosignatures {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRecords resets all changes to the "records" edge.
-func (m *SumDBTreeMutation) ResetRecords() {
-	m.records = nil
-	m.clearedrecords = false
-	m.removedrecords = nil
+// ResetCosignatures resets all changes to the "cosignatures" edge.
+func (m *SumDBTreeHeadMutation) ResetCosignatures() {
+	m.cosignatures = nil
+	m.clearedcosignatures = false
+	m.removedcosignatures = nil
 }
 
-// Where appends a list predicates to the SumDBTreeMutation builder.
-func (m *SumDBTreeMutation) Where(ps ...predicate.SumDBTree) {
+// Where appends a list predicates to the SumDBTreeHeadMutation builder.
+func (m *SumDBTreeHeadMutation) Where(ps ...predicate.SumDBTreeHead) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SumDBTreeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SumDBTreeHeadMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SumDBTreeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SumDBTree, len(ps))
+func (m *SumDBTreeHeadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SumDBTreeHead, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3035,42 +7232,39 @@ func (m *SumDBTreeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SumDBTreeMutation) Op() Op {
+func (m *SumDBTreeHeadMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SumDBTreeMutation) SetOp(op Op) {
+func (m *SumDBTreeHeadMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SumDBTree).
-func (m *SumDBTreeMutation) Type() string {
+// Type returns the node type of this mutation (SumDBTreeHead).
+func (m *SumDBTreeHeadMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SumDBTreeMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *SumDBTreeHeadMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, sumdbtree.FieldCreatedAt)
+		fields = append(fields, sumdbtreehead.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, sumdbtree.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, sumdbtree.FieldName)
+		fields = append(fields, sumdbtreehead.FieldUpdatedAt)
 	}
 	if m.size != nil {
-		fields = append(fields, sumdbtree.FieldSize)
+		fields = append(fields, sumdbtreehead.FieldSize)
 	}
-	if m.signer_key != nil {
-		fields = append(fields, sumdbtree.FieldSignerKey)
+	if m.hash != nil {
+		fields = append(fields, sumdbtreehead.FieldHash)
 	}
-	if m.verifier_key != nil {
-		fields = append(fields, sumdbtree.FieldVerifierKey)
+	if m.note != nil {
+		fields = append(fields, sumdbtreehead.FieldNote)
 	}
 	return fields
 }
@@ -3078,20 +7272,18 @@ func (m *SumDBTreeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SumDBTreeMutation) Field(name string) (ent.Value, bool) {
+func (m *SumDBTreeHeadMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sumdbtree.FieldCreatedAt:
+	case sumdbtreehead.FieldCreatedAt:
 		return m.CreatedAt()
-	case sumdbtree.FieldUpdatedAt:
+	case sumdbtreehead.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case sumdbtree.FieldName:
-		return m.Name()
-	case sumdbtree.FieldSize:
+	case sumdbtreehead.FieldSize:
 		return m.Size()
-	case sumdbtree.FieldSignerKey:
-		return m.SignerKey()
-	case sumdbtree.FieldVerifierKey:
-		return m.VerifierKey()
+	case sumdbtreehead.FieldHash:
+		return m.Hash()
+	case sumdbtreehead.FieldNote:
+		return m.Note()
 	}
 	return nil, false
 }
@@ -3099,81 +7291,72 @@ func (m *SumDBTreeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SumDBTreeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SumDBTreeHeadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sumdbtree.FieldCreatedAt:
+	case sumdbtreehead.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case sumdbtree.FieldUpdatedAt:
+	case sumdbtreehead.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case sumdbtree.FieldName:
-		return m.OldName(ctx)
-	case sumdbtree.FieldSize:
+	case sumdbtreehead.FieldSize:
 		return m.OldSize(ctx)
-	case sumdbtree.FieldSignerKey:
-		return m.OldSignerKey(ctx)
-	case sumdbtree.FieldVerifierKey:
-		return m.OldVerifierKey(ctx)
+	case sumdbtreehead.FieldHash:
+		return m.OldHash(ctx)
+	case sumdbtreehead.FieldNote:
+		return m.OldNote(ctx)
 	}
-	return nil, fmt.Errorf("unknown SumDBTree field %s", name)
+	return nil, fmt.Errorf("unknown SumDBTreeHead field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SumDBTreeMutation) SetField(name string, value ent.Value) error {
+func (m *SumDBTreeHeadMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sumdbtree.FieldCreatedAt:
+	case sumdbtreehead.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case sumdbtree.FieldUpdatedAt:
+	case sumdbtreehead.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case sumdbtree.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case sumdbtree.FieldSize:
+	case sumdbtreehead.FieldSize:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSize(v)
 		return nil
-	case sumdbtree.FieldSignerKey:
-		v, ok := value.(crypto.Secret)
+	case sumdbtreehead.FieldHash:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSignerKey(v)
+		m.SetHash(v)
 		return nil
-	case sumdbtree.FieldVerifierKey:
-		v, ok := value.(string)
+	case sumdbtreehead.FieldNote:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVerifierKey(v)
+		m.SetNote(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBTree field %s", name)
+	return fmt.Errorf("unknown SumDBTreeHead field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SumDBTreeMutation) AddedFields() []string {
+func (m *SumDBTreeHeadMutation) AddedFields() []string {
 	var fields []string
 	if m.addsize != nil {
-		fields = append(fields, sumdbtree.FieldSize)
+		fields = append(fields, sumdbtreehead.FieldSize)
 	}
 	return fields
 }
@@ -3181,9 +7364,9 @@ func (m *SumDBTreeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SumDBTreeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SumDBTreeHeadMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case sumdbtree.FieldSize:
+	case sumdbtreehead.FieldSize:
 		return m.AddedSize()
 	}
 	return nil, false
@@ -3192,9 +7375,9 @@ func (m *SumDBTreeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SumDBTreeMutation) AddField(name string, value ent.Value) error {
+func (m *SumDBTreeHeadMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sumdbtree.FieldSize:
+	case sumdbtreehead.FieldSize:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3202,79 +7385,74 @@ func (m *SumDBTreeMutation) AddField(name string, value ent.Value) error {
 		m.AddSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBTree numeric field %s", name)
+	return fmt.Errorf("unknown SumDBTreeHead numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SumDBTreeMutation) ClearedFields() []string {
+func (m *SumDBTreeHeadMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SumDBTreeMutation) FieldCleared(name string) bool {
+func (m *SumDBTreeHeadMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SumDBTreeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SumDBTree nullable field %s", name)
+func (m *SumDBTreeHeadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SumDBTreeHead nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SumDBTreeMutation) ResetField(name string) error {
+func (m *SumDBTreeHeadMutation) ResetField(name string) error {
 	switch name {
-	case sumdbtree.FieldCreatedAt:
+	case sumdbtreehead.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case sumdbtree.FieldUpdatedAt:
+	case sumdbtreehead.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case sumdbtree.FieldName:
-		m.ResetName()
-		return nil
-	case sumdbtree.FieldSize:
+	case sumdbtreehead.FieldSize:
 		m.ResetSize()
 		return nil
-	case sumdbtree.FieldSignerKey:
-		m.ResetSignerKey()
+	case sumdbtreehead.FieldHash:
+		m.ResetHash()
 		return nil
-	case sumdbtree.FieldVerifierKey:
-		m.ResetVerifierKey()
+	case sumdbtreehead.FieldNote:
+		m.ResetNote()
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBTree field %s", name)
+	return fmt.Errorf("unknown SumDBTreeHead field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SumDBTreeMutation) AddedEdges() []string {
+func (m *SumDBTreeHeadMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.hashes != nil {
-		edges = append(edges, sumdbtree.EdgeHashes)
+	if m.tree != nil {
+		edges = append(edges, sumdbtreehead.EdgeTree)
 	}
-	if m.records != nil {
-		edges = append(edges, sumdbtree.EdgeRecords)
+	if m.cosignatures != nil {
+		edges = append(edges, sumdbtreehead.EdgeCosignatures)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SumDBTreeMutation) AddedIDs(name string) []ent.Value {
+func (m *SumDBTreeHeadMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case sumdbtree.EdgeHashes:
-		ids := make([]ent.Value, 0, len(m.hashes))
-		for id := range m.hashes {
-			ids = append(ids, id)
+	case sumdbtreehead.EdgeTree:
+		if id := m.tree; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case sumdbtree.EdgeRecords:
-		ids := make([]ent.Value, 0, len(m.records))
-		for id := range m.records {
+	case sumdbtreehead.EdgeCosignatures:
+		ids := make([]ent.Value, 0, len(m.cosignatures))
+		for id := range m.cosignatures {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3283,30 +7461,21 @@ func (m *SumDBTreeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SumDBTreeMutation) RemovedEdges() []string {
+func (m *SumDBTreeHeadMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedhashes != nil {
-		edges = append(edges, sumdbtree.EdgeHashes)
-	}
-	if m.removedrecords != nil {
-		edges = append(edges, sumdbtree.EdgeRecords)
+	if m.removedcosignatures != nil {
+		edges = append(edges, sumdbtreehead.EdgeCosignatures)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SumDBTreeMutation) RemovedIDs(name string) []ent.Value {
+func (m *SumDBTreeHeadMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case sumdbtree.EdgeHashes:
-		ids := make([]ent.Value, 0, len(m.removedhashes))
-		for id := range m.removedhashes {
-			ids = append(ids, id)
-		}
-		return ids
-	case sumdbtree.EdgeRecords:
-		ids := make([]ent.Value, 0, len(m.removedrecords))
-		for id := range m.removedrecords {
+	case sumdbtreehead.EdgeCosignatures:
+		ids := make([]ent.Value, 0, len(m.removedcosignatures))
+		for id := range m.removedcosignatures {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3315,47 +7484,50 @@ func (m *SumDBTreeMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SumDBTreeMutation) ClearedEdges() []string {
+func (m *SumDBTreeHeadMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedhashes {
-		edges = append(edges, sumdbtree.EdgeHashes)
+	if m.clearedtree {
+		edges = append(edges, sumdbtreehead.EdgeTree)
 	}
-	if m.clearedrecords {
-		edges = append(edges, sumdbtree.EdgeRecords)
+	if m.clearedcosignatures {
+		edges = append(edges, sumdbtreehead.EdgeCosignatures)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SumDBTreeMutation) EdgeCleared(name string) bool {
+func (m *SumDBTreeHeadMutation) EdgeCleared(name string) bool {
 	switch name {
-	case sumdbtree.EdgeHashes:
-		return m.clearedhashes
-	case sumdbtree.EdgeRecords:
-		return m.clearedrecords
+	case sumdbtreehead.EdgeTree:
+		return m.clearedtree
+	case sumdbtreehead.EdgeCosignatures:
+		return m.clearedcosignatures
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SumDBTreeMutation) ClearEdge(name string) error {
+func (m *SumDBTreeHeadMutation) ClearEdge(name string) error {
 	switch name {
+	case sumdbtreehead.EdgeTree:
+		m.ClearTree()
+		return nil
 	}
-	return fmt.Errorf("unknown SumDBTree unique edge %s", name)
+	return fmt.Errorf("unknown SumDBTreeHead unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SumDBTreeMutation) ResetEdge(name string) error {
+func (m *SumDBTreeHeadMutation) ResetEdge(name string) error {
 	switch name {
-	case sumdbtree.EdgeHashes:
-		m.ResetHashes()
+	case sumdbtreehead.EdgeTree:
+		m.ResetTree()
 		return nil
-	case sumdbtree.EdgeRecords:
-		m.ResetRecords()
+	case sumdbtreehead.EdgeCosignatures:
+		m.ResetCosignatures()
 		return nil
 	}
-	return fmt.Errorf("unknown SumDBTree edge %s", name)
+	return fmt.Errorf("unknown SumDBTreeHead edge %s", name)
 }
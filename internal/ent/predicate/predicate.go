@@ -9,14 +9,32 @@ import (
 // Archive is the predicate function for archive builders.
 type Archive func(*sql.Selector)
 
+// ArchiveReplacement is the predicate function for archivereplacement builders.
+type ArchiveReplacement func(*sql.Selector)
+
 // Asset is the predicate function for asset builders.
 type Asset func(*sql.Selector)
 
+// Deprecation is the predicate function for deprecation builders.
+type Deprecation func(*sql.Selector)
+
+// Retraction is the predicate function for retraction builders.
+type Retraction func(*sql.Selector)
+
+// SumDBCosignature is the predicate function for sumdbcosignature builders.
+type SumDBCosignature func(*sql.Selector)
+
 // SumDBHash is the predicate function for sumdbhash builders.
 type SumDBHash func(*sql.Selector)
 
+// SumDBKey is the predicate function for sumdbkey builders.
+type SumDBKey func(*sql.Selector)
+
 // SumDBRecord is the predicate function for sumdbrecord builders.
 type SumDBRecord func(*sql.Selector)
 
 // SumDBTree is the predicate function for sumdbtree builders.
 type SumDBTree func(*sql.Selector)
+
+// SumDBTreeHead is the predicate function for sumdbtreehead builders.
+type SumDBTreeHead func(*sql.Selector)
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retraction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldPath, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldVersion, v))
+}
+
+// Rationale applies equality check predicate on the "rationale" field. It's identical to RationaleEQ.
+func Rationale(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldRationale, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldContainsFold(FieldPath, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// RationaleEQ applies the EQ predicate on the "rationale" field.
+func RationaleEQ(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEQ(FieldRationale, v))
+}
+
+// RationaleNEQ applies the NEQ predicate on the "rationale" field.
+func RationaleNEQ(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNEQ(FieldRationale, v))
+}
+
+// RationaleIn applies the In predicate on the "rationale" field.
+func RationaleIn(vs ...string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldIn(FieldRationale, vs...))
+}
+
+// RationaleNotIn applies the NotIn predicate on the "rationale" field.
+func RationaleNotIn(vs ...string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldNotIn(FieldRationale, vs...))
+}
+
+// RationaleGT applies the GT predicate on the "rationale" field.
+func RationaleGT(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGT(FieldRationale, v))
+}
+
+// RationaleGTE applies the GTE predicate on the "rationale" field.
+func RationaleGTE(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldGTE(FieldRationale, v))
+}
+
+// RationaleLT applies the LT predicate on the "rationale" field.
+func RationaleLT(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLT(FieldRationale, v))
+}
+
+// RationaleLTE applies the LTE predicate on the "rationale" field.
+func RationaleLTE(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldLTE(FieldRationale, v))
+}
+
+// RationaleContains applies the Contains predicate on the "rationale" field.
+func RationaleContains(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldContains(FieldRationale, v))
+}
+
+// RationaleHasPrefix applies the HasPrefix predicate on the "rationale" field.
+func RationaleHasPrefix(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldHasPrefix(FieldRationale, v))
+}
+
+// RationaleHasSuffix applies the HasSuffix predicate on the "rationale" field.
+func RationaleHasSuffix(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldHasSuffix(FieldRationale, v))
+}
+
+// RationaleEqualFold applies the EqualFold predicate on the "rationale" field.
+func RationaleEqualFold(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldEqualFold(FieldRationale, v))
+}
+
+// RationaleContainsFold applies the ContainsFold predicate on the "rationale" field.
+func RationaleContainsFold(v string) predicate.Retraction {
+	return predicate.Retraction(sql.FieldContainsFold(FieldRationale, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Retraction) predicate.Retraction {
+	return predicate.Retraction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Retraction) predicate.Retraction {
+	return predicate.Retraction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Retraction) predicate.Retraction {
+	return predicate.Retraction(sql.NotPredicates(p))
+}
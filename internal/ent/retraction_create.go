@@ -0,0 +1,691 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
+)
+
+// RetractionCreate is the builder for creating a Retraction entity.
+type RetractionCreate struct {
+	config
+	mutation *RetractionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RetractionCreate) SetCreatedAt(v time.Time) *RetractionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RetractionCreate) SetNillableCreatedAt(v *time.Time) *RetractionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RetractionCreate) SetUpdatedAt(v time.Time) *RetractionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RetractionCreate) SetNillableUpdatedAt(v *time.Time) *RetractionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *RetractionCreate) SetPath(v string) *RetractionCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *RetractionCreate) SetVersion(v string) *RetractionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetRationale sets the "rationale" field.
+func (_c *RetractionCreate) SetRationale(v string) *RetractionCreate {
+	_c.mutation.SetRationale(v)
+	return _c
+}
+
+// SetNillableRationale sets the "rationale" field if the given value is not nil.
+func (_c *RetractionCreate) SetNillableRationale(v *string) *RetractionCreate {
+	if v != nil {
+		_c.SetRationale(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RetractionMutation object of the builder.
+func (_c *RetractionCreate) Mutation() *RetractionMutation {
+	return _c.mutation
+}
+
+// Save creates the Retraction in the database.
+func (_c *RetractionCreate) Save(ctx context.Context) (*Retraction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RetractionCreate) SaveX(ctx context.Context) *Retraction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RetractionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RetractionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RetractionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := retraction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := retraction.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Rationale(); !ok {
+		v := retraction.DefaultRationale
+		_c.mutation.SetRationale(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RetractionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Retraction.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Retraction.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Retraction.path"`)}
+	}
+	if v, ok := _c.mutation.Path(); ok {
+		if err := retraction.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Retraction.path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Retraction.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := retraction.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Retraction.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rationale(); !ok {
+		return &ValidationError{Name: "rationale", err: errors.New(`ent: missing required field "Retraction.rationale"`)}
+	}
+	return nil
+}
+
+func (_c *RetractionCreate) sqlSave(ctx context.Context) (*Retraction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RetractionCreate) createSpec() (*Retraction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Retraction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(retraction.Table, sqlgraph.NewFieldSpec(retraction.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(retraction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(retraction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(retraction.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(retraction.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Rationale(); ok {
+		_spec.SetField(retraction.FieldRationale, field.TypeString, value)
+		_node.Rationale = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Retraction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RetractionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RetractionCreate) OnConflict(opts ...sql.ConflictOption) *RetractionUpsertOne {
+	_c.conflict = opts
+	return &RetractionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Retraction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RetractionCreate) OnConflictColumns(columns ...string) *RetractionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RetractionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RetractionUpsertOne is the builder for "upsert"-ing
+	//  one Retraction node.
+	RetractionUpsertOne struct {
+		create *RetractionCreate
+	}
+
+	// RetractionUpsert is the "OnConflict" setter.
+	RetractionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RetractionUpsert) SetUpdatedAt(v time.Time) *RetractionUpsert {
+	u.Set(retraction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RetractionUpsert) UpdateUpdatedAt() *RetractionUpsert {
+	u.SetExcluded(retraction.FieldUpdatedAt)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *RetractionUpsert) SetPath(v string) *RetractionUpsert {
+	u.Set(retraction.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *RetractionUpsert) UpdatePath() *RetractionUpsert {
+	u.SetExcluded(retraction.FieldPath)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *RetractionUpsert) SetVersion(v string) *RetractionUpsert {
+	u.Set(retraction.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *RetractionUpsert) UpdateVersion() *RetractionUpsert {
+	u.SetExcluded(retraction.FieldVersion)
+	return u
+}
+
+// SetRationale sets the "rationale" field.
+func (u *RetractionUpsert) SetRationale(v string) *RetractionUpsert {
+	u.Set(retraction.FieldRationale, v)
+	return u
+}
+
+// UpdateRationale sets the "rationale" field to the value that was provided on create.
+func (u *RetractionUpsert) UpdateRationale() *RetractionUpsert {
+	u.SetExcluded(retraction.FieldRationale)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Retraction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RetractionUpsertOne) UpdateNewValues() *RetractionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(retraction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Retraction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RetractionUpsertOne) Ignore() *RetractionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RetractionUpsertOne) DoNothing() *RetractionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RetractionCreate.OnConflict
+// documentation for more info.
+func (u *RetractionUpsertOne) Update(set func(*RetractionUpsert)) *RetractionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RetractionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RetractionUpsertOne) SetUpdatedAt(v time.Time) *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RetractionUpsertOne) UpdateUpdatedAt() *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *RetractionUpsertOne) SetPath(v string) *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *RetractionUpsertOne) UpdatePath() *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *RetractionUpsertOne) SetVersion(v string) *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *RetractionUpsertOne) UpdateVersion() *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetRationale sets the "rationale" field.
+func (u *RetractionUpsertOne) SetRationale(v string) *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetRationale(v)
+	})
+}
+
+// UpdateRationale sets the "rationale" field to the value that was provided on create.
+func (u *RetractionUpsertOne) UpdateRationale() *RetractionUpsertOne {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdateRationale()
+	})
+}
+
+// Exec executes the query.
+func (u *RetractionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RetractionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RetractionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RetractionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RetractionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RetractionCreateBulk is the builder for creating many Retraction entities in bulk.
+type RetractionCreateBulk struct {
+	config
+	err      error
+	builders []*RetractionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Retraction entities in the database.
+func (_c *RetractionCreateBulk) Save(ctx context.Context) ([]*Retraction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Retraction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RetractionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RetractionCreateBulk) SaveX(ctx context.Context) []*Retraction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RetractionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RetractionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Retraction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RetractionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RetractionCreateBulk) OnConflict(opts ...sql.ConflictOption) *RetractionUpsertBulk {
+	_c.conflict = opts
+	return &RetractionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Retraction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RetractionCreateBulk) OnConflictColumns(columns ...string) *RetractionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RetractionUpsertBulk{
+		create: _c,
+	}
+}
+
+// RetractionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Retraction nodes.
+type RetractionUpsertBulk struct {
+	create *RetractionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Retraction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RetractionUpsertBulk) UpdateNewValues() *RetractionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(retraction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Retraction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RetractionUpsertBulk) Ignore() *RetractionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RetractionUpsertBulk) DoNothing() *RetractionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RetractionCreateBulk.OnConflict
+// documentation for more info.
+func (u *RetractionUpsertBulk) Update(set func(*RetractionUpsert)) *RetractionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RetractionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RetractionUpsertBulk) SetUpdatedAt(v time.Time) *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RetractionUpsertBulk) UpdateUpdatedAt() *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *RetractionUpsertBulk) SetPath(v string) *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *RetractionUpsertBulk) UpdatePath() *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *RetractionUpsertBulk) SetVersion(v string) *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *RetractionUpsertBulk) UpdateVersion() *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetRationale sets the "rationale" field.
+func (u *RetractionUpsertBulk) SetRationale(v string) *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.SetRationale(v)
+	})
+}
+
+// UpdateRationale sets the "rationale" field to the value that was provided on create.
+func (u *RetractionUpsertBulk) UpdateRationale() *RetractionUpsertBulk {
+	return u.Update(func(s *RetractionUpsert) {
+		s.UpdateRationale()
+	})
+}
+
+// Exec executes the query.
+func (u *RetractionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RetractionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RetractionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RetractionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
+)
+
+// RetractionUpdate is the builder for updating Retraction entities.
+type RetractionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RetractionMutation
+}
+
+// Where appends a list predicates to the RetractionUpdate builder.
+func (_u *RetractionUpdate) Where(ps ...predicate.Retraction) *RetractionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RetractionUpdate) SetUpdatedAt(v time.Time) *RetractionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *RetractionUpdate) SetPath(v string) *RetractionUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *RetractionUpdate) SetNillablePath(v *string) *RetractionUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *RetractionUpdate) SetVersion(v string) *RetractionUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *RetractionUpdate) SetNillableVersion(v *string) *RetractionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetRationale sets the "rationale" field.
+func (_u *RetractionUpdate) SetRationale(v string) *RetractionUpdate {
+	_u.mutation.SetRationale(v)
+	return _u
+}
+
+// SetNillableRationale sets the "rationale" field if the given value is not nil.
+func (_u *RetractionUpdate) SetNillableRationale(v *string) *RetractionUpdate {
+	if v != nil {
+		_u.SetRationale(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RetractionMutation object of the builder.
+func (_u *RetractionUpdate) Mutation() *RetractionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RetractionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RetractionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RetractionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RetractionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RetractionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := retraction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RetractionUpdate) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := retraction.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Retraction.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := retraction.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Retraction.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RetractionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retraction.Table, retraction.Columns, sqlgraph.NewFieldSpec(retraction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(retraction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(retraction.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(retraction.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rationale(); ok {
+		_spec.SetField(retraction.FieldRationale, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retraction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RetractionUpdateOne is the builder for updating a single Retraction entity.
+type RetractionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RetractionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RetractionUpdateOne) SetUpdatedAt(v time.Time) *RetractionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *RetractionUpdateOne) SetPath(v string) *RetractionUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *RetractionUpdateOne) SetNillablePath(v *string) *RetractionUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *RetractionUpdateOne) SetVersion(v string) *RetractionUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *RetractionUpdateOne) SetNillableVersion(v *string) *RetractionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetRationale sets the "rationale" field.
+func (_u *RetractionUpdateOne) SetRationale(v string) *RetractionUpdateOne {
+	_u.mutation.SetRationale(v)
+	return _u
+}
+
+// SetNillableRationale sets the "rationale" field if the given value is not nil.
+func (_u *RetractionUpdateOne) SetNillableRationale(v *string) *RetractionUpdateOne {
+	if v != nil {
+		_u.SetRationale(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RetractionMutation object of the builder.
+func (_u *RetractionUpdateOne) Mutation() *RetractionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RetractionUpdate builder.
+func (_u *RetractionUpdateOne) Where(ps ...predicate.Retraction) *RetractionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RetractionUpdateOne) Select(field string, fields ...string) *RetractionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Retraction entity.
+func (_u *RetractionUpdateOne) Save(ctx context.Context) (*Retraction, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RetractionUpdateOne) SaveX(ctx context.Context) *Retraction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RetractionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RetractionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RetractionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := retraction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RetractionUpdateOne) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := retraction.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Retraction.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := retraction.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Retraction.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RetractionUpdateOne) sqlSave(ctx context.Context) (_node *Retraction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retraction.Table, retraction.Columns, sqlgraph.NewFieldSpec(retraction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Retraction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retraction.FieldID)
+		for _, f := range fields {
+			if !retraction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != retraction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(retraction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(retraction.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(retraction.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rationale(); ok {
+		_spec.SetField(retraction.FieldRationale, field.TypeString, value)
+	}
+	_node = &Retraction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retraction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
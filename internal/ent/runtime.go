@@ -6,11 +6,17 @@ import (
 	"time"
 
 	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/archivereplacement"
 	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
 	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -36,6 +42,29 @@ func init() {
 	archiveDescCoordinate := archiveFields[1].Descriptor()
 	// archive.CoordinateValidator is a validator for the "coordinate" field. It is called by the builders before save.
 	archive.CoordinateValidator = archiveDescCoordinate.Validators[0].(func(string) error)
+	// archiveDescCached is the schema descriptor for cached field.
+	archiveDescCached := archiveFields[6].Descriptor()
+	// archive.DefaultCached holds the default value on creation for the cached field.
+	archive.DefaultCached = archiveDescCached.Default.(bool)
+	archivereplacementMixin := schema.ArchiveReplacement{}.Mixin()
+	archivereplacementMixinFields0 := archivereplacementMixin[0].Fields()
+	_ = archivereplacementMixinFields0
+	archivereplacementFields := schema.ArchiveReplacement{}.Fields()
+	_ = archivereplacementFields
+	// archivereplacementDescCreatedAt is the schema descriptor for created_at field.
+	archivereplacementDescCreatedAt := archivereplacementMixinFields0[0].Descriptor()
+	// archivereplacement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	archivereplacement.DefaultCreatedAt = archivereplacementDescCreatedAt.Default.(func() time.Time)
+	// archivereplacementDescUpdatedAt is the schema descriptor for updated_at field.
+	archivereplacementDescUpdatedAt := archivereplacementMixinFields0[1].Descriptor()
+	// archivereplacement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	archivereplacement.DefaultUpdatedAt = archivereplacementDescUpdatedAt.Default.(func() time.Time)
+	// archivereplacement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	archivereplacement.UpdateDefaultUpdatedAt = archivereplacementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// archivereplacementDescCoordinate is the schema descriptor for coordinate field.
+	archivereplacementDescCoordinate := archivereplacementFields[0].Descriptor()
+	// archivereplacement.CoordinateValidator is a validator for the "coordinate" field. It is called by the builders before save.
+	archivereplacement.CoordinateValidator = archivereplacementDescCoordinate.Validators[0].(func(string) error)
 	assetMixin := schema.Asset{}.Mixin()
 	assetMixinFields0 := assetMixin[0].Fields()
 	_ = assetMixinFields0
@@ -55,6 +84,67 @@ func init() {
 	assetDescURI := assetFields[1].Descriptor()
 	// asset.URIValidator is a validator for the "uri" field. It is called by the builders before save.
 	asset.URIValidator = assetDescURI.Validators[0].(func(string) error)
+	deprecationMixin := schema.Deprecation{}.Mixin()
+	deprecationMixinFields0 := deprecationMixin[0].Fields()
+	_ = deprecationMixinFields0
+	deprecationFields := schema.Deprecation{}.Fields()
+	_ = deprecationFields
+	// deprecationDescCreatedAt is the schema descriptor for created_at field.
+	deprecationDescCreatedAt := deprecationMixinFields0[0].Descriptor()
+	// deprecation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deprecation.DefaultCreatedAt = deprecationDescCreatedAt.Default.(func() time.Time)
+	// deprecationDescUpdatedAt is the schema descriptor for updated_at field.
+	deprecationDescUpdatedAt := deprecationMixinFields0[1].Descriptor()
+	// deprecation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deprecation.DefaultUpdatedAt = deprecationDescUpdatedAt.Default.(func() time.Time)
+	// deprecation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deprecation.UpdateDefaultUpdatedAt = deprecationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deprecationDescPath is the schema descriptor for path field.
+	deprecationDescPath := deprecationFields[0].Descriptor()
+	// deprecation.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	deprecation.PathValidator = deprecationDescPath.Validators[0].(func(string) error)
+	retractionMixin := schema.Retraction{}.Mixin()
+	retractionMixinFields0 := retractionMixin[0].Fields()
+	_ = retractionMixinFields0
+	retractionFields := schema.Retraction{}.Fields()
+	_ = retractionFields
+	// retractionDescCreatedAt is the schema descriptor for created_at field.
+	retractionDescCreatedAt := retractionMixinFields0[0].Descriptor()
+	// retraction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	retraction.DefaultCreatedAt = retractionDescCreatedAt.Default.(func() time.Time)
+	// retractionDescUpdatedAt is the schema descriptor for updated_at field.
+	retractionDescUpdatedAt := retractionMixinFields0[1].Descriptor()
+	// retraction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	retraction.DefaultUpdatedAt = retractionDescUpdatedAt.Default.(func() time.Time)
+	// retraction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	retraction.UpdateDefaultUpdatedAt = retractionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// retractionDescPath is the schema descriptor for path field.
+	retractionDescPath := retractionFields[0].Descriptor()
+	// retraction.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	retraction.PathValidator = retractionDescPath.Validators[0].(func(string) error)
+	// retractionDescVersion is the schema descriptor for version field.
+	retractionDescVersion := retractionFields[1].Descriptor()
+	// retraction.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	retraction.VersionValidator = retractionDescVersion.Validators[0].(func(string) error)
+	// retractionDescRationale is the schema descriptor for rationale field.
+	retractionDescRationale := retractionFields[2].Descriptor()
+	// retraction.DefaultRationale holds the default value on creation for the rationale field.
+	retraction.DefaultRationale = retractionDescRationale.Default.(string)
+	sumdbcosignatureMixin := schema.SumDBCosignature{}.Mixin()
+	sumdbcosignatureMixinFields0 := sumdbcosignatureMixin[0].Fields()
+	_ = sumdbcosignatureMixinFields0
+	sumdbcosignatureFields := schema.SumDBCosignature{}.Fields()
+	_ = sumdbcosignatureFields
+	// sumdbcosignatureDescCreatedAt is the schema descriptor for created_at field.
+	sumdbcosignatureDescCreatedAt := sumdbcosignatureMixinFields0[0].Descriptor()
+	// sumdbcosignature.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sumdbcosignature.DefaultCreatedAt = sumdbcosignatureDescCreatedAt.Default.(func() time.Time)
+	// sumdbcosignatureDescUpdatedAt is the schema descriptor for updated_at field.
+	sumdbcosignatureDescUpdatedAt := sumdbcosignatureMixinFields0[1].Descriptor()
+	// sumdbcosignature.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sumdbcosignature.DefaultUpdatedAt = sumdbcosignatureDescUpdatedAt.Default.(func() time.Time)
+	// sumdbcosignature.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sumdbcosignature.UpdateDefaultUpdatedAt = sumdbcosignatureDescUpdatedAt.UpdateDefault.(func() time.Time)
 	sumdbhashMixin := schema.SumDBHash{}.Mixin()
 	sumdbhashMixinFields0 := sumdbhashMixin[0].Fields()
 	_ = sumdbhashMixinFields0
@@ -70,6 +160,29 @@ func init() {
 	sumdbhash.DefaultUpdatedAt = sumdbhashDescUpdatedAt.Default.(func() time.Time)
 	// sumdbhash.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	sumdbhash.UpdateDefaultUpdatedAt = sumdbhashDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sumdbkeyMixin := schema.SumDBKey{}.Mixin()
+	sumdbkeyMixinFields0 := sumdbkeyMixin[0].Fields()
+	_ = sumdbkeyMixinFields0
+	sumdbkeyFields := schema.SumDBKey{}.Fields()
+	_ = sumdbkeyFields
+	// sumdbkeyDescCreatedAt is the schema descriptor for created_at field.
+	sumdbkeyDescCreatedAt := sumdbkeyMixinFields0[0].Descriptor()
+	// sumdbkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sumdbkey.DefaultCreatedAt = sumdbkeyDescCreatedAt.Default.(func() time.Time)
+	// sumdbkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	sumdbkeyDescUpdatedAt := sumdbkeyMixinFields0[1].Descriptor()
+	// sumdbkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sumdbkey.DefaultUpdatedAt = sumdbkeyDescUpdatedAt.Default.(func() time.Time)
+	// sumdbkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sumdbkey.UpdateDefaultUpdatedAt = sumdbkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sumdbkeyDescSignerKey is the schema descriptor for signer_key field.
+	sumdbkeyDescSignerKey := sumdbkeyFields[0].Descriptor()
+	// sumdbkey.SignerKeyValidator is a validator for the "signer_key" field. It is called by the builders before save.
+	sumdbkey.SignerKeyValidator = sumdbkeyDescSignerKey.Validators[0].(func(string) error)
+	// sumdbkeyDescVerifierKey is the schema descriptor for verifier_key field.
+	sumdbkeyDescVerifierKey := sumdbkeyFields[1].Descriptor()
+	// sumdbkey.VerifierKeyValidator is a validator for the "verifier_key" field. It is called by the builders before save.
+	sumdbkey.VerifierKeyValidator = sumdbkeyDescVerifierKey.Validators[0].(func(string) error)
 	sumdbrecordMixin := schema.SumDBRecord{}.Mixin()
 	sumdbrecordMixinFields0 := sumdbrecordMixin[0].Fields()
 	_ = sumdbrecordMixinFields0
@@ -120,4 +233,19 @@ func init() {
 	sumdbtreeDescVerifierKey := sumdbtreeFields[3].Descriptor()
 	// sumdbtree.VerifierKeyValidator is a validator for the "verifier_key" field. It is called by the builders before save.
 	sumdbtree.VerifierKeyValidator = sumdbtreeDescVerifierKey.Validators[0].(func(string) error)
+	sumdbtreeheadMixin := schema.SumDBTreeHead{}.Mixin()
+	sumdbtreeheadMixinFields0 := sumdbtreeheadMixin[0].Fields()
+	_ = sumdbtreeheadMixinFields0
+	sumdbtreeheadFields := schema.SumDBTreeHead{}.Fields()
+	_ = sumdbtreeheadFields
+	// sumdbtreeheadDescCreatedAt is the schema descriptor for created_at field.
+	sumdbtreeheadDescCreatedAt := sumdbtreeheadMixinFields0[0].Descriptor()
+	// sumdbtreehead.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sumdbtreehead.DefaultCreatedAt = sumdbtreeheadDescCreatedAt.Default.(func() time.Time)
+	// sumdbtreeheadDescUpdatedAt is the schema descriptor for updated_at field.
+	sumdbtreeheadDescUpdatedAt := sumdbtreeheadMixinFields0[1].Descriptor()
+	// sumdbtreehead.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sumdbtreehead.DefaultUpdatedAt = sumdbtreeheadDescUpdatedAt.Default.(func() time.Time)
+	// sumdbtreehead.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sumdbtreehead.UpdateDefaultUpdatedAt = sumdbtreeheadDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -7,9 +7,20 @@ import (
 	"github.com/pseudomuto/pacman/internal/types"
 )
 
-type Archive struct {
-	ent.Schema
-}
+type (
+	Archive struct {
+		ent.Schema
+	}
+
+	// Origin describes where a version came from. This matches the Origin in the GOPROXY protocol's .info response.
+	Origin struct {
+		VCS    string `json:"VCS,omitempty"`
+		URL    string `json:"URL,omitempty"`
+		Subdir string `json:"Subdir,omitempty"`
+		Hash   string `json:"Hash,omitempty"`
+		Ref    string `json:"Ref,omitempty"`
+	}
+)
 
 func (Archive) Mixin() []ent.Mixin {
 	return []ent.Mixin{TimeMixin{}}
@@ -18,8 +29,21 @@ func (Archive) Mixin() []ent.Mixin {
 func (Archive) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").GoType(types.ArchiveType(-1)),
-		field.String("coordinate").MaxLen(200),
+		field.String("coordinate").MaxLen(MaxCoordinateLen),
 		field.JSON("assets", []AssetURL{}),
+		field.Time("released_at").
+			Optional().
+			Nillable().
+			Comment("When the version was released (the Time in .info)"),
+		field.JSON("origin", &Origin{}).
+			Optional().
+			Comment("Where the version came from (the Origin in .info)"),
+		field.String("hash").
+			Optional().
+			Comment("The h1: dirhash of the package built from source, used to detect conflicting publishes"),
+		field.Bool("cached").
+			Default(false).
+			Comment("Whether the archive was cached from an upstream proxy, rather than published"),
 	}
 }
 
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArchiveReplacement is the audit record for an admin replacing the contents of a published Archive.
+type ArchiveReplacement struct {
+	ent.Schema
+}
+
+func (ArchiveReplacement) Mixin() []ent.Mixin {
+	return []ent.Mixin{TimeMixin{}}
+}
+
+func (ArchiveReplacement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("coordinate").MaxLen(MaxCoordinateLen).Immutable(),
+		field.String("previous_hash").
+			Immutable().
+			Comment("The hash of the replaced package, empty when it wasn't known"),
+		field.String("hash").Immutable(),
+		field.String("principal").
+			Immutable().
+			Comment("The name of the token used to replace the archive"),
+		field.Text("reason").Immutable(),
+	}
+}
+
+func (ArchiveReplacement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("coordinate"),
+	}
+}
@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Deprecation marks a Go module as deprecated.
+type Deprecation struct {
+	ent.Schema
+}
+
+func (Deprecation) Mixin() []ent.Mixin {
+	return []ent.Mixin{TimeMixin{}}
+}
+
+func (Deprecation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("path").MaxLen(MaxPathLen).Unique(),
+		field.Text("message").Comment("The deprecation message (the Deprecated comment in go.mod)"),
+	}
+}
@@ -0,0 +1,13 @@
+package schema
+
+// Limits on the Go module paths and versions stored in indexed columns.
+//
+// golang.org/x/mod/module doesn't bound their length, but indexes need bounded columns (e.g. MySQL can't index TEXT,
+// and limits keys to 3072 bytes). These leave plenty of room for deep monorepo paths and pseudo-versions (e.g.
+// v0.0.0-20191109021931-daa7c04131f5), while keeping each index within that limit.
+const (
+	MaxPathLen    = 500
+	MaxVersionLen = 200
+	// MaxCoordinateLen fits a module's path@version.
+	MaxCoordinateLen = MaxPathLen + 1 + MaxVersionLen
+)
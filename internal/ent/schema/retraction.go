@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Retraction marks a version of a Go module as retracted. Retracted versions are still served and listed, but are
+// skipped when resolving @latest.
+type Retraction struct {
+	ent.Schema
+}
+
+func (Retraction) Mixin() []ent.Mixin {
+	return []ent.Mixin{TimeMixin{}}
+}
+
+func (Retraction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("path").MaxLen(MaxPathLen),
+		field.String("version").MaxLen(MaxVersionLen),
+		field.Text("rationale").
+			Default("").
+			Comment("Why the version was retracted (the comment on the retract directive)"),
+	}
+}
+
+func (Retraction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("path", "version").Unique(),
+	}
+}
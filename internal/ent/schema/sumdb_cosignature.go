@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SumDBCosignature is a witness' signature of a SumDBTreeHead.
+type SumDBCosignature struct {
+	ent.Schema
+}
+
+func (SumDBCosignature) Mixin() []ent.Mixin {
+	return []ent.Mixin{TimeMixin{}}
+}
+
+func (SumDBCosignature) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("witness").
+			Immutable().
+			Comment("The name of the witness' key"),
+		field.Uint32("key_hash").Immutable(),
+		field.String("signature").
+			Immutable().
+			Comment("The base64 encoded signature, as it appears in the note (including the key hash)"),
+	}
+}
+
+func (SumDBCosignature) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("head"),
+		index.Fields("witness", "key_hash").Edges("head").Unique(),
+	}
+}
+
+func (SumDBCosignature) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("head", SumDBTreeHead.Type).
+			Ref("cosignatures").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
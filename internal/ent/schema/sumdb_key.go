@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/pseudomuto/pacman/internal/crypto"
+)
+
+// SumDBKey is a signing key of a SumDBTree which was replaced by rotating the tree's key. Its created_at is when the
+// key was rotated out.
+type SumDBKey struct {
+	ent.Schema
+}
+
+func (SumDBKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{TimeMixin{}}
+}
+
+func (SumDBKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("signer_key").MaxLen(100).GoType(crypto.Secret("")).Immutable(),
+		field.String("verifier_key").MaxLen(100).Immutable(),
+		field.Time("cosign_until").
+			Comment("Tree heads are signed with this key (along with the current one) until this time"),
+	}
+}
+
+func (SumDBKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("tree"),
+	}
+}
+
+func (SumDBKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("tree", SumDBTree.Type).
+			Ref("keys").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
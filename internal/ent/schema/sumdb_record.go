@@ -18,8 +18,8 @@ func (SumDBRecord) Mixin() []ent.Mixin {
 func (SumDBRecord) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("record_id"),
-		field.String("path").MaxLen(200),
-		field.String("version").MaxLen(20),
+		field.String("path").MaxLen(MaxPathLen),
+		field.String("version").MaxLen(MaxVersionLen),
 		field.Bytes("data"),
 	}
 }
@@ -21,6 +21,8 @@ func (SumDBTree) Fields() []ent.Field {
 		field.Int64("size"),
 		field.String("signer_key").MaxLen(100).GoType(crypto.Secret("")),
 		field.String("verifier_key").MaxLen(100),
+		// Frozen trees are served, but never grow. Retired trees are no longer served.
+		field.Enum("status").Values("active", "frozen", "retired").Default("active"),
 	}
 }
 
@@ -30,5 +32,9 @@ func (SumDBTree) Edges() []ent.Edge {
 			StorageKey(edge.Column("tree_id")),
 		edge.To("records", SumDBRecord.Type).
 			StorageKey(edge.Column("tree_id")),
+		edge.To("keys", SumDBKey.Type).
+			StorageKey(edge.Column("tree_id")),
+		edge.To("heads", SumDBTreeHead.Type).
+			StorageKey(edge.Column("tree_id")),
 	}
 }
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SumDBTreeHead is a signed tree head of a SumDBTree. One is recorded for every size the tree is signed at, so the
+// history of the tree can be audited (and cosigned by witnesses).
+type SumDBTreeHead struct {
+	ent.Schema
+}
+
+func (SumDBTreeHead) Mixin() []ent.Mixin {
+	return []ent.Mixin{TimeMixin{}}
+}
+
+func (SumDBTreeHead) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("size").Immutable(),
+		field.Bytes("hash").Immutable(),
+		field.Bytes("note").
+			Immutable().
+			Comment("The signed note, as first served by the tree"),
+	}
+}
+
+func (SumDBTreeHead) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("tree"),
+		index.Fields("size").Edges("tree").Unique(),
+	}
+}
+
+func (SumDBTreeHead) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("tree", SumDBTree.Type).
+			Ref("heads").
+			Required().
+			Immutable().
+			Unique(),
+		edge.To("cosignatures", SumDBCosignature.Type).
+			StorageKey(edge.Column("head_id")),
+	}
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBCosignature is the model entity for the SumDBCosignature schema.
+type SumDBCosignature struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// When this object was initially created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The last time this object was modified
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The name of the witness' key
+	Witness string `json:"witness,omitempty"`
+	// KeyHash holds the value of the "key_hash" field.
+	KeyHash uint32 `json:"key_hash,omitempty"`
+	// The base64 encoded signature, as it appears in the note (including the key hash)
+	Signature string `json:"signature,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SumDBCosignatureQuery when eager-loading is set.
+	Edges        SumDBCosignatureEdges `json:"edges"`
+	head_id      *int
+	selectValues sql.SelectValues
+}
+
+// SumDBCosignatureEdges holds the relations/edges for other nodes in the graph.
+type SumDBCosignatureEdges struct {
+	// Head holds the value of the head edge.
+	Head *SumDBTreeHead `json:"head,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HeadOrErr returns the Head value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SumDBCosignatureEdges) HeadOrErr() (*SumDBTreeHead, error) {
+	if e.Head != nil {
+		return e.Head, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: sumdbtreehead.Label}
+	}
+	return nil, &NotLoadedError{edge: "head"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SumDBCosignature) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sumdbcosignature.FieldID, sumdbcosignature.FieldKeyHash:
+			values[i] = new(sql.NullInt64)
+		case sumdbcosignature.FieldWitness, sumdbcosignature.FieldSignature:
+			values[i] = new(sql.NullString)
+		case sumdbcosignature.FieldCreatedAt, sumdbcosignature.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case sumdbcosignature.ForeignKeys[0]: // head_id
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SumDBCosignature fields.
+func (_m *SumDBCosignature) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sumdbcosignature.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case sumdbcosignature.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sumdbcosignature.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case sumdbcosignature.FieldWitness:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field witness", values[i])
+			} else if value.Valid {
+				_m.Witness = value.String
+			}
+		case sumdbcosignature.FieldKeyHash:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key_hash", values[i])
+			} else if value.Valid {
+				_m.KeyHash = uint32(value.Int64)
+			}
+		case sumdbcosignature.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				_m.Signature = value.String
+			}
+		case sumdbcosignature.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field head_id", value)
+			} else if value.Valid {
+				_m.head_id = new(int)
+				*_m.head_id = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SumDBCosignature.
+// This includes values selected through modifiers, order, etc.
+func (_m *SumDBCosignature) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHead queries the "head" edge of the SumDBCosignature entity.
+func (_m *SumDBCosignature) QueryHead() *SumDBTreeHeadQuery {
+	return NewSumDBCosignatureClient(_m.config).QueryHead(_m)
+}
+
+// Update returns a builder for updating this SumDBCosignature.
+// Note that you need to call SumDBCosignature.Unwrap() before calling this method if this SumDBCosignature
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SumDBCosignature) Update() *SumDBCosignatureUpdateOne {
+	return NewSumDBCosignatureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SumDBCosignature entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SumDBCosignature) Unwrap() *SumDBCosignature {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SumDBCosignature is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SumDBCosignature) String() string {
+	var builder strings.Builder
+	builder.WriteString("SumDBCosignature(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("witness=")
+	builder.WriteString(_m.Witness)
+	builder.WriteString(", ")
+	builder.WriteString("key_hash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyHash))
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(_m.Signature)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SumDBCosignatures is a parsable slice of SumDBCosignature.
+type SumDBCosignatures []*SumDBCosignature
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sumdbcosignature
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sumdbcosignature type in the database.
+	Label = "sum_db_cosignature"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldWitness holds the string denoting the witness field in the database.
+	FieldWitness = "witness"
+	// FieldKeyHash holds the string denoting the key_hash field in the database.
+	FieldKeyHash = "key_hash"
+	// FieldSignature holds the string denoting the signature field in the database.
+	FieldSignature = "signature"
+	// EdgeHead holds the string denoting the head edge name in mutations.
+	EdgeHead = "head"
+	// Table holds the table name of the sumdbcosignature in the database.
+	Table = "sum_db_cosignatures"
+	// HeadTable is the table that holds the head relation/edge.
+	HeadTable = "sum_db_cosignatures"
+	// HeadInverseTable is the table name for the SumDBTreeHead entity.
+	// It exists in this package in order to avoid circular dependency with the "sumdbtreehead" package.
+	HeadInverseTable = "sum_db_tree_heads"
+	// HeadColumn is the table column denoting the head relation/edge.
+	HeadColumn = "head_id"
+)
+
+// Columns holds all SQL columns for sumdbcosignature fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldWitness,
+	FieldKeyHash,
+	FieldSignature,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sum_db_cosignatures"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"head_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SumDBCosignature queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByWitness orders the results by the witness field.
+func ByWitness(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWitness, opts...).ToFunc()
+}
+
+// ByKeyHash orders the results by the key_hash field.
+func ByKeyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyHash, opts...).ToFunc()
+}
+
+// BySignature orders the results by the signature field.
+func BySignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignature, opts...).ToFunc()
+}
+
+// ByHeadField orders the results by head field.
+func ByHeadField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHeadStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHeadStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HeadInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HeadTable, HeadColumn),
+	)
+}
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sumdbcosignature
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Witness applies equality check predicate on the "witness" field. It's identical to WitnessEQ.
+func Witness(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldWitness, v))
+}
+
+// KeyHash applies equality check predicate on the "key_hash" field. It's identical to KeyHashEQ.
+func KeyHash(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldKeyHash, v))
+}
+
+// Signature applies equality check predicate on the "signature" field. It's identical to SignatureEQ.
+func Signature(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldSignature, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// WitnessEQ applies the EQ predicate on the "witness" field.
+func WitnessEQ(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldWitness, v))
+}
+
+// WitnessNEQ applies the NEQ predicate on the "witness" field.
+func WitnessNEQ(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNEQ(FieldWitness, v))
+}
+
+// WitnessIn applies the In predicate on the "witness" field.
+func WitnessIn(vs ...string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldIn(FieldWitness, vs...))
+}
+
+// WitnessNotIn applies the NotIn predicate on the "witness" field.
+func WitnessNotIn(vs ...string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNotIn(FieldWitness, vs...))
+}
+
+// WitnessGT applies the GT predicate on the "witness" field.
+func WitnessGT(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGT(FieldWitness, v))
+}
+
+// WitnessGTE applies the GTE predicate on the "witness" field.
+func WitnessGTE(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGTE(FieldWitness, v))
+}
+
+// WitnessLT applies the LT predicate on the "witness" field.
+func WitnessLT(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLT(FieldWitness, v))
+}
+
+// WitnessLTE applies the LTE predicate on the "witness" field.
+func WitnessLTE(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLTE(FieldWitness, v))
+}
+
+// WitnessContains applies the Contains predicate on the "witness" field.
+func WitnessContains(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldContains(FieldWitness, v))
+}
+
+// WitnessHasPrefix applies the HasPrefix predicate on the "witness" field.
+func WitnessHasPrefix(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldHasPrefix(FieldWitness, v))
+}
+
+// WitnessHasSuffix applies the HasSuffix predicate on the "witness" field.
+func WitnessHasSuffix(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldHasSuffix(FieldWitness, v))
+}
+
+// WitnessEqualFold applies the EqualFold predicate on the "witness" field.
+func WitnessEqualFold(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEqualFold(FieldWitness, v))
+}
+
+// WitnessContainsFold applies the ContainsFold predicate on the "witness" field.
+func WitnessContainsFold(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldContainsFold(FieldWitness, v))
+}
+
+// KeyHashEQ applies the EQ predicate on the "key_hash" field.
+func KeyHashEQ(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldKeyHash, v))
+}
+
+// KeyHashNEQ applies the NEQ predicate on the "key_hash" field.
+func KeyHashNEQ(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNEQ(FieldKeyHash, v))
+}
+
+// KeyHashIn applies the In predicate on the "key_hash" field.
+func KeyHashIn(vs ...uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldIn(FieldKeyHash, vs...))
+}
+
+// KeyHashNotIn applies the NotIn predicate on the "key_hash" field.
+func KeyHashNotIn(vs ...uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNotIn(FieldKeyHash, vs...))
+}
+
+// KeyHashGT applies the GT predicate on the "key_hash" field.
+func KeyHashGT(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGT(FieldKeyHash, v))
+}
+
+// KeyHashGTE applies the GTE predicate on the "key_hash" field.
+func KeyHashGTE(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGTE(FieldKeyHash, v))
+}
+
+// KeyHashLT applies the LT predicate on the "key_hash" field.
+func KeyHashLT(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLT(FieldKeyHash, v))
+}
+
+// KeyHashLTE applies the LTE predicate on the "key_hash" field.
+func KeyHashLTE(v uint32) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLTE(FieldKeyHash, v))
+}
+
+// SignatureEQ applies the EQ predicate on the "signature" field.
+func SignatureEQ(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEQ(FieldSignature, v))
+}
+
+// SignatureNEQ applies the NEQ predicate on the "signature" field.
+func SignatureNEQ(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNEQ(FieldSignature, v))
+}
+
+// SignatureIn applies the In predicate on the "signature" field.
+func SignatureIn(vs ...string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldIn(FieldSignature, vs...))
+}
+
+// SignatureNotIn applies the NotIn predicate on the "signature" field.
+func SignatureNotIn(vs ...string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldNotIn(FieldSignature, vs...))
+}
+
+// SignatureGT applies the GT predicate on the "signature" field.
+func SignatureGT(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGT(FieldSignature, v))
+}
+
+// SignatureGTE applies the GTE predicate on the "signature" field.
+func SignatureGTE(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldGTE(FieldSignature, v))
+}
+
+// SignatureLT applies the LT predicate on the "signature" field.
+func SignatureLT(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLT(FieldSignature, v))
+}
+
+// SignatureLTE applies the LTE predicate on the "signature" field.
+func SignatureLTE(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldLTE(FieldSignature, v))
+}
+
+// SignatureContains applies the Contains predicate on the "signature" field.
+func SignatureContains(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldContains(FieldSignature, v))
+}
+
+// SignatureHasPrefix applies the HasPrefix predicate on the "signature" field.
+func SignatureHasPrefix(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldHasPrefix(FieldSignature, v))
+}
+
+// SignatureHasSuffix applies the HasSuffix predicate on the "signature" field.
+func SignatureHasSuffix(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldHasSuffix(FieldSignature, v))
+}
+
+// SignatureEqualFold applies the EqualFold predicate on the "signature" field.
+func SignatureEqualFold(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldEqualFold(FieldSignature, v))
+}
+
+// SignatureContainsFold applies the ContainsFold predicate on the "signature" field.
+func SignatureContainsFold(v string) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.FieldContainsFold(FieldSignature, v))
+}
+
+// HasHead applies the HasEdge predicate on the "head" edge.
+func HasHead() predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HeadTable, HeadColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHeadWith applies the HasEdge predicate on the "head" edge with a given conditions (other predicates).
+func HasHeadWith(preds ...predicate.SumDBTreeHead) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(func(s *sql.Selector) {
+		step := newHeadStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SumDBCosignature) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SumDBCosignature) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SumDBCosignature) predicate.SumDBCosignature {
+	return predicate.SumDBCosignature(sql.NotPredicates(p))
+}
@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBCosignatureCreate is the builder for creating a SumDBCosignature entity.
+type SumDBCosignatureCreate struct {
+	config
+	mutation *SumDBCosignatureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SumDBCosignatureCreate) SetCreatedAt(v time.Time) *SumDBCosignatureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SumDBCosignatureCreate) SetNillableCreatedAt(v *time.Time) *SumDBCosignatureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SumDBCosignatureCreate) SetUpdatedAt(v time.Time) *SumDBCosignatureCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SumDBCosignatureCreate) SetNillableUpdatedAt(v *time.Time) *SumDBCosignatureCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWitness sets the "witness" field.
+func (_c *SumDBCosignatureCreate) SetWitness(v string) *SumDBCosignatureCreate {
+	_c.mutation.SetWitness(v)
+	return _c
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (_c *SumDBCosignatureCreate) SetKeyHash(v uint32) *SumDBCosignatureCreate {
+	_c.mutation.SetKeyHash(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *SumDBCosignatureCreate) SetSignature(v string) *SumDBCosignatureCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetHeadID sets the "head" edge to the SumDBTreeHead entity by ID.
+func (_c *SumDBCosignatureCreate) SetHeadID(id int) *SumDBCosignatureCreate {
+	_c.mutation.SetHeadID(id)
+	return _c
+}
+
+// SetHead sets the "head" edge to the SumDBTreeHead entity.
+func (_c *SumDBCosignatureCreate) SetHead(v *SumDBTreeHead) *SumDBCosignatureCreate {
+	return _c.SetHeadID(v.ID)
+}
+
+// Mutation returns the SumDBCosignatureMutation object of the builder.
+func (_c *SumDBCosignatureCreate) Mutation() *SumDBCosignatureMutation {
+	return _c.mutation
+}
+
+// Save creates the SumDBCosignature in the database.
+func (_c *SumDBCosignatureCreate) Save(ctx context.Context) (*SumDBCosignature, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SumDBCosignatureCreate) SaveX(ctx context.Context) *SumDBCosignature {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SumDBCosignatureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SumDBCosignatureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SumDBCosignatureCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sumdbcosignature.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := sumdbcosignature.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SumDBCosignatureCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SumDBCosignature.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SumDBCosignature.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Witness(); !ok {
+		return &ValidationError{Name: "witness", err: errors.New(`ent: missing required field "SumDBCosignature.witness"`)}
+	}
+	if _, ok := _c.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "SumDBCosignature.key_hash"`)}
+	}
+	if _, ok := _c.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "SumDBCosignature.signature"`)}
+	}
+	if len(_c.mutation.HeadIDs()) == 0 {
+		return &ValidationError{Name: "head", err: errors.New(`ent: missing required edge "SumDBCosignature.head"`)}
+	}
+	return nil
+}
+
+func (_c *SumDBCosignatureCreate) sqlSave(ctx context.Context) (*SumDBCosignature, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SumDBCosignatureCreate) createSpec() (*SumDBCosignature, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SumDBCosignature{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sumdbcosignature.Table, sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sumdbcosignature.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbcosignature.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Witness(); ok {
+		_spec.SetField(sumdbcosignature.FieldWitness, field.TypeString, value)
+		_node.Witness = value
+	}
+	if value, ok := _c.mutation.KeyHash(); ok {
+		_spec.SetField(sumdbcosignature.FieldKeyHash, field.TypeUint32, value)
+		_node.KeyHash = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(sumdbcosignature.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	if nodes := _c.mutation.HeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sumdbcosignature.HeadTable,
+			Columns: []string{sumdbcosignature.HeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.head_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SumDBCosignature.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SumDBCosignatureUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SumDBCosignatureCreate) OnConflict(opts ...sql.ConflictOption) *SumDBCosignatureUpsertOne {
+	_c.conflict = opts
+	return &SumDBCosignatureUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SumDBCosignature.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SumDBCosignatureCreate) OnConflictColumns(columns ...string) *SumDBCosignatureUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SumDBCosignatureUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SumDBCosignatureUpsertOne is the builder for "upsert"-ing
+	//  one SumDBCosignature node.
+	SumDBCosignatureUpsertOne struct {
+		create *SumDBCosignatureCreate
+	}
+
+	// SumDBCosignatureUpsert is the "OnConflict" setter.
+	SumDBCosignatureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBCosignatureUpsert) SetUpdatedAt(v time.Time) *SumDBCosignatureUpsert {
+	u.Set(sumdbcosignature.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBCosignatureUpsert) UpdateUpdatedAt() *SumDBCosignatureUpsert {
+	u.SetExcluded(sumdbcosignature.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SumDBCosignature.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SumDBCosignatureUpsertOne) UpdateNewValues() *SumDBCosignatureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sumdbcosignature.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Witness(); exists {
+			s.SetIgnore(sumdbcosignature.FieldWitness)
+		}
+		if _, exists := u.create.mutation.KeyHash(); exists {
+			s.SetIgnore(sumdbcosignature.FieldKeyHash)
+		}
+		if _, exists := u.create.mutation.Signature(); exists {
+			s.SetIgnore(sumdbcosignature.FieldSignature)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SumDBCosignature.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SumDBCosignatureUpsertOne) Ignore() *SumDBCosignatureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SumDBCosignatureUpsertOne) DoNothing() *SumDBCosignatureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SumDBCosignatureCreate.OnConflict
+// documentation for more info.
+func (u *SumDBCosignatureUpsertOne) Update(set func(*SumDBCosignatureUpsert)) *SumDBCosignatureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SumDBCosignatureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBCosignatureUpsertOne) SetUpdatedAt(v time.Time) *SumDBCosignatureUpsertOne {
+	return u.Update(func(s *SumDBCosignatureUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBCosignatureUpsertOne) UpdateUpdatedAt() *SumDBCosignatureUpsertOne {
+	return u.Update(func(s *SumDBCosignatureUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SumDBCosignatureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SumDBCosignatureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SumDBCosignatureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SumDBCosignatureUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SumDBCosignatureUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SumDBCosignatureCreateBulk is the builder for creating many SumDBCosignature entities in bulk.
+type SumDBCosignatureCreateBulk struct {
+	config
+	err      error
+	builders []*SumDBCosignatureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SumDBCosignature entities in the database.
+func (_c *SumDBCosignatureCreateBulk) Save(ctx context.Context) ([]*SumDBCosignature, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SumDBCosignature, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SumDBCosignatureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SumDBCosignatureCreateBulk) SaveX(ctx context.Context) []*SumDBCosignature {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SumDBCosignatureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SumDBCosignatureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SumDBCosignature.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SumDBCosignatureUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SumDBCosignatureCreateBulk) OnConflict(opts ...sql.ConflictOption) *SumDBCosignatureUpsertBulk {
+	_c.conflict = opts
+	return &SumDBCosignatureUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SumDBCosignature.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SumDBCosignatureCreateBulk) OnConflictColumns(columns ...string) *SumDBCosignatureUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SumDBCosignatureUpsertBulk{
+		create: _c,
+	}
+}
+
+// SumDBCosignatureUpsertBulk is the builder for "upsert"-ing
+// a bulk of SumDBCosignature nodes.
+type SumDBCosignatureUpsertBulk struct {
+	create *SumDBCosignatureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SumDBCosignature.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SumDBCosignatureUpsertBulk) UpdateNewValues() *SumDBCosignatureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sumdbcosignature.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Witness(); exists {
+				s.SetIgnore(sumdbcosignature.FieldWitness)
+			}
+			if _, exists := b.mutation.KeyHash(); exists {
+				s.SetIgnore(sumdbcosignature.FieldKeyHash)
+			}
+			if _, exists := b.mutation.Signature(); exists {
+				s.SetIgnore(sumdbcosignature.FieldSignature)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SumDBCosignature.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SumDBCosignatureUpsertBulk) Ignore() *SumDBCosignatureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SumDBCosignatureUpsertBulk) DoNothing() *SumDBCosignatureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SumDBCosignatureCreateBulk.OnConflict
+// documentation for more info.
+func (u *SumDBCosignatureUpsertBulk) Update(set func(*SumDBCosignatureUpsert)) *SumDBCosignatureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SumDBCosignatureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBCosignatureUpsertBulk) SetUpdatedAt(v time.Time) *SumDBCosignatureUpsertBulk {
+	return u.Update(func(s *SumDBCosignatureUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBCosignatureUpsertBulk) UpdateUpdatedAt() *SumDBCosignatureUpsertBulk {
+	return u.Update(func(s *SumDBCosignatureUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SumDBCosignatureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SumDBCosignatureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SumDBCosignatureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SumDBCosignatureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
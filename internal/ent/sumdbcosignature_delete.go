@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+)
+
+// SumDBCosignatureDelete is the builder for deleting a SumDBCosignature entity.
+type SumDBCosignatureDelete struct {
+	config
+	hooks    []Hook
+	mutation *SumDBCosignatureMutation
+}
+
+// Where appends a list predicates to the SumDBCosignatureDelete builder.
+func (_d *SumDBCosignatureDelete) Where(ps ...predicate.SumDBCosignature) *SumDBCosignatureDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SumDBCosignatureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SumDBCosignatureDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SumDBCosignatureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sumdbcosignature.Table, sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SumDBCosignatureDeleteOne is the builder for deleting a single SumDBCosignature entity.
+type SumDBCosignatureDeleteOne struct {
+	_d *SumDBCosignatureDelete
+}
+
+// Where appends a list predicates to the SumDBCosignatureDelete builder.
+func (_d *SumDBCosignatureDeleteOne) Where(ps ...predicate.SumDBCosignature) *SumDBCosignatureDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SumDBCosignatureDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sumdbcosignature.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SumDBCosignatureDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
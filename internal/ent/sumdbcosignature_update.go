@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+)
+
+// SumDBCosignatureUpdate is the builder for updating SumDBCosignature entities.
+type SumDBCosignatureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SumDBCosignatureMutation
+}
+
+// Where appends a list predicates to the SumDBCosignatureUpdate builder.
+func (_u *SumDBCosignatureUpdate) Where(ps ...predicate.SumDBCosignature) *SumDBCosignatureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SumDBCosignatureUpdate) SetUpdatedAt(v time.Time) *SumDBCosignatureUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SumDBCosignatureMutation object of the builder.
+func (_u *SumDBCosignatureUpdate) Mutation() *SumDBCosignatureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SumDBCosignatureUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SumDBCosignatureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SumDBCosignatureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SumDBCosignatureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SumDBCosignatureUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sumdbcosignature.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SumDBCosignatureUpdate) check() error {
+	if _u.mutation.HeadCleared() && len(_u.mutation.HeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SumDBCosignature.head"`)
+	}
+	return nil
+}
+
+func (_u *SumDBCosignatureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sumdbcosignature.Table, sumdbcosignature.Columns, sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbcosignature.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sumdbcosignature.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SumDBCosignatureUpdateOne is the builder for updating a single SumDBCosignature entity.
+type SumDBCosignatureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SumDBCosignatureMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SumDBCosignatureUpdateOne) SetUpdatedAt(v time.Time) *SumDBCosignatureUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SumDBCosignatureMutation object of the builder.
+func (_u *SumDBCosignatureUpdateOne) Mutation() *SumDBCosignatureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SumDBCosignatureUpdate builder.
+func (_u *SumDBCosignatureUpdateOne) Where(ps ...predicate.SumDBCosignature) *SumDBCosignatureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SumDBCosignatureUpdateOne) Select(field string, fields ...string) *SumDBCosignatureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SumDBCosignature entity.
+func (_u *SumDBCosignatureUpdateOne) Save(ctx context.Context) (*SumDBCosignature, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SumDBCosignatureUpdateOne) SaveX(ctx context.Context) *SumDBCosignature {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SumDBCosignatureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SumDBCosignatureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SumDBCosignatureUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sumdbcosignature.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SumDBCosignatureUpdateOne) check() error {
+	if _u.mutation.HeadCleared() && len(_u.mutation.HeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SumDBCosignature.head"`)
+	}
+	return nil
+}
+
+func (_u *SumDBCosignatureUpdateOne) sqlSave(ctx context.Context) (_node *SumDBCosignature, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sumdbcosignature.Table, sumdbcosignature.Columns, sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SumDBCosignature.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sumdbcosignature.FieldID)
+		for _, f := range fields {
+			if !sumdbcosignature.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sumdbcosignature.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbcosignature.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SumDBCosignature{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sumdbcosignature.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
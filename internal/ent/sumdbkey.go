@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+)
+
+// SumDBKey is the model entity for the SumDBKey schema.
+type SumDBKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// When this object was initially created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The last time this object was modified
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SignerKey holds the value of the "signer_key" field.
+	SignerKey crypto.Secret `json:"signer_key,omitempty"`
+	// VerifierKey holds the value of the "verifier_key" field.
+	VerifierKey string `json:"verifier_key,omitempty"`
+	// Tree heads are signed with this key (along with the current one) until this time
+	CosignUntil time.Time `json:"cosign_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SumDBKeyQuery when eager-loading is set.
+	Edges        SumDBKeyEdges `json:"edges"`
+	tree_id      *int
+	selectValues sql.SelectValues
+}
+
+// SumDBKeyEdges holds the relations/edges for other nodes in the graph.
+type SumDBKeyEdges struct {
+	// Tree holds the value of the tree edge.
+	Tree *SumDBTree `json:"tree,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TreeOrErr returns the Tree value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SumDBKeyEdges) TreeOrErr() (*SumDBTree, error) {
+	if e.Tree != nil {
+		return e.Tree, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: sumdbtree.Label}
+	}
+	return nil, &NotLoadedError{edge: "tree"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SumDBKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sumdbkey.FieldSignerKey:
+			values[i] = new(crypto.Secret)
+		case sumdbkey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sumdbkey.FieldVerifierKey:
+			values[i] = new(sql.NullString)
+		case sumdbkey.FieldCreatedAt, sumdbkey.FieldUpdatedAt, sumdbkey.FieldCosignUntil:
+			values[i] = new(sql.NullTime)
+		case sumdbkey.ForeignKeys[0]: // tree_id
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SumDBKey fields.
+func (_m *SumDBKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sumdbkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case sumdbkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sumdbkey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case sumdbkey.FieldSignerKey:
+			if value, ok := values[i].(*crypto.Secret); !ok {
+				return fmt.Errorf("unexpected type %T for field signer_key", values[i])
+			} else if value != nil {
+				_m.SignerKey = *value
+			}
+		case sumdbkey.FieldVerifierKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verifier_key", values[i])
+			} else if value.Valid {
+				_m.VerifierKey = value.String
+			}
+		case sumdbkey.FieldCosignUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cosign_until", values[i])
+			} else if value.Valid {
+				_m.CosignUntil = value.Time
+			}
+		case sumdbkey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tree_id", value)
+			} else if value.Valid {
+				_m.tree_id = new(int)
+				*_m.tree_id = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SumDBKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *SumDBKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTree queries the "tree" edge of the SumDBKey entity.
+func (_m *SumDBKey) QueryTree() *SumDBTreeQuery {
+	return NewSumDBKeyClient(_m.config).QueryTree(_m)
+}
+
+// Update returns a builder for updating this SumDBKey.
+// Note that you need to call SumDBKey.Unwrap() before calling this method if this SumDBKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SumDBKey) Update() *SumDBKeyUpdateOne {
+	return NewSumDBKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SumDBKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SumDBKey) Unwrap() *SumDBKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SumDBKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SumDBKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SumDBKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("signer_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignerKey))
+	builder.WriteString(", ")
+	builder.WriteString("verifier_key=")
+	builder.WriteString(_m.VerifierKey)
+	builder.WriteString(", ")
+	builder.WriteString("cosign_until=")
+	builder.WriteString(_m.CosignUntil.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SumDBKeys is a parsable slice of SumDBKey.
+type SumDBKeys []*SumDBKey
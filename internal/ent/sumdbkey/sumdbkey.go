@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sumdbkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sumdbkey type in the database.
+	Label = "sum_db_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSignerKey holds the string denoting the signer_key field in the database.
+	FieldSignerKey = "signer_key"
+	// FieldVerifierKey holds the string denoting the verifier_key field in the database.
+	FieldVerifierKey = "verifier_key"
+	// FieldCosignUntil holds the string denoting the cosign_until field in the database.
+	FieldCosignUntil = "cosign_until"
+	// EdgeTree holds the string denoting the tree edge name in mutations.
+	EdgeTree = "tree"
+	// Table holds the table name of the sumdbkey in the database.
+	Table = "sum_db_keys"
+	// TreeTable is the table that holds the tree relation/edge.
+	TreeTable = "sum_db_keys"
+	// TreeInverseTable is the table name for the SumDBTree entity.
+	// It exists in this package in order to avoid circular dependency with the "sumdbtree" package.
+	TreeInverseTable = "sum_db_trees"
+	// TreeColumn is the table column denoting the tree relation/edge.
+	TreeColumn = "tree_id"
+)
+
+// Columns holds all SQL columns for sumdbkey fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSignerKey,
+	FieldVerifierKey,
+	FieldCosignUntil,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sum_db_keys"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tree_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// SignerKeyValidator is a validator for the "signer_key" field. It is called by the builders before save.
+	SignerKeyValidator func(string) error
+	// VerifierKeyValidator is a validator for the "verifier_key" field. It is called by the builders before save.
+	VerifierKeyValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SumDBKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySignerKey orders the results by the signer_key field.
+func BySignerKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignerKey, opts...).ToFunc()
+}
+
+// ByVerifierKey orders the results by the verifier_key field.
+func ByVerifierKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifierKey, opts...).ToFunc()
+}
+
+// ByCosignUntil orders the results by the cosign_until field.
+func ByCosignUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCosignUntil, opts...).ToFunc()
+}
+
+// ByTreeField orders the results by tree field.
+func ByTreeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTreeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTreeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TreeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TreeTable, TreeColumn),
+	)
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sumdbkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SignerKey applies equality check predicate on the "signer_key" field. It's identical to SignerKeyEQ.
+func SignerKey(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldSignerKey, v))
+}
+
+// VerifierKey applies equality check predicate on the "verifier_key" field. It's identical to VerifierKeyEQ.
+func VerifierKey(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldVerifierKey, v))
+}
+
+// CosignUntil applies equality check predicate on the "cosign_until" field. It's identical to CosignUntilEQ.
+func CosignUntil(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldCosignUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SignerKeyEQ applies the EQ predicate on the "signer_key" field.
+func SignerKeyEQ(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldSignerKey, v))
+}
+
+// SignerKeyNEQ applies the NEQ predicate on the "signer_key" field.
+func SignerKeyNEQ(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNEQ(FieldSignerKey, v))
+}
+
+// SignerKeyIn applies the In predicate on the "signer_key" field.
+func SignerKeyIn(vs ...crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldIn(FieldSignerKey, vs...))
+}
+
+// SignerKeyNotIn applies the NotIn predicate on the "signer_key" field.
+func SignerKeyNotIn(vs ...crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNotIn(FieldSignerKey, vs...))
+}
+
+// SignerKeyGT applies the GT predicate on the "signer_key" field.
+func SignerKeyGT(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGT(FieldSignerKey, v))
+}
+
+// SignerKeyGTE applies the GTE predicate on the "signer_key" field.
+func SignerKeyGTE(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGTE(FieldSignerKey, v))
+}
+
+// SignerKeyLT applies the LT predicate on the "signer_key" field.
+func SignerKeyLT(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLT(FieldSignerKey, v))
+}
+
+// SignerKeyLTE applies the LTE predicate on the "signer_key" field.
+func SignerKeyLTE(v crypto.Secret) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLTE(FieldSignerKey, v))
+}
+
+// SignerKeyContains applies the Contains predicate on the "signer_key" field.
+func SignerKeyContains(v crypto.Secret) predicate.SumDBKey {
+	vc := string(v)
+	return predicate.SumDBKey(sql.FieldContains(FieldSignerKey, vc))
+}
+
+// SignerKeyHasPrefix applies the HasPrefix predicate on the "signer_key" field.
+func SignerKeyHasPrefix(v crypto.Secret) predicate.SumDBKey {
+	vc := string(v)
+	return predicate.SumDBKey(sql.FieldHasPrefix(FieldSignerKey, vc))
+}
+
+// SignerKeyHasSuffix applies the HasSuffix predicate on the "signer_key" field.
+func SignerKeyHasSuffix(v crypto.Secret) predicate.SumDBKey {
+	vc := string(v)
+	return predicate.SumDBKey(sql.FieldHasSuffix(FieldSignerKey, vc))
+}
+
+// SignerKeyEqualFold applies the EqualFold predicate on the "signer_key" field.
+func SignerKeyEqualFold(v crypto.Secret) predicate.SumDBKey {
+	vc := string(v)
+	return predicate.SumDBKey(sql.FieldEqualFold(FieldSignerKey, vc))
+}
+
+// SignerKeyContainsFold applies the ContainsFold predicate on the "signer_key" field.
+func SignerKeyContainsFold(v crypto.Secret) predicate.SumDBKey {
+	vc := string(v)
+	return predicate.SumDBKey(sql.FieldContainsFold(FieldSignerKey, vc))
+}
+
+// VerifierKeyEQ applies the EQ predicate on the "verifier_key" field.
+func VerifierKeyEQ(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldVerifierKey, v))
+}
+
+// VerifierKeyNEQ applies the NEQ predicate on the "verifier_key" field.
+func VerifierKeyNEQ(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNEQ(FieldVerifierKey, v))
+}
+
+// VerifierKeyIn applies the In predicate on the "verifier_key" field.
+func VerifierKeyIn(vs ...string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldIn(FieldVerifierKey, vs...))
+}
+
+// VerifierKeyNotIn applies the NotIn predicate on the "verifier_key" field.
+func VerifierKeyNotIn(vs ...string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNotIn(FieldVerifierKey, vs...))
+}
+
+// VerifierKeyGT applies the GT predicate on the "verifier_key" field.
+func VerifierKeyGT(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGT(FieldVerifierKey, v))
+}
+
+// VerifierKeyGTE applies the GTE predicate on the "verifier_key" field.
+func VerifierKeyGTE(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGTE(FieldVerifierKey, v))
+}
+
+// VerifierKeyLT applies the LT predicate on the "verifier_key" field.
+func VerifierKeyLT(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLT(FieldVerifierKey, v))
+}
+
+// VerifierKeyLTE applies the LTE predicate on the "verifier_key" field.
+func VerifierKeyLTE(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLTE(FieldVerifierKey, v))
+}
+
+// VerifierKeyContains applies the Contains predicate on the "verifier_key" field.
+func VerifierKeyContains(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldContains(FieldVerifierKey, v))
+}
+
+// VerifierKeyHasPrefix applies the HasPrefix predicate on the "verifier_key" field.
+func VerifierKeyHasPrefix(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldHasPrefix(FieldVerifierKey, v))
+}
+
+// VerifierKeyHasSuffix applies the HasSuffix predicate on the "verifier_key" field.
+func VerifierKeyHasSuffix(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldHasSuffix(FieldVerifierKey, v))
+}
+
+// VerifierKeyEqualFold applies the EqualFold predicate on the "verifier_key" field.
+func VerifierKeyEqualFold(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEqualFold(FieldVerifierKey, v))
+}
+
+// VerifierKeyContainsFold applies the ContainsFold predicate on the "verifier_key" field.
+func VerifierKeyContainsFold(v string) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldContainsFold(FieldVerifierKey, v))
+}
+
+// CosignUntilEQ applies the EQ predicate on the "cosign_until" field.
+func CosignUntilEQ(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldEQ(FieldCosignUntil, v))
+}
+
+// CosignUntilNEQ applies the NEQ predicate on the "cosign_until" field.
+func CosignUntilNEQ(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNEQ(FieldCosignUntil, v))
+}
+
+// CosignUntilIn applies the In predicate on the "cosign_until" field.
+func CosignUntilIn(vs ...time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldIn(FieldCosignUntil, vs...))
+}
+
+// CosignUntilNotIn applies the NotIn predicate on the "cosign_until" field.
+func CosignUntilNotIn(vs ...time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldNotIn(FieldCosignUntil, vs...))
+}
+
+// CosignUntilGT applies the GT predicate on the "cosign_until" field.
+func CosignUntilGT(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGT(FieldCosignUntil, v))
+}
+
+// CosignUntilGTE applies the GTE predicate on the "cosign_until" field.
+func CosignUntilGTE(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldGTE(FieldCosignUntil, v))
+}
+
+// CosignUntilLT applies the LT predicate on the "cosign_until" field.
+func CosignUntilLT(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLT(FieldCosignUntil, v))
+}
+
+// CosignUntilLTE applies the LTE predicate on the "cosign_until" field.
+func CosignUntilLTE(v time.Time) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.FieldLTE(FieldCosignUntil, v))
+}
+
+// HasTree applies the HasEdge predicate on the "tree" edge.
+func HasTree() predicate.SumDBKey {
+	return predicate.SumDBKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TreeTable, TreeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTreeWith applies the HasEdge predicate on the "tree" edge with a given conditions (other predicates).
+func HasTreeWith(preds ...predicate.SumDBTree) predicate.SumDBKey {
+	return predicate.SumDBKey(func(s *sql.Selector) {
+		step := newTreeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SumDBKey) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SumDBKey) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SumDBKey) predicate.SumDBKey {
+	return predicate.SumDBKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+)
+
+// SumDBKeyCreate is the builder for creating a SumDBKey entity.
+type SumDBKeyCreate struct {
+	config
+	mutation *SumDBKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SumDBKeyCreate) SetCreatedAt(v time.Time) *SumDBKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SumDBKeyCreate) SetNillableCreatedAt(v *time.Time) *SumDBKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SumDBKeyCreate) SetUpdatedAt(v time.Time) *SumDBKeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SumDBKeyCreate) SetNillableUpdatedAt(v *time.Time) *SumDBKeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSignerKey sets the "signer_key" field.
+func (_c *SumDBKeyCreate) SetSignerKey(v crypto.Secret) *SumDBKeyCreate {
+	_c.mutation.SetSignerKey(v)
+	return _c
+}
+
+// SetVerifierKey sets the "verifier_key" field.
+func (_c *SumDBKeyCreate) SetVerifierKey(v string) *SumDBKeyCreate {
+	_c.mutation.SetVerifierKey(v)
+	return _c
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (_c *SumDBKeyCreate) SetCosignUntil(v time.Time) *SumDBKeyCreate {
+	_c.mutation.SetCosignUntil(v)
+	return _c
+}
+
+// SetTreeID sets the "tree" edge to the SumDBTree entity by ID.
+func (_c *SumDBKeyCreate) SetTreeID(id int) *SumDBKeyCreate {
+	_c.mutation.SetTreeID(id)
+	return _c
+}
+
+// SetTree sets the "tree" edge to the SumDBTree entity.
+func (_c *SumDBKeyCreate) SetTree(v *SumDBTree) *SumDBKeyCreate {
+	return _c.SetTreeID(v.ID)
+}
+
+// Mutation returns the SumDBKeyMutation object of the builder.
+func (_c *SumDBKeyCreate) Mutation() *SumDBKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the SumDBKey in the database.
+func (_c *SumDBKeyCreate) Save(ctx context.Context) (*SumDBKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SumDBKeyCreate) SaveX(ctx context.Context) *SumDBKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SumDBKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SumDBKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SumDBKeyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sumdbkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := sumdbkey.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SumDBKeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SumDBKey.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SumDBKey.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SignerKey(); !ok {
+		return &ValidationError{Name: "signer_key", err: errors.New(`ent: missing required field "SumDBKey.signer_key"`)}
+	}
+	if v, ok := _c.mutation.SignerKey(); ok {
+		if err := sumdbkey.SignerKeyValidator(string(v)); err != nil {
+			return &ValidationError{Name: "signer_key", err: fmt.Errorf(`ent: validator failed for field "SumDBKey.signer_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerifierKey(); !ok {
+		return &ValidationError{Name: "verifier_key", err: errors.New(`ent: missing required field "SumDBKey.verifier_key"`)}
+	}
+	if v, ok := _c.mutation.VerifierKey(); ok {
+		if err := sumdbkey.VerifierKeyValidator(v); err != nil {
+			return &ValidationError{Name: "verifier_key", err: fmt.Errorf(`ent: validator failed for field "SumDBKey.verifier_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CosignUntil(); !ok {
+		return &ValidationError{Name: "cosign_until", err: errors.New(`ent: missing required field "SumDBKey.cosign_until"`)}
+	}
+	if len(_c.mutation.TreeIDs()) == 0 {
+		return &ValidationError{Name: "tree", err: errors.New(`ent: missing required edge "SumDBKey.tree"`)}
+	}
+	return nil
+}
+
+func (_c *SumDBKeyCreate) sqlSave(ctx context.Context) (*SumDBKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SumDBKeyCreate) createSpec() (*SumDBKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SumDBKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sumdbkey.Table, sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sumdbkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.SignerKey(); ok {
+		_spec.SetField(sumdbkey.FieldSignerKey, field.TypeString, value)
+		_node.SignerKey = value
+	}
+	if value, ok := _c.mutation.VerifierKey(); ok {
+		_spec.SetField(sumdbkey.FieldVerifierKey, field.TypeString, value)
+		_node.VerifierKey = value
+	}
+	if value, ok := _c.mutation.CosignUntil(); ok {
+		_spec.SetField(sumdbkey.FieldCosignUntil, field.TypeTime, value)
+		_node.CosignUntil = value
+	}
+	if nodes := _c.mutation.TreeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sumdbkey.TreeTable,
+			Columns: []string{sumdbkey.TreeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtree.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tree_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SumDBKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SumDBKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SumDBKeyCreate) OnConflict(opts ...sql.ConflictOption) *SumDBKeyUpsertOne {
+	_c.conflict = opts
+	return &SumDBKeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SumDBKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SumDBKeyCreate) OnConflictColumns(columns ...string) *SumDBKeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SumDBKeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SumDBKeyUpsertOne is the builder for "upsert"-ing
+	//  one SumDBKey node.
+	SumDBKeyUpsertOne struct {
+		create *SumDBKeyCreate
+	}
+
+	// SumDBKeyUpsert is the "OnConflict" setter.
+	SumDBKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBKeyUpsert) SetUpdatedAt(v time.Time) *SumDBKeyUpsert {
+	u.Set(sumdbkey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBKeyUpsert) UpdateUpdatedAt() *SumDBKeyUpsert {
+	u.SetExcluded(sumdbkey.FieldUpdatedAt)
+	return u
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (u *SumDBKeyUpsert) SetCosignUntil(v time.Time) *SumDBKeyUpsert {
+	u.Set(sumdbkey.FieldCosignUntil, v)
+	return u
+}
+
+// UpdateCosignUntil sets the "cosign_until" field to the value that was provided on create.
+func (u *SumDBKeyUpsert) UpdateCosignUntil() *SumDBKeyUpsert {
+	u.SetExcluded(sumdbkey.FieldCosignUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SumDBKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SumDBKeyUpsertOne) UpdateNewValues() *SumDBKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sumdbkey.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.SignerKey(); exists {
+			s.SetIgnore(sumdbkey.FieldSignerKey)
+		}
+		if _, exists := u.create.mutation.VerifierKey(); exists {
+			s.SetIgnore(sumdbkey.FieldVerifierKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SumDBKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SumDBKeyUpsertOne) Ignore() *SumDBKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SumDBKeyUpsertOne) DoNothing() *SumDBKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SumDBKeyCreate.OnConflict
+// documentation for more info.
+func (u *SumDBKeyUpsertOne) Update(set func(*SumDBKeyUpsert)) *SumDBKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SumDBKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBKeyUpsertOne) SetUpdatedAt(v time.Time) *SumDBKeyUpsertOne {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBKeyUpsertOne) UpdateUpdatedAt() *SumDBKeyUpsertOne {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (u *SumDBKeyUpsertOne) SetCosignUntil(v time.Time) *SumDBKeyUpsertOne {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.SetCosignUntil(v)
+	})
+}
+
+// UpdateCosignUntil sets the "cosign_until" field to the value that was provided on create.
+func (u *SumDBKeyUpsertOne) UpdateCosignUntil() *SumDBKeyUpsertOne {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.UpdateCosignUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *SumDBKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SumDBKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SumDBKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SumDBKeyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SumDBKeyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SumDBKeyCreateBulk is the builder for creating many SumDBKey entities in bulk.
+type SumDBKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SumDBKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SumDBKey entities in the database.
+func (_c *SumDBKeyCreateBulk) Save(ctx context.Context) ([]*SumDBKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SumDBKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SumDBKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SumDBKeyCreateBulk) SaveX(ctx context.Context) []*SumDBKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SumDBKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SumDBKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SumDBKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SumDBKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SumDBKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *SumDBKeyUpsertBulk {
+	_c.conflict = opts
+	return &SumDBKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SumDBKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SumDBKeyCreateBulk) OnConflictColumns(columns ...string) *SumDBKeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SumDBKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// SumDBKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of SumDBKey nodes.
+type SumDBKeyUpsertBulk struct {
+	create *SumDBKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SumDBKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SumDBKeyUpsertBulk) UpdateNewValues() *SumDBKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sumdbkey.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.SignerKey(); exists {
+				s.SetIgnore(sumdbkey.FieldSignerKey)
+			}
+			if _, exists := b.mutation.VerifierKey(); exists {
+				s.SetIgnore(sumdbkey.FieldVerifierKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SumDBKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SumDBKeyUpsertBulk) Ignore() *SumDBKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SumDBKeyUpsertBulk) DoNothing() *SumDBKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SumDBKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *SumDBKeyUpsertBulk) Update(set func(*SumDBKeyUpsert)) *SumDBKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SumDBKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBKeyUpsertBulk) SetUpdatedAt(v time.Time) *SumDBKeyUpsertBulk {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBKeyUpsertBulk) UpdateUpdatedAt() *SumDBKeyUpsertBulk {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (u *SumDBKeyUpsertBulk) SetCosignUntil(v time.Time) *SumDBKeyUpsertBulk {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.SetCosignUntil(v)
+	})
+}
+
+// UpdateCosignUntil sets the "cosign_until" field to the value that was provided on create.
+func (u *SumDBKeyUpsertBulk) UpdateCosignUntil() *SumDBKeyUpsertBulk {
+	return u.Update(func(s *SumDBKeyUpsert) {
+		s.UpdateCosignUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *SumDBKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SumDBKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SumDBKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SumDBKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
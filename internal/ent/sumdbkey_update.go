@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
+)
+
+// SumDBKeyUpdate is the builder for updating SumDBKey entities.
+type SumDBKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SumDBKeyMutation
+}
+
+// Where appends a list predicates to the SumDBKeyUpdate builder.
+func (_u *SumDBKeyUpdate) Where(ps ...predicate.SumDBKey) *SumDBKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SumDBKeyUpdate) SetUpdatedAt(v time.Time) *SumDBKeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (_u *SumDBKeyUpdate) SetCosignUntil(v time.Time) *SumDBKeyUpdate {
+	_u.mutation.SetCosignUntil(v)
+	return _u
+}
+
+// SetNillableCosignUntil sets the "cosign_until" field if the given value is not nil.
+func (_u *SumDBKeyUpdate) SetNillableCosignUntil(v *time.Time) *SumDBKeyUpdate {
+	if v != nil {
+		_u.SetCosignUntil(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SumDBKeyMutation object of the builder.
+func (_u *SumDBKeyUpdate) Mutation() *SumDBKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SumDBKeyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SumDBKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SumDBKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SumDBKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SumDBKeyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sumdbkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SumDBKeyUpdate) check() error {
+	if _u.mutation.TreeCleared() && len(_u.mutation.TreeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SumDBKey.tree"`)
+	}
+	return nil
+}
+
+func (_u *SumDBKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sumdbkey.Table, sumdbkey.Columns, sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CosignUntil(); ok {
+		_spec.SetField(sumdbkey.FieldCosignUntil, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sumdbkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SumDBKeyUpdateOne is the builder for updating a single SumDBKey entity.
+type SumDBKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SumDBKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SumDBKeyUpdateOne) SetUpdatedAt(v time.Time) *SumDBKeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCosignUntil sets the "cosign_until" field.
+func (_u *SumDBKeyUpdateOne) SetCosignUntil(v time.Time) *SumDBKeyUpdateOne {
+	_u.mutation.SetCosignUntil(v)
+	return _u
+}
+
+// SetNillableCosignUntil sets the "cosign_until" field if the given value is not nil.
+func (_u *SumDBKeyUpdateOne) SetNillableCosignUntil(v *time.Time) *SumDBKeyUpdateOne {
+	if v != nil {
+		_u.SetCosignUntil(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SumDBKeyMutation object of the builder.
+func (_u *SumDBKeyUpdateOne) Mutation() *SumDBKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SumDBKeyUpdate builder.
+func (_u *SumDBKeyUpdateOne) Where(ps ...predicate.SumDBKey) *SumDBKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SumDBKeyUpdateOne) Select(field string, fields ...string) *SumDBKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SumDBKey entity.
+func (_u *SumDBKeyUpdateOne) Save(ctx context.Context) (*SumDBKey, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SumDBKeyUpdateOne) SaveX(ctx context.Context) *SumDBKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SumDBKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SumDBKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SumDBKeyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sumdbkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SumDBKeyUpdateOne) check() error {
+	if _u.mutation.TreeCleared() && len(_u.mutation.TreeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SumDBKey.tree"`)
+	}
+	return nil
+}
+
+func (_u *SumDBKeyUpdateOne) sqlSave(ctx context.Context) (_node *SumDBKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sumdbkey.Table, sumdbkey.Columns, sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SumDBKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sumdbkey.FieldID)
+		for _, f := range fields {
+			if !sumdbkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sumdbkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CosignUntil(); ok {
+		_spec.SetField(sumdbkey.FieldCosignUntil, field.TypeTime, value)
+	}
+	_node = &SumDBKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sumdbkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
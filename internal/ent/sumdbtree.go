@@ -30,6 +30,8 @@ type SumDBTree struct {
 	SignerKey crypto.Secret `json:"signer_key,omitempty"`
 	// VerifierKey holds the value of the "verifier_key" field.
 	VerifierKey string `json:"verifier_key,omitempty"`
+	// Status holds the value of the "status" field.
+	Status sumdbtree.Status `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SumDBTreeQuery when eager-loading is set.
 	Edges        SumDBTreeEdges `json:"edges"`
@@ -42,9 +44,13 @@ type SumDBTreeEdges struct {
 	Hashes []*SumDBHash `json:"hashes,omitempty"`
 	// Records holds the value of the records edge.
 	Records []*SumDBRecord `json:"records,omitempty"`
+	// Keys holds the value of the keys edge.
+	Keys []*SumDBKey `json:"keys,omitempty"`
+	// Heads holds the value of the heads edge.
+	Heads []*SumDBTreeHead `json:"heads,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // HashesOrErr returns the Hashes value or an error if the edge
@@ -65,6 +71,24 @@ func (e SumDBTreeEdges) RecordsOrErr() ([]*SumDBRecord, error) {
 	return nil, &NotLoadedError{edge: "records"}
 }
 
+// KeysOrErr returns the Keys value or an error if the edge
+// was not loaded in eager-loading.
+func (e SumDBTreeEdges) KeysOrErr() ([]*SumDBKey, error) {
+	if e.loadedTypes[2] {
+		return e.Keys, nil
+	}
+	return nil, &NotLoadedError{edge: "keys"}
+}
+
+// HeadsOrErr returns the Heads value or an error if the edge
+// was not loaded in eager-loading.
+func (e SumDBTreeEdges) HeadsOrErr() ([]*SumDBTreeHead, error) {
+	if e.loadedTypes[3] {
+		return e.Heads, nil
+	}
+	return nil, &NotLoadedError{edge: "heads"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SumDBTree) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -74,7 +98,7 @@ func (*SumDBTree) scanValues(columns []string) ([]any, error) {
 			values[i] = new(crypto.Secret)
 		case sumdbtree.FieldID, sumdbtree.FieldSize:
 			values[i] = new(sql.NullInt64)
-		case sumdbtree.FieldName, sumdbtree.FieldVerifierKey:
+		case sumdbtree.FieldName, sumdbtree.FieldVerifierKey, sumdbtree.FieldStatus:
 			values[i] = new(sql.NullString)
 		case sumdbtree.FieldCreatedAt, sumdbtree.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -135,6 +159,12 @@ func (_m *SumDBTree) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.VerifierKey = value.String
 			}
+		case sumdbtree.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = sumdbtree.Status(value.String)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +188,16 @@ func (_m *SumDBTree) QueryRecords() *SumDBRecordQuery {
 	return NewSumDBTreeClient(_m.config).QueryRecords(_m)
 }
 
+// QueryKeys queries the "keys" edge of the SumDBTree entity.
+func (_m *SumDBTree) QueryKeys() *SumDBKeyQuery {
+	return NewSumDBTreeClient(_m.config).QueryKeys(_m)
+}
+
+// QueryHeads queries the "heads" edge of the SumDBTree entity.
+func (_m *SumDBTree) QueryHeads() *SumDBTreeHeadQuery {
+	return NewSumDBTreeClient(_m.config).QueryHeads(_m)
+}
+
 // Update returns a builder for updating this SumDBTree.
 // Note that you need to call SumDBTree.Unwrap() before calling this method if this SumDBTree
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +238,9 @@ func (_m *SumDBTree) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("verifier_key=")
 	builder.WriteString(_m.VerifierKey)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteByte(')')
 	return builder.String()
 }
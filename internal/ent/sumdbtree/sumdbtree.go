@@ -3,6 +3,7 @@
 package sumdbtree
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,10 +27,16 @@ const (
 	FieldSignerKey = "signer_key"
 	// FieldVerifierKey holds the string denoting the verifier_key field in the database.
 	FieldVerifierKey = "verifier_key"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// EdgeHashes holds the string denoting the hashes edge name in mutations.
 	EdgeHashes = "hashes"
 	// EdgeRecords holds the string denoting the records edge name in mutations.
 	EdgeRecords = "records"
+	// EdgeKeys holds the string denoting the keys edge name in mutations.
+	EdgeKeys = "keys"
+	// EdgeHeads holds the string denoting the heads edge name in mutations.
+	EdgeHeads = "heads"
 	// Table holds the table name of the sumdbtree in the database.
 	Table = "sum_db_trees"
 	// HashesTable is the table that holds the hashes relation/edge.
@@ -46,6 +53,20 @@ const (
 	RecordsInverseTable = "sum_db_records"
 	// RecordsColumn is the table column denoting the records relation/edge.
 	RecordsColumn = "tree_id"
+	// KeysTable is the table that holds the keys relation/edge.
+	KeysTable = "sum_db_keys"
+	// KeysInverseTable is the table name for the SumDBKey entity.
+	// It exists in this package in order to avoid circular dependency with the "sumdbkey" package.
+	KeysInverseTable = "sum_db_keys"
+	// KeysColumn is the table column denoting the keys relation/edge.
+	KeysColumn = "tree_id"
+	// HeadsTable is the table that holds the heads relation/edge.
+	HeadsTable = "sum_db_tree_heads"
+	// HeadsInverseTable is the table name for the SumDBTreeHead entity.
+	// It exists in this package in order to avoid circular dependency with the "sumdbtreehead" package.
+	HeadsInverseTable = "sum_db_tree_heads"
+	// HeadsColumn is the table column denoting the heads relation/edge.
+	HeadsColumn = "tree_id"
 )
 
 // Columns holds all SQL columns for sumdbtree fields.
@@ -57,6 +78,7 @@ var Columns = []string{
 	FieldSize,
 	FieldSignerKey,
 	FieldVerifierKey,
+	FieldStatus,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,6 +106,33 @@ var (
 	VerifierKeyValidator func(string) error
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive  Status = "active"
+	StatusFrozen  Status = "frozen"
+	StatusRetired Status = "retired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusFrozen, StatusRetired:
+		return nil
+	default:
+		return fmt.Errorf("sumdbtree: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the SumDBTree queries.
 type OrderOption func(*sql.Selector)
 
@@ -122,6 +171,11 @@ func ByVerifierKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVerifierKey, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // ByHashesCount orders the results by hashes count.
 func ByHashesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -149,6 +203,34 @@ func ByRecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByKeysCount orders the results by keys count.
+func ByKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKeysStep(), opts...)
+	}
+}
+
+// ByKeys orders the results by keys terms.
+func ByKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHeadsCount orders the results by heads count.
+func ByHeadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHeadsStep(), opts...)
+	}
+}
+
+// ByHeads orders the results by heads terms.
+func ByHeads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHeadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newHashesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -163,3 +245,17 @@ func newRecordsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RecordsTable, RecordsColumn),
 	)
 }
+func newKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, KeysTable, KeysColumn),
+	)
+}
+func newHeadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HeadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HeadsTable, HeadsColumn),
+	)
+}
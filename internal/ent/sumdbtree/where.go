@@ -406,6 +406,26 @@ func VerifierKeyContainsFold(v string) predicate.SumDBTree {
 	return predicate.SumDBTree(sql.FieldContainsFold(FieldVerifierKey, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SumDBTree {
+	return predicate.SumDBTree(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SumDBTree {
+	return predicate.SumDBTree(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SumDBTree {
+	return predicate.SumDBTree(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SumDBTree {
+	return predicate.SumDBTree(sql.FieldNotIn(FieldStatus, vs...))
+}
+
 // HasHashes applies the HasEdge predicate on the "hashes" edge.
 func HasHashes() predicate.SumDBTree {
 	return predicate.SumDBTree(func(s *sql.Selector) {
@@ -452,6 +472,52 @@ func HasRecordsWith(preds ...predicate.SumDBRecord) predicate.SumDBTree {
 	})
 }
 
+// HasKeys applies the HasEdge predicate on the "keys" edge.
+func HasKeys() predicate.SumDBTree {
+	return predicate.SumDBTree(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, KeysTable, KeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeysWith applies the HasEdge predicate on the "keys" edge with a given conditions (other predicates).
+func HasKeysWith(preds ...predicate.SumDBKey) predicate.SumDBTree {
+	return predicate.SumDBTree(func(s *sql.Selector) {
+		step := newKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHeads applies the HasEdge predicate on the "heads" edge.
+func HasHeads() predicate.SumDBTree {
+	return predicate.SumDBTree(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HeadsTable, HeadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHeadsWith applies the HasEdge predicate on the "heads" edge with a given conditions (other predicates).
+func HasHeadsWith(preds ...predicate.SumDBTreeHead) predicate.SumDBTree {
+	return predicate.SumDBTree(func(s *sql.Selector) {
+		step := newHeadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SumDBTree) predicate.SumDBTree {
 	return predicate.SumDBTree(sql.AndPredicates(predicates...))
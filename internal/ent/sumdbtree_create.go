@@ -13,8 +13,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 )
 
 // SumDBTreeCreate is the builder for creating a SumDBTree entity.
@@ -77,6 +79,20 @@ func (_c *SumDBTreeCreate) SetVerifierKey(v string) *SumDBTreeCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *SumDBTreeCreate) SetStatus(v sumdbtree.Status) *SumDBTreeCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SumDBTreeCreate) SetNillableStatus(v *sumdbtree.Status) *SumDBTreeCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
 // AddHashIDs adds the "hashes" edge to the SumDBHash entity by IDs.
 func (_c *SumDBTreeCreate) AddHashIDs(ids ...int) *SumDBTreeCreate {
 	_c.mutation.AddHashIDs(ids...)
@@ -107,6 +123,36 @@ func (_c *SumDBTreeCreate) AddRecords(v ...*SumDBRecord) *SumDBTreeCreate {
 	return _c.AddRecordIDs(ids...)
 }
 
+// AddKeyIDs adds the "keys" edge to the SumDBKey entity by IDs.
+func (_c *SumDBTreeCreate) AddKeyIDs(ids ...int) *SumDBTreeCreate {
+	_c.mutation.AddKeyIDs(ids...)
+	return _c
+}
+
+// AddKeys adds the "keys" edges to the SumDBKey entity.
+func (_c *SumDBTreeCreate) AddKeys(v ...*SumDBKey) *SumDBTreeCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKeyIDs(ids...)
+}
+
+// AddHeadIDs adds the "heads" edge to the SumDBTreeHead entity by IDs.
+func (_c *SumDBTreeCreate) AddHeadIDs(ids ...int) *SumDBTreeCreate {
+	_c.mutation.AddHeadIDs(ids...)
+	return _c
+}
+
+// AddHeads adds the "heads" edges to the SumDBTreeHead entity.
+func (_c *SumDBTreeCreate) AddHeads(v ...*SumDBTreeHead) *SumDBTreeCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHeadIDs(ids...)
+}
+
 // Mutation returns the SumDBTreeMutation object of the builder.
 func (_c *SumDBTreeCreate) Mutation() *SumDBTreeMutation {
 	return _c.mutation
@@ -150,6 +196,10 @@ func (_c *SumDBTreeCreate) defaults() {
 		v := sumdbtree.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := sumdbtree.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -187,6 +237,14 @@ func (_c *SumDBTreeCreate) check() error {
 			return &ValidationError{Name: "verifier_key", err: fmt.Errorf(`ent: validator failed for field "SumDBTree.verifier_key": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SumDBTree.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := sumdbtree.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SumDBTree.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -238,6 +296,10 @@ func (_c *SumDBTreeCreate) createSpec() (*SumDBTree, *sqlgraph.CreateSpec) {
 		_spec.SetField(sumdbtree.FieldVerifierKey, field.TypeString, value)
 		_node.VerifierKey = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(sumdbtree.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if nodes := _c.mutation.HashesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -270,6 +332,38 @@ func (_c *SumDBTreeCreate) createSpec() (*SumDBTree, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -388,6 +482,18 @@ func (u *SumDBTreeUpsert) UpdateVerifierKey() *SumDBTreeUpsert {
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *SumDBTreeUpsert) SetStatus(v sumdbtree.Status) *SumDBTreeUpsert {
+	u.Set(sumdbtree.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SumDBTreeUpsert) UpdateStatus() *SumDBTreeUpsert {
+	u.SetExcluded(sumdbtree.FieldStatus)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -510,6 +616,20 @@ func (u *SumDBTreeUpsertOne) UpdateVerifierKey() *SumDBTreeUpsertOne {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *SumDBTreeUpsertOne) SetStatus(v sumdbtree.Status) *SumDBTreeUpsertOne {
+	return u.Update(func(s *SumDBTreeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SumDBTreeUpsertOne) UpdateStatus() *SumDBTreeUpsertOne {
+	return u.Update(func(s *SumDBTreeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *SumDBTreeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -798,6 +918,20 @@ func (u *SumDBTreeUpsertBulk) UpdateVerifierKey() *SumDBTreeUpsertBulk {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *SumDBTreeUpsertBulk) SetStatus(v sumdbtree.Status) *SumDBTreeUpsertBulk {
+	return u.Update(func(s *SumDBTreeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SumDBTreeUpsertBulk) UpdateStatus() *SumDBTreeUpsertBulk {
+	return u.Update(func(s *SumDBTreeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *SumDBTreeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -14,8 +14,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/pseudomuto/pacman/internal/ent/predicate"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 )
 
 // SumDBTreeQuery is the builder for querying SumDBTree entities.
@@ -27,6 +29,8 @@ type SumDBTreeQuery struct {
 	predicates  []predicate.SumDBTree
 	withHashes  *SumDBHashQuery
 	withRecords *SumDBRecordQuery
+	withKeys    *SumDBKeyQuery
+	withHeads   *SumDBTreeHeadQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,50 @@ func (_q *SumDBTreeQuery) QueryRecords() *SumDBRecordQuery {
 	return query
 }
 
+// QueryKeys chains the current query on the "keys" edge.
+func (_q *SumDBTreeQuery) QueryKeys() *SumDBKeyQuery {
+	query := (&SumDBKeyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtree.Table, sumdbtree.FieldID, selector),
+			sqlgraph.To(sumdbkey.Table, sumdbkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sumdbtree.KeysTable, sumdbtree.KeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHeads chains the current query on the "heads" edge.
+func (_q *SumDBTreeQuery) QueryHeads() *SumDBTreeHeadQuery {
+	query := (&SumDBTreeHeadClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtree.Table, sumdbtree.FieldID, selector),
+			sqlgraph.To(sumdbtreehead.Table, sumdbtreehead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sumdbtree.HeadsTable, sumdbtree.HeadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SumDBTree entity from the query.
 // Returns a *NotFoundError when no SumDBTree was found.
 func (_q *SumDBTreeQuery) First(ctx context.Context) (*SumDBTree, error) {
@@ -301,6 +349,8 @@ func (_q *SumDBTreeQuery) Clone() *SumDBTreeQuery {
 		predicates:  append([]predicate.SumDBTree{}, _q.predicates...),
 		withHashes:  _q.withHashes.Clone(),
 		withRecords: _q.withRecords.Clone(),
+		withKeys:    _q.withKeys.Clone(),
+		withHeads:   _q.withHeads.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +379,28 @@ func (_q *SumDBTreeQuery) WithRecords(opts ...func(*SumDBRecordQuery)) *SumDBTre
 	return _q
 }
 
+// WithKeys tells the query-builder to eager-load the nodes that are connected to
+// the "keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SumDBTreeQuery) WithKeys(opts ...func(*SumDBKeyQuery)) *SumDBTreeQuery {
+	query := (&SumDBKeyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKeys = query
+	return _q
+}
+
+// WithHeads tells the query-builder to eager-load the nodes that are connected to
+// the "heads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SumDBTreeQuery) WithHeads(opts ...func(*SumDBTreeHeadQuery)) *SumDBTreeQuery {
+	query := (&SumDBTreeHeadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHeads = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +479,11 @@ func (_q *SumDBTreeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Su
 	var (
 		nodes       = []*SumDBTree{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withHashes != nil,
 			_q.withRecords != nil,
+			_q.withKeys != nil,
+			_q.withHeads != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +518,20 @@ func (_q *SumDBTreeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Su
 			return nil, err
 		}
 	}
+	if query := _q.withKeys; query != nil {
+		if err := _q.loadKeys(ctx, query, nodes,
+			func(n *SumDBTree) { n.Edges.Keys = []*SumDBKey{} },
+			func(n *SumDBTree, e *SumDBKey) { n.Edges.Keys = append(n.Edges.Keys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHeads; query != nil {
+		if err := _q.loadHeads(ctx, query, nodes,
+			func(n *SumDBTree) { n.Edges.Heads = []*SumDBTreeHead{} },
+			func(n *SumDBTree, e *SumDBTreeHead) { n.Edges.Heads = append(n.Edges.Heads, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -509,6 +597,68 @@ func (_q *SumDBTreeQuery) loadRecords(ctx context.Context, query *SumDBRecordQue
 	}
 	return nil
 }
+func (_q *SumDBTreeQuery) loadKeys(ctx context.Context, query *SumDBKeyQuery, nodes []*SumDBTree, init func(*SumDBTree), assign func(*SumDBTree, *SumDBKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SumDBTree)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SumDBKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(sumdbtree.KeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tree_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tree_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tree_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SumDBTreeQuery) loadHeads(ctx context.Context, query *SumDBTreeHeadQuery, nodes []*SumDBTree, init func(*SumDBTree), assign func(*SumDBTree, *SumDBTreeHead)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SumDBTree)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SumDBTreeHead(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(sumdbtree.HeadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tree_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tree_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tree_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *SumDBTreeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
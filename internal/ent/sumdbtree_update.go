@@ -14,8 +14,10 @@ import (
 	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/ent/predicate"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
 )
 
 // SumDBTreeUpdate is the builder for updating SumDBTree entities.
@@ -100,6 +102,20 @@ func (_u *SumDBTreeUpdate) SetNillableVerifierKey(v *string) *SumDBTreeUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *SumDBTreeUpdate) SetStatus(v sumdbtree.Status) *SumDBTreeUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SumDBTreeUpdate) SetNillableStatus(v *sumdbtree.Status) *SumDBTreeUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // AddHashIDs adds the "hashes" edge to the SumDBHash entity by IDs.
 func (_u *SumDBTreeUpdate) AddHashIDs(ids ...int) *SumDBTreeUpdate {
 	_u.mutation.AddHashIDs(ids...)
@@ -130,6 +146,36 @@ func (_u *SumDBTreeUpdate) AddRecords(v ...*SumDBRecord) *SumDBTreeUpdate {
 	return _u.AddRecordIDs(ids...)
 }
 
+// AddKeyIDs adds the "keys" edge to the SumDBKey entity by IDs.
+func (_u *SumDBTreeUpdate) AddKeyIDs(ids ...int) *SumDBTreeUpdate {
+	_u.mutation.AddKeyIDs(ids...)
+	return _u
+}
+
+// AddKeys adds the "keys" edges to the SumDBKey entity.
+func (_u *SumDBTreeUpdate) AddKeys(v ...*SumDBKey) *SumDBTreeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKeyIDs(ids...)
+}
+
+// AddHeadIDs adds the "heads" edge to the SumDBTreeHead entity by IDs.
+func (_u *SumDBTreeUpdate) AddHeadIDs(ids ...int) *SumDBTreeUpdate {
+	_u.mutation.AddHeadIDs(ids...)
+	return _u
+}
+
+// AddHeads adds the "heads" edges to the SumDBTreeHead entity.
+func (_u *SumDBTreeUpdate) AddHeads(v ...*SumDBTreeHead) *SumDBTreeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHeadIDs(ids...)
+}
+
 // Mutation returns the SumDBTreeMutation object of the builder.
 func (_u *SumDBTreeUpdate) Mutation() *SumDBTreeMutation {
 	return _u.mutation
@@ -177,6 +223,48 @@ func (_u *SumDBTreeUpdate) RemoveRecords(v ...*SumDBRecord) *SumDBTreeUpdate {
 	return _u.RemoveRecordIDs(ids...)
 }
 
+// ClearKeys clears all "keys" edges to the SumDBKey entity.
+func (_u *SumDBTreeUpdate) ClearKeys() *SumDBTreeUpdate {
+	_u.mutation.ClearKeys()
+	return _u
+}
+
+// RemoveKeyIDs removes the "keys" edge to SumDBKey entities by IDs.
+func (_u *SumDBTreeUpdate) RemoveKeyIDs(ids ...int) *SumDBTreeUpdate {
+	_u.mutation.RemoveKeyIDs(ids...)
+	return _u
+}
+
+// RemoveKeys removes "keys" edges to SumDBKey entities.
+func (_u *SumDBTreeUpdate) RemoveKeys(v ...*SumDBKey) *SumDBTreeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKeyIDs(ids...)
+}
+
+// ClearHeads clears all "heads" edges to the SumDBTreeHead entity.
+func (_u *SumDBTreeUpdate) ClearHeads() *SumDBTreeUpdate {
+	_u.mutation.ClearHeads()
+	return _u
+}
+
+// RemoveHeadIDs removes the "heads" edge to SumDBTreeHead entities by IDs.
+func (_u *SumDBTreeUpdate) RemoveHeadIDs(ids ...int) *SumDBTreeUpdate {
+	_u.mutation.RemoveHeadIDs(ids...)
+	return _u
+}
+
+// RemoveHeads removes "heads" edges to SumDBTreeHead entities.
+func (_u *SumDBTreeUpdate) RemoveHeads(v ...*SumDBTreeHead) *SumDBTreeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHeadIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SumDBTreeUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -230,6 +318,11 @@ func (_u *SumDBTreeUpdate) check() error {
 			return &ValidationError{Name: "verifier_key", err: fmt.Errorf(`ent: validator failed for field "SumDBTree.verifier_key": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := sumdbtree.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SumDBTree.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -263,6 +356,9 @@ func (_u *SumDBTreeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.VerifierKey(); ok {
 		_spec.SetField(sumdbtree.FieldVerifierKey, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(sumdbtree.FieldStatus, field.TypeEnum, value)
+	}
 	if _u.mutation.HashesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -353,6 +449,96 @@ func (_u *SumDBTreeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKeysIDs(); len(nodes) > 0 && !_u.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHeadsIDs(); len(nodes) > 0 && !_u.mutation.HeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{sumdbtree.Label}
@@ -442,6 +628,20 @@ func (_u *SumDBTreeUpdateOne) SetNillableVerifierKey(v *string) *SumDBTreeUpdate
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *SumDBTreeUpdateOne) SetStatus(v sumdbtree.Status) *SumDBTreeUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SumDBTreeUpdateOne) SetNillableStatus(v *sumdbtree.Status) *SumDBTreeUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // AddHashIDs adds the "hashes" edge to the SumDBHash entity by IDs.
 func (_u *SumDBTreeUpdateOne) AddHashIDs(ids ...int) *SumDBTreeUpdateOne {
 	_u.mutation.AddHashIDs(ids...)
@@ -472,6 +672,36 @@ func (_u *SumDBTreeUpdateOne) AddRecords(v ...*SumDBRecord) *SumDBTreeUpdateOne
 	return _u.AddRecordIDs(ids...)
 }
 
+// AddKeyIDs adds the "keys" edge to the SumDBKey entity by IDs.
+func (_u *SumDBTreeUpdateOne) AddKeyIDs(ids ...int) *SumDBTreeUpdateOne {
+	_u.mutation.AddKeyIDs(ids...)
+	return _u
+}
+
+// AddKeys adds the "keys" edges to the SumDBKey entity.
+func (_u *SumDBTreeUpdateOne) AddKeys(v ...*SumDBKey) *SumDBTreeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKeyIDs(ids...)
+}
+
+// AddHeadIDs adds the "heads" edge to the SumDBTreeHead entity by IDs.
+func (_u *SumDBTreeUpdateOne) AddHeadIDs(ids ...int) *SumDBTreeUpdateOne {
+	_u.mutation.AddHeadIDs(ids...)
+	return _u
+}
+
+// AddHeads adds the "heads" edges to the SumDBTreeHead entity.
+func (_u *SumDBTreeUpdateOne) AddHeads(v ...*SumDBTreeHead) *SumDBTreeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHeadIDs(ids...)
+}
+
 // Mutation returns the SumDBTreeMutation object of the builder.
 func (_u *SumDBTreeUpdateOne) Mutation() *SumDBTreeMutation {
 	return _u.mutation
@@ -519,6 +749,48 @@ func (_u *SumDBTreeUpdateOne) RemoveRecords(v ...*SumDBRecord) *SumDBTreeUpdateO
 	return _u.RemoveRecordIDs(ids...)
 }
 
+// ClearKeys clears all "keys" edges to the SumDBKey entity.
+func (_u *SumDBTreeUpdateOne) ClearKeys() *SumDBTreeUpdateOne {
+	_u.mutation.ClearKeys()
+	return _u
+}
+
+// RemoveKeyIDs removes the "keys" edge to SumDBKey entities by IDs.
+func (_u *SumDBTreeUpdateOne) RemoveKeyIDs(ids ...int) *SumDBTreeUpdateOne {
+	_u.mutation.RemoveKeyIDs(ids...)
+	return _u
+}
+
+// RemoveKeys removes "keys" edges to SumDBKey entities.
+func (_u *SumDBTreeUpdateOne) RemoveKeys(v ...*SumDBKey) *SumDBTreeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKeyIDs(ids...)
+}
+
+// ClearHeads clears all "heads" edges to the SumDBTreeHead entity.
+func (_u *SumDBTreeUpdateOne) ClearHeads() *SumDBTreeUpdateOne {
+	_u.mutation.ClearHeads()
+	return _u
+}
+
+// RemoveHeadIDs removes the "heads" edge to SumDBTreeHead entities by IDs.
+func (_u *SumDBTreeUpdateOne) RemoveHeadIDs(ids ...int) *SumDBTreeUpdateOne {
+	_u.mutation.RemoveHeadIDs(ids...)
+	return _u
+}
+
+// RemoveHeads removes "heads" edges to SumDBTreeHead entities.
+func (_u *SumDBTreeUpdateOne) RemoveHeads(v ...*SumDBTreeHead) *SumDBTreeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHeadIDs(ids...)
+}
+
 // Where appends a list predicates to the SumDBTreeUpdate builder.
 func (_u *SumDBTreeUpdateOne) Where(ps ...predicate.SumDBTree) *SumDBTreeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -585,6 +857,11 @@ func (_u *SumDBTreeUpdateOne) check() error {
 			return &ValidationError{Name: "verifier_key", err: fmt.Errorf(`ent: validator failed for field "SumDBTree.verifier_key": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := sumdbtree.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SumDBTree.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -635,6 +912,9 @@ func (_u *SumDBTreeUpdateOne) sqlSave(ctx context.Context) (_node *SumDBTree, er
 	if value, ok := _u.mutation.VerifierKey(); ok {
 		_spec.SetField(sumdbtree.FieldVerifierKey, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(sumdbtree.FieldStatus, field.TypeEnum, value)
+	}
 	if _u.mutation.HashesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -725,6 +1005,96 @@ func (_u *SumDBTreeUpdateOne) sqlSave(ctx context.Context) (_node *SumDBTree, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKeysIDs(); len(nodes) > 0 && !_u.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.KeysTable,
+			Columns: []string{sumdbtree.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHeadsIDs(); len(nodes) > 0 && !_u.mutation.HeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtree.HeadsTable,
+			Columns: []string{sumdbtree.HeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SumDBTree{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
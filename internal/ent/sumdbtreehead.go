@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBTreeHead is the model entity for the SumDBTreeHead schema.
+type SumDBTreeHead struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// When this object was initially created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The last time this object was modified
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash []byte `json:"hash,omitempty"`
+	// The signed note, as first served by the tree
+	Note []byte `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SumDBTreeHeadQuery when eager-loading is set.
+	Edges        SumDBTreeHeadEdges `json:"edges"`
+	tree_id      *int
+	selectValues sql.SelectValues
+}
+
+// SumDBTreeHeadEdges holds the relations/edges for other nodes in the graph.
+type SumDBTreeHeadEdges struct {
+	// Tree holds the value of the tree edge.
+	Tree *SumDBTree `json:"tree,omitempty"`
+	// Cosignatures holds the value of the cosignatures edge.
+	Cosignatures []*SumDBCosignature `json:"cosignatures,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TreeOrErr returns the Tree value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SumDBTreeHeadEdges) TreeOrErr() (*SumDBTree, error) {
+	if e.Tree != nil {
+		return e.Tree, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: sumdbtree.Label}
+	}
+	return nil, &NotLoadedError{edge: "tree"}
+}
+
+// CosignaturesOrErr returns the Cosignatures value or an error if the edge
+// was not loaded in eager-loading.
+func (e SumDBTreeHeadEdges) CosignaturesOrErr() ([]*SumDBCosignature, error) {
+	if e.loadedTypes[1] {
+		return e.Cosignatures, nil
+	}
+	return nil, &NotLoadedError{edge: "cosignatures"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SumDBTreeHead) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sumdbtreehead.FieldHash, sumdbtreehead.FieldNote:
+			values[i] = new([]byte)
+		case sumdbtreehead.FieldID, sumdbtreehead.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case sumdbtreehead.FieldCreatedAt, sumdbtreehead.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case sumdbtreehead.ForeignKeys[0]: // tree_id
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SumDBTreeHead fields.
+func (_m *SumDBTreeHead) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sumdbtreehead.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case sumdbtreehead.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sumdbtreehead.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case sumdbtreehead.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case sumdbtreehead.FieldHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value != nil {
+				_m.Hash = *value
+			}
+		case sumdbtreehead.FieldNote:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value != nil {
+				_m.Note = *value
+			}
+		case sumdbtreehead.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tree_id", value)
+			} else if value.Valid {
+				_m.tree_id = new(int)
+				*_m.tree_id = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SumDBTreeHead.
+// This includes values selected through modifiers, order, etc.
+func (_m *SumDBTreeHead) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTree queries the "tree" edge of the SumDBTreeHead entity.
+func (_m *SumDBTreeHead) QueryTree() *SumDBTreeQuery {
+	return NewSumDBTreeHeadClient(_m.config).QueryTree(_m)
+}
+
+// QueryCosignatures queries the "cosignatures" edge of the SumDBTreeHead entity.
+func (_m *SumDBTreeHead) QueryCosignatures() *SumDBCosignatureQuery {
+	return NewSumDBTreeHeadClient(_m.config).QueryCosignatures(_m)
+}
+
+// Update returns a builder for updating this SumDBTreeHead.
+// Note that you need to call SumDBTreeHead.Unwrap() before calling this method if this SumDBTreeHead
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SumDBTreeHead) Update() *SumDBTreeHeadUpdateOne {
+	return NewSumDBTreeHeadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SumDBTreeHead entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SumDBTreeHead) Unwrap() *SumDBTreeHead {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SumDBTreeHead is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SumDBTreeHead) String() string {
+	var builder strings.Builder
+	builder.WriteString("SumDBTreeHead(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hash))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Note))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SumDBTreeHeads is a parsable slice of SumDBTreeHead.
+type SumDBTreeHeads []*SumDBTreeHead
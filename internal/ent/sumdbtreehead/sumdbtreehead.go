@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sumdbtreehead
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sumdbtreehead type in the database.
+	Label = "sum_db_tree_head"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// EdgeTree holds the string denoting the tree edge name in mutations.
+	EdgeTree = "tree"
+	// EdgeCosignatures holds the string denoting the cosignatures edge name in mutations.
+	EdgeCosignatures = "cosignatures"
+	// Table holds the table name of the sumdbtreehead in the database.
+	Table = "sum_db_tree_heads"
+	// TreeTable is the table that holds the tree relation/edge.
+	TreeTable = "sum_db_tree_heads"
+	// TreeInverseTable is the table name for the SumDBTree entity.
+	// It exists in this package in order to avoid circular dependency with the "sumdbtree" package.
+	TreeInverseTable = "sum_db_trees"
+	// TreeColumn is the table column denoting the tree relation/edge.
+	TreeColumn = "tree_id"
+	// CosignaturesTable is the table that holds the cosignatures relation/edge.
+	CosignaturesTable = "sum_db_cosignatures"
+	// CosignaturesInverseTable is the table name for the SumDBCosignature entity.
+	// It exists in this package in order to avoid circular dependency with the "sumdbcosignature" package.
+	CosignaturesInverseTable = "sum_db_cosignatures"
+	// CosignaturesColumn is the table column denoting the cosignatures relation/edge.
+	CosignaturesColumn = "head_id"
+)
+
+// Columns holds all SQL columns for sumdbtreehead fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSize,
+	FieldHash,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sum_db_tree_heads"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tree_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SumDBTreeHead queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByTreeField orders the results by tree field.
+func ByTreeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTreeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCosignaturesCount orders the results by cosignatures count.
+func ByCosignaturesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCosignaturesStep(), opts...)
+	}
+}
+
+// ByCosignatures orders the results by cosignatures terms.
+func ByCosignatures(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCosignaturesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTreeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TreeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TreeTable, TreeColumn),
+	)
+}
+func newCosignaturesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CosignaturesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CosignaturesTable, CosignaturesColumn),
+	)
+}
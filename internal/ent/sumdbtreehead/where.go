@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sumdbtreehead
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldSize, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldHash, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLTE(FieldSize, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...[]byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...[]byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLTE(FieldHash, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...[]byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...[]byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v []byte) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.FieldLTE(FieldNote, v))
+}
+
+// HasTree applies the HasEdge predicate on the "tree" edge.
+func HasTree() predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TreeTable, TreeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTreeWith applies the HasEdge predicate on the "tree" edge with a given conditions (other predicates).
+func HasTreeWith(preds ...predicate.SumDBTree) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(func(s *sql.Selector) {
+		step := newTreeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCosignatures applies the HasEdge predicate on the "cosignatures" edge.
+func HasCosignatures() predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CosignaturesTable, CosignaturesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCosignaturesWith applies the HasEdge predicate on the "cosignatures" edge with a given conditions (other predicates).
+func HasCosignaturesWith(preds ...predicate.SumDBCosignature) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(func(s *sql.Selector) {
+		step := newCosignaturesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SumDBTreeHead) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SumDBTreeHead) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SumDBTreeHead) predicate.SumDBTreeHead {
+	return predicate.SumDBTreeHead(sql.NotPredicates(p))
+}
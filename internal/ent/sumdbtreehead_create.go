@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBTreeHeadCreate is the builder for creating a SumDBTreeHead entity.
+type SumDBTreeHeadCreate struct {
+	config
+	mutation *SumDBTreeHeadMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SumDBTreeHeadCreate) SetCreatedAt(v time.Time) *SumDBTreeHeadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SumDBTreeHeadCreate) SetNillableCreatedAt(v *time.Time) *SumDBTreeHeadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SumDBTreeHeadCreate) SetUpdatedAt(v time.Time) *SumDBTreeHeadCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SumDBTreeHeadCreate) SetNillableUpdatedAt(v *time.Time) *SumDBTreeHeadCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *SumDBTreeHeadCreate) SetSize(v int64) *SumDBTreeHeadCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *SumDBTreeHeadCreate) SetHash(v []byte) *SumDBTreeHeadCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *SumDBTreeHeadCreate) SetNote(v []byte) *SumDBTreeHeadCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetTreeID sets the "tree" edge to the SumDBTree entity by ID.
+func (_c *SumDBTreeHeadCreate) SetTreeID(id int) *SumDBTreeHeadCreate {
+	_c.mutation.SetTreeID(id)
+	return _c
+}
+
+// SetTree sets the "tree" edge to the SumDBTree entity.
+func (_c *SumDBTreeHeadCreate) SetTree(v *SumDBTree) *SumDBTreeHeadCreate {
+	return _c.SetTreeID(v.ID)
+}
+
+// AddCosignatureIDs adds the "cosignatures" edge to the SumDBCosignature entity by IDs.
+func (_c *SumDBTreeHeadCreate) AddCosignatureIDs(ids ...int) *SumDBTreeHeadCreate {
+	_c.mutation.AddCosignatureIDs(ids...)
+	return _c
+}
+
+// AddCosignatures adds the "cosignatures" edges to the SumDBCosignature entity.
+func (_c *SumDBTreeHeadCreate) AddCosignatures(v ...*SumDBCosignature) *SumDBTreeHeadCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCosignatureIDs(ids...)
+}
+
+// Mutation returns the SumDBTreeHeadMutation object of the builder.
+func (_c *SumDBTreeHeadCreate) Mutation() *SumDBTreeHeadMutation {
+	return _c.mutation
+}
+
+// Save creates the SumDBTreeHead in the database.
+func (_c *SumDBTreeHeadCreate) Save(ctx context.Context) (*SumDBTreeHead, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SumDBTreeHeadCreate) SaveX(ctx context.Context) *SumDBTreeHead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SumDBTreeHeadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SumDBTreeHeadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SumDBTreeHeadCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sumdbtreehead.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := sumdbtreehead.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SumDBTreeHeadCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SumDBTreeHead.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SumDBTreeHead.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "SumDBTreeHead.size"`)}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "SumDBTreeHead.hash"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "SumDBTreeHead.note"`)}
+	}
+	if len(_c.mutation.TreeIDs()) == 0 {
+		return &ValidationError{Name: "tree", err: errors.New(`ent: missing required edge "SumDBTreeHead.tree"`)}
+	}
+	return nil
+}
+
+func (_c *SumDBTreeHeadCreate) sqlSave(ctx context.Context) (*SumDBTreeHead, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SumDBTreeHeadCreate) createSpec() (*SumDBTreeHead, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SumDBTreeHead{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sumdbtreehead.Table, sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sumdbtreehead.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbtreehead.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(sumdbtreehead.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(sumdbtreehead.FieldHash, field.TypeBytes, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(sumdbtreehead.FieldNote, field.TypeBytes, value)
+		_node.Note = value
+	}
+	if nodes := _c.mutation.TreeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sumdbtreehead.TreeTable,
+			Columns: []string{sumdbtreehead.TreeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbtree.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tree_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CosignaturesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SumDBTreeHead.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SumDBTreeHeadUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SumDBTreeHeadCreate) OnConflict(opts ...sql.ConflictOption) *SumDBTreeHeadUpsertOne {
+	_c.conflict = opts
+	return &SumDBTreeHeadUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SumDBTreeHead.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SumDBTreeHeadCreate) OnConflictColumns(columns ...string) *SumDBTreeHeadUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SumDBTreeHeadUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SumDBTreeHeadUpsertOne is the builder for "upsert"-ing
+	//  one SumDBTreeHead node.
+	SumDBTreeHeadUpsertOne struct {
+		create *SumDBTreeHeadCreate
+	}
+
+	// SumDBTreeHeadUpsert is the "OnConflict" setter.
+	SumDBTreeHeadUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBTreeHeadUpsert) SetUpdatedAt(v time.Time) *SumDBTreeHeadUpsert {
+	u.Set(sumdbtreehead.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBTreeHeadUpsert) UpdateUpdatedAt() *SumDBTreeHeadUpsert {
+	u.SetExcluded(sumdbtreehead.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SumDBTreeHead.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SumDBTreeHeadUpsertOne) UpdateNewValues() *SumDBTreeHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sumdbtreehead.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Size(); exists {
+			s.SetIgnore(sumdbtreehead.FieldSize)
+		}
+		if _, exists := u.create.mutation.Hash(); exists {
+			s.SetIgnore(sumdbtreehead.FieldHash)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(sumdbtreehead.FieldNote)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SumDBTreeHead.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SumDBTreeHeadUpsertOne) Ignore() *SumDBTreeHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SumDBTreeHeadUpsertOne) DoNothing() *SumDBTreeHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SumDBTreeHeadCreate.OnConflict
+// documentation for more info.
+func (u *SumDBTreeHeadUpsertOne) Update(set func(*SumDBTreeHeadUpsert)) *SumDBTreeHeadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SumDBTreeHeadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBTreeHeadUpsertOne) SetUpdatedAt(v time.Time) *SumDBTreeHeadUpsertOne {
+	return u.Update(func(s *SumDBTreeHeadUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBTreeHeadUpsertOne) UpdateUpdatedAt() *SumDBTreeHeadUpsertOne {
+	return u.Update(func(s *SumDBTreeHeadUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SumDBTreeHeadUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SumDBTreeHeadCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SumDBTreeHeadUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SumDBTreeHeadUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SumDBTreeHeadUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SumDBTreeHeadCreateBulk is the builder for creating many SumDBTreeHead entities in bulk.
+type SumDBTreeHeadCreateBulk struct {
+	config
+	err      error
+	builders []*SumDBTreeHeadCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SumDBTreeHead entities in the database.
+func (_c *SumDBTreeHeadCreateBulk) Save(ctx context.Context) ([]*SumDBTreeHead, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SumDBTreeHead, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SumDBTreeHeadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SumDBTreeHeadCreateBulk) SaveX(ctx context.Context) []*SumDBTreeHead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SumDBTreeHeadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SumDBTreeHeadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SumDBTreeHead.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SumDBTreeHeadUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SumDBTreeHeadCreateBulk) OnConflict(opts ...sql.ConflictOption) *SumDBTreeHeadUpsertBulk {
+	_c.conflict = opts
+	return &SumDBTreeHeadUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SumDBTreeHead.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SumDBTreeHeadCreateBulk) OnConflictColumns(columns ...string) *SumDBTreeHeadUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SumDBTreeHeadUpsertBulk{
+		create: _c,
+	}
+}
+
+// SumDBTreeHeadUpsertBulk is the builder for "upsert"-ing
+// a bulk of SumDBTreeHead nodes.
+type SumDBTreeHeadUpsertBulk struct {
+	create *SumDBTreeHeadCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SumDBTreeHead.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SumDBTreeHeadUpsertBulk) UpdateNewValues() *SumDBTreeHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sumdbtreehead.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Size(); exists {
+				s.SetIgnore(sumdbtreehead.FieldSize)
+			}
+			if _, exists := b.mutation.Hash(); exists {
+				s.SetIgnore(sumdbtreehead.FieldHash)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(sumdbtreehead.FieldNote)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SumDBTreeHead.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SumDBTreeHeadUpsertBulk) Ignore() *SumDBTreeHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SumDBTreeHeadUpsertBulk) DoNothing() *SumDBTreeHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SumDBTreeHeadCreateBulk.OnConflict
+// documentation for more info.
+func (u *SumDBTreeHeadUpsertBulk) Update(set func(*SumDBTreeHeadUpsert)) *SumDBTreeHeadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SumDBTreeHeadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SumDBTreeHeadUpsertBulk) SetUpdatedAt(v time.Time) *SumDBTreeHeadUpsertBulk {
+	return u.Update(func(s *SumDBTreeHeadUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SumDBTreeHeadUpsertBulk) UpdateUpdatedAt() *SumDBTreeHeadUpsertBulk {
+	return u.Update(func(s *SumDBTreeHeadUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SumDBTreeHeadUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SumDBTreeHeadCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SumDBTreeHeadCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SumDBTreeHeadUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
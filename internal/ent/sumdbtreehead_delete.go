@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBTreeHeadDelete is the builder for deleting a SumDBTreeHead entity.
+type SumDBTreeHeadDelete struct {
+	config
+	hooks    []Hook
+	mutation *SumDBTreeHeadMutation
+}
+
+// Where appends a list predicates to the SumDBTreeHeadDelete builder.
+func (_d *SumDBTreeHeadDelete) Where(ps ...predicate.SumDBTreeHead) *SumDBTreeHeadDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SumDBTreeHeadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SumDBTreeHeadDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SumDBTreeHeadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sumdbtreehead.Table, sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SumDBTreeHeadDeleteOne is the builder for deleting a single SumDBTreeHead entity.
+type SumDBTreeHeadDeleteOne struct {
+	_d *SumDBTreeHeadDelete
+}
+
+// Where appends a list predicates to the SumDBTreeHeadDelete builder.
+func (_d *SumDBTreeHeadDeleteOne) Where(ps ...predicate.SumDBTreeHead) *SumDBTreeHeadDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SumDBTreeHeadDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sumdbtreehead.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SumDBTreeHeadDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
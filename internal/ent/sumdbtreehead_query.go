@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBTreeHeadQuery is the builder for querying SumDBTreeHead entities.
+type SumDBTreeHeadQuery struct {
+	config
+	ctx              *QueryContext
+	order            []sumdbtreehead.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.SumDBTreeHead
+	withTree         *SumDBTreeQuery
+	withCosignatures *SumDBCosignatureQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SumDBTreeHeadQuery builder.
+func (_q *SumDBTreeHeadQuery) Where(ps ...predicate.SumDBTreeHead) *SumDBTreeHeadQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *SumDBTreeHeadQuery) Limit(limit int) *SumDBTreeHeadQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *SumDBTreeHeadQuery) Offset(offset int) *SumDBTreeHeadQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *SumDBTreeHeadQuery) Unique(unique bool) *SumDBTreeHeadQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *SumDBTreeHeadQuery) Order(o ...sumdbtreehead.OrderOption) *SumDBTreeHeadQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTree chains the current query on the "tree" edge.
+func (_q *SumDBTreeHeadQuery) QueryTree() *SumDBTreeQuery {
+	query := (&SumDBTreeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtreehead.Table, sumdbtreehead.FieldID, selector),
+			sqlgraph.To(sumdbtree.Table, sumdbtree.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sumdbtreehead.TreeTable, sumdbtreehead.TreeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCosignatures chains the current query on the "cosignatures" edge.
+func (_q *SumDBTreeHeadQuery) QueryCosignatures() *SumDBCosignatureQuery {
+	query := (&SumDBCosignatureClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sumdbtreehead.Table, sumdbtreehead.FieldID, selector),
+			sqlgraph.To(sumdbcosignature.Table, sumdbcosignature.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sumdbtreehead.CosignaturesTable, sumdbtreehead.CosignaturesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SumDBTreeHead entity from the query.
+// Returns a *NotFoundError when no SumDBTreeHead was found.
+func (_q *SumDBTreeHeadQuery) First(ctx context.Context) (*SumDBTreeHead, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sumdbtreehead.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) FirstX(ctx context.Context) *SumDBTreeHead {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SumDBTreeHead ID from the query.
+// Returns a *NotFoundError when no SumDBTreeHead ID was found.
+func (_q *SumDBTreeHeadQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sumdbtreehead.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SumDBTreeHead entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SumDBTreeHead entity is found.
+// Returns a *NotFoundError when no SumDBTreeHead entities are found.
+func (_q *SumDBTreeHeadQuery) Only(ctx context.Context) (*SumDBTreeHead, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sumdbtreehead.Label}
+	default:
+		return nil, &NotSingularError{sumdbtreehead.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) OnlyX(ctx context.Context) *SumDBTreeHead {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SumDBTreeHead ID in the query.
+// Returns a *NotSingularError when more than one SumDBTreeHead ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *SumDBTreeHeadQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sumdbtreehead.Label}
+	default:
+		err = &NotSingularError{sumdbtreehead.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SumDBTreeHeads.
+func (_q *SumDBTreeHeadQuery) All(ctx context.Context) ([]*SumDBTreeHead, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SumDBTreeHead, *SumDBTreeHeadQuery]()
+	return withInterceptors[[]*SumDBTreeHead](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) AllX(ctx context.Context) []*SumDBTreeHead {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SumDBTreeHead IDs.
+func (_q *SumDBTreeHeadQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(sumdbtreehead.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *SumDBTreeHeadQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*SumDBTreeHeadQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *SumDBTreeHeadQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *SumDBTreeHeadQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SumDBTreeHeadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *SumDBTreeHeadQuery) Clone() *SumDBTreeHeadQuery {
+	if _q == nil {
+		return nil
+	}
+	return &SumDBTreeHeadQuery{
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]sumdbtreehead.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.SumDBTreeHead{}, _q.predicates...),
+		withTree:         _q.withTree.Clone(),
+		withCosignatures: _q.withCosignatures.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithTree tells the query-builder to eager-load the nodes that are connected to
+// the "tree" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SumDBTreeHeadQuery) WithTree(opts ...func(*SumDBTreeQuery)) *SumDBTreeHeadQuery {
+	query := (&SumDBTreeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTree = query
+	return _q
+}
+
+// WithCosignatures tells the query-builder to eager-load the nodes that are connected to
+// the "cosignatures" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SumDBTreeHeadQuery) WithCosignatures(opts ...func(*SumDBCosignatureQuery)) *SumDBTreeHeadQuery {
+	query := (&SumDBCosignatureClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCosignatures = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SumDBTreeHead.Query().
+//		GroupBy(sumdbtreehead.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *SumDBTreeHeadQuery) GroupBy(field string, fields ...string) *SumDBTreeHeadGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SumDBTreeHeadGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = sumdbtreehead.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SumDBTreeHead.Query().
+//		Select(sumdbtreehead.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *SumDBTreeHeadQuery) Select(fields ...string) *SumDBTreeHeadSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &SumDBTreeHeadSelect{SumDBTreeHeadQuery: _q}
+	sbuild.label = sumdbtreehead.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SumDBTreeHeadSelect configured with the given aggregations.
+func (_q *SumDBTreeHeadQuery) Aggregate(fns ...AggregateFunc) *SumDBTreeHeadSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *SumDBTreeHeadQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !sumdbtreehead.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *SumDBTreeHeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SumDBTreeHead, error) {
+	var (
+		nodes       = []*SumDBTreeHead{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withTree != nil,
+			_q.withCosignatures != nil,
+		}
+	)
+	if _q.withTree != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, sumdbtreehead.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SumDBTreeHead).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SumDBTreeHead{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTree; query != nil {
+		if err := _q.loadTree(ctx, query, nodes, nil,
+			func(n *SumDBTreeHead, e *SumDBTree) { n.Edges.Tree = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCosignatures; query != nil {
+		if err := _q.loadCosignatures(ctx, query, nodes,
+			func(n *SumDBTreeHead) { n.Edges.Cosignatures = []*SumDBCosignature{} },
+			func(n *SumDBTreeHead, e *SumDBCosignature) { n.Edges.Cosignatures = append(n.Edges.Cosignatures, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *SumDBTreeHeadQuery) loadTree(ctx context.Context, query *SumDBTreeQuery, nodes []*SumDBTreeHead, init func(*SumDBTreeHead), assign func(*SumDBTreeHead, *SumDBTree)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SumDBTreeHead)
+	for i := range nodes {
+		if nodes[i].tree_id == nil {
+			continue
+		}
+		fk := *nodes[i].tree_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sumdbtree.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tree_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SumDBTreeHeadQuery) loadCosignatures(ctx context.Context, query *SumDBCosignatureQuery, nodes []*SumDBTreeHead, init func(*SumDBTreeHead), assign func(*SumDBTreeHead, *SumDBCosignature)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SumDBTreeHead)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SumDBCosignature(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(sumdbtreehead.CosignaturesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.head_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "head_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "head_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *SumDBTreeHeadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *SumDBTreeHeadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sumdbtreehead.Table, sumdbtreehead.Columns, sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sumdbtreehead.FieldID)
+		for i := range fields {
+			if fields[i] != sumdbtreehead.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *SumDBTreeHeadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(sumdbtreehead.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = sumdbtreehead.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SumDBTreeHeadGroupBy is the group-by builder for SumDBTreeHead entities.
+type SumDBTreeHeadGroupBy struct {
+	selector
+	build *SumDBTreeHeadQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *SumDBTreeHeadGroupBy) Aggregate(fns ...AggregateFunc) *SumDBTreeHeadGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *SumDBTreeHeadGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SumDBTreeHeadQuery, *SumDBTreeHeadGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *SumDBTreeHeadGroupBy) sqlScan(ctx context.Context, root *SumDBTreeHeadQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SumDBTreeHeadSelect is the builder for selecting fields of SumDBTreeHead entities.
+type SumDBTreeHeadSelect struct {
+	*SumDBTreeHeadQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *SumDBTreeHeadSelect) Aggregate(fns ...AggregateFunc) *SumDBTreeHeadSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *SumDBTreeHeadSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SumDBTreeHeadQuery, *SumDBTreeHeadSelect](ctx, _s.SumDBTreeHeadQuery, _s, _s.inters, v)
+}
+
+func (_s *SumDBTreeHeadSelect) sqlScan(ctx context.Context, root *SumDBTreeHeadQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
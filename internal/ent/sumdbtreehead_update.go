@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pseudomuto/pacman/internal/ent/predicate"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+)
+
+// SumDBTreeHeadUpdate is the builder for updating SumDBTreeHead entities.
+type SumDBTreeHeadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SumDBTreeHeadMutation
+}
+
+// Where appends a list predicates to the SumDBTreeHeadUpdate builder.
+func (_u *SumDBTreeHeadUpdate) Where(ps ...predicate.SumDBTreeHead) *SumDBTreeHeadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SumDBTreeHeadUpdate) SetUpdatedAt(v time.Time) *SumDBTreeHeadUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddCosignatureIDs adds the "cosignatures" edge to the SumDBCosignature entity by IDs.
+func (_u *SumDBTreeHeadUpdate) AddCosignatureIDs(ids ...int) *SumDBTreeHeadUpdate {
+	_u.mutation.AddCosignatureIDs(ids...)
+	return _u
+}
+
+// AddCosignatures adds the "cosignatures" edges to the SumDBCosignature entity.
+func (_u *SumDBTreeHeadUpdate) AddCosignatures(v ...*SumDBCosignature) *SumDBTreeHeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCosignatureIDs(ids...)
+}
+
+// Mutation returns the SumDBTreeHeadMutation object of the builder.
+func (_u *SumDBTreeHeadUpdate) Mutation() *SumDBTreeHeadMutation {
+	return _u.mutation
+}
+
+// ClearCosignatures clears all "cosignatures" edges to the SumDBCosignature entity.
+func (_u *SumDBTreeHeadUpdate) ClearCosignatures() *SumDBTreeHeadUpdate {
+	_u.mutation.ClearCosignatures()
+	return _u
+}
+
+// RemoveCosignatureIDs removes the "cosignatures" edge to SumDBCosignature entities by IDs.
+func (_u *SumDBTreeHeadUpdate) RemoveCosignatureIDs(ids ...int) *SumDBTreeHeadUpdate {
+	_u.mutation.RemoveCosignatureIDs(ids...)
+	return _u
+}
+
+// RemoveCosignatures removes "cosignatures" edges to SumDBCosignature entities.
+func (_u *SumDBTreeHeadUpdate) RemoveCosignatures(v ...*SumDBCosignature) *SumDBTreeHeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCosignatureIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SumDBTreeHeadUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SumDBTreeHeadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SumDBTreeHeadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SumDBTreeHeadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SumDBTreeHeadUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sumdbtreehead.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SumDBTreeHeadUpdate) check() error {
+	if _u.mutation.TreeCleared() && len(_u.mutation.TreeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SumDBTreeHead.tree"`)
+	}
+	return nil
+}
+
+func (_u *SumDBTreeHeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sumdbtreehead.Table, sumdbtreehead.Columns, sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbtreehead.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CosignaturesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCosignaturesIDs(); len(nodes) > 0 && !_u.mutation.CosignaturesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CosignaturesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sumdbtreehead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SumDBTreeHeadUpdateOne is the builder for updating a single SumDBTreeHead entity.
+type SumDBTreeHeadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SumDBTreeHeadMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SumDBTreeHeadUpdateOne) SetUpdatedAt(v time.Time) *SumDBTreeHeadUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddCosignatureIDs adds the "cosignatures" edge to the SumDBCosignature entity by IDs.
+func (_u *SumDBTreeHeadUpdateOne) AddCosignatureIDs(ids ...int) *SumDBTreeHeadUpdateOne {
+	_u.mutation.AddCosignatureIDs(ids...)
+	return _u
+}
+
+// AddCosignatures adds the "cosignatures" edges to the SumDBCosignature entity.
+func (_u *SumDBTreeHeadUpdateOne) AddCosignatures(v ...*SumDBCosignature) *SumDBTreeHeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCosignatureIDs(ids...)
+}
+
+// Mutation returns the SumDBTreeHeadMutation object of the builder.
+func (_u *SumDBTreeHeadUpdateOne) Mutation() *SumDBTreeHeadMutation {
+	return _u.mutation
+}
+
+// ClearCosignatures clears all "cosignatures" edges to the SumDBCosignature entity.
+func (_u *SumDBTreeHeadUpdateOne) ClearCosignatures() *SumDBTreeHeadUpdateOne {
+	_u.mutation.ClearCosignatures()
+	return _u
+}
+
+// RemoveCosignatureIDs removes the "cosignatures" edge to SumDBCosignature entities by IDs.
+func (_u *SumDBTreeHeadUpdateOne) RemoveCosignatureIDs(ids ...int) *SumDBTreeHeadUpdateOne {
+	_u.mutation.RemoveCosignatureIDs(ids...)
+	return _u
+}
+
+// RemoveCosignatures removes "cosignatures" edges to SumDBCosignature entities.
+func (_u *SumDBTreeHeadUpdateOne) RemoveCosignatures(v ...*SumDBCosignature) *SumDBTreeHeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCosignatureIDs(ids...)
+}
+
+// Where appends a list predicates to the SumDBTreeHeadUpdate builder.
+func (_u *SumDBTreeHeadUpdateOne) Where(ps ...predicate.SumDBTreeHead) *SumDBTreeHeadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SumDBTreeHeadUpdateOne) Select(field string, fields ...string) *SumDBTreeHeadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SumDBTreeHead entity.
+func (_u *SumDBTreeHeadUpdateOne) Save(ctx context.Context) (*SumDBTreeHead, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SumDBTreeHeadUpdateOne) SaveX(ctx context.Context) *SumDBTreeHead {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SumDBTreeHeadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SumDBTreeHeadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SumDBTreeHeadUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sumdbtreehead.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SumDBTreeHeadUpdateOne) check() error {
+	if _u.mutation.TreeCleared() && len(_u.mutation.TreeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SumDBTreeHead.tree"`)
+	}
+	return nil
+}
+
+func (_u *SumDBTreeHeadUpdateOne) sqlSave(ctx context.Context) (_node *SumDBTreeHead, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sumdbtreehead.Table, sumdbtreehead.Columns, sqlgraph.NewFieldSpec(sumdbtreehead.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SumDBTreeHead.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sumdbtreehead.FieldID)
+		for _, f := range fields {
+			if !sumdbtreehead.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sumdbtreehead.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sumdbtreehead.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CosignaturesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCosignaturesIDs(); len(nodes) > 0 && !_u.mutation.CosignaturesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CosignaturesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sumdbtreehead.CosignaturesTable,
+			Columns: []string{sumdbtreehead.CosignaturesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sumdbcosignature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SumDBTreeHead{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sumdbtreehead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,14 +14,26 @@ type Tx struct {
 	config
 	// Archive is the client for interacting with the Archive builders.
 	Archive *ArchiveClient
+	// ArchiveReplacement is the client for interacting with the ArchiveReplacement builders.
+	ArchiveReplacement *ArchiveReplacementClient
 	// Asset is the client for interacting with the Asset builders.
 	Asset *AssetClient
+	// Deprecation is the client for interacting with the Deprecation builders.
+	Deprecation *DeprecationClient
+	// Retraction is the client for interacting with the Retraction builders.
+	Retraction *RetractionClient
+	// SumDBCosignature is the client for interacting with the SumDBCosignature builders.
+	SumDBCosignature *SumDBCosignatureClient
 	// SumDBHash is the client for interacting with the SumDBHash builders.
 	SumDBHash *SumDBHashClient
+	// SumDBKey is the client for interacting with the SumDBKey builders.
+	SumDBKey *SumDBKeyClient
 	// SumDBRecord is the client for interacting with the SumDBRecord builders.
 	SumDBRecord *SumDBRecordClient
 	// SumDBTree is the client for interacting with the SumDBTree builders.
 	SumDBTree *SumDBTreeClient
+	// SumDBTreeHead is the client for interacting with the SumDBTreeHead builders.
+	SumDBTreeHead *SumDBTreeHeadClient
 
 	// lazily loaded.
 	client     *Client
@@ -154,10 +166,16 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Archive = NewArchiveClient(tx.config)
+	tx.ArchiveReplacement = NewArchiveReplacementClient(tx.config)
 	tx.Asset = NewAssetClient(tx.config)
+	tx.Deprecation = NewDeprecationClient(tx.config)
+	tx.Retraction = NewRetractionClient(tx.config)
+	tx.SumDBCosignature = NewSumDBCosignatureClient(tx.config)
 	tx.SumDBHash = NewSumDBHashClient(tx.config)
+	tx.SumDBKey = NewSumDBKeyClient(tx.config)
 	tx.SumDBRecord = NewSumDBRecordClient(tx.config)
 	tx.SumDBTree = NewSumDBTreeClient(tx.config)
+	tx.SumDBTreeHead = NewSumDBTreeHeadClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
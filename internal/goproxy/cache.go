@@ -0,0 +1,181 @@
+package goproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/fsutil"
+	"github.com/pseudomuto/pacman/internal/types"
+	"golang.org/x/mod/module"
+)
+
+// Writer stores the contents of the reader at the supplied name (relative to the bucket root) and returns the full URI
+// of the object.
+//
+// NB: This is satisfied by storage.Uploader.
+type Writer interface {
+	Write(context.Context, io.Reader, string) (string, error)
+}
+
+// WithCache enables pull-through caching. Assets fetched from upstream are written using w and recorded as cached
+// Archives, so subsequent requests are served from storage (even when the upstream is unavailable). Cached Archives are
+// kept apart from published ones, which take them over when the same version is published.
+func WithCache(w Writer) UpstreamOption {
+	return func(up *UpstreamProxy) { up.cache = w }
+}
+
+// pull fetches the asset for mod (at/ext) from upstream, verifies it (see WithChecksumDB), writes it to the cache and
+// records it on mod's Archive. The Archive is created when it doesn't exist yet. The URI of the cached asset is
+// returned.
+func (s *UpstreamProxy) pull(ctx context.Context, mod module.Version, at types.AssetType, ext string) (string, error) {
+	name, err := escapedVersion(mod)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := s.fetch(ctx, name+ext)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = body.Close() }()
+
+	var uri string
+	if err := fsutil.WithTempFile(func(f *os.File) error {
+		if _, err := io.Copy(f, body); err != nil {
+			return fmt.Errorf("failed to download asset: %s, %w", mod, err)
+		}
+
+		if err := s.verify(mod, ext, f.Name()); err != nil {
+			return err
+		}
+
+		if _, err := f.Seek(0, 0); err != nil {
+			return fmt.Errorf("failed to seek in asset: %w", err)
+		}
+
+		// NB: Mirrors the GOPROXY layout (e.g. gomod/github.com/!some/module/@v/v1.0.0.zip). Unlike published assets,
+		// upstream versions are immutable, so they're not named by their hash.
+		if uri, err = s.cache.Write(ctx, f, types.GoModule.String()+"/"+name+ext); err != nil {
+			return fmt.Errorf("failed to cache asset: %s, %w", mod, err)
+		}
+
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	if err := s.record(ctx, mod, []schema.AssetURL{{Type: at, URL: uri}}, nil); err != nil {
+		return "", err
+	}
+
+	return uri, nil
+}
+
+// pullInfo fetches the .info for mod from upstream, recording its Time and Origin on mod's Archive.
+func (s *UpstreamProxy) pullInfo(ctx context.Context, mod module.Version) (*Info, error) {
+	name, err := escapedVersion(mod)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.fetch(ctx, name+".info")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+
+	var inf Info
+	if err := json.NewDecoder(body).Decode(&inf); err != nil {
+		return nil, fmt.Errorf("failed to decode upstream info: %s, %w", mod, err)
+	}
+
+	// NB: Queries (e.g. a branch name) resolve to a different version, there's nothing to record for those.
+	if inf.Version != mod.Version {
+		return &inf, nil
+	}
+
+	if err := s.record(ctx, mod, nil, &inf); err != nil {
+		return nil, err
+	}
+
+	return &inf, nil
+}
+
+// record adds assets (and inf when set) to the Archive for mod, creating it (marked as cached) when necessary.
+func (s *UpstreamProxy) record(ctx context.Context, mod module.Version, assets []schema.AssetURL, inf *Info) error {
+	if _, err := data.WithTx(ctx, s.db, func(tx *ent.Tx) (*ent.Archive, error) {
+		for _, a := range assets {
+			if err := tx.Asset.Create().SetType(a.Type).SetURI(a.URL).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("failed to create asset: %s, %w", mod, err)
+			}
+		}
+
+		arch, err := tx.Archive.Query().
+			Where(
+				archive.TypeEQ(types.GoModule),
+				archive.Coordinate(mod.String()),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			create := tx.Archive.Create().
+				SetType(types.GoModule).
+				SetCoordinate(mod.String()).
+				SetAssets(append([]schema.AssetURL{}, assets...)).
+				SetCached(true)
+			if inf != nil {
+				create.SetNillableReleasedAt(inf.Time)
+				if inf.Origin != nil {
+					create.SetOrigin(inf.Origin)
+				}
+			}
+
+			return create.Save(ctx)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to find archive: %s, %w", mod, err)
+		}
+
+		// NB: The module was published since it was looked up. Published archives always take precedence.
+		if !arch.Cached {
+			return arch, nil
+		}
+
+		update := arch.Update().AppendAssets(assets)
+		if inf != nil {
+			update.SetNillableReleasedAt(inf.Time)
+			if inf.Origin != nil {
+				update.SetOrigin(inf.Origin)
+			}
+		}
+
+		return update.Save(ctx)
+	}); err != nil && !ent.IsConstraintError(err) {
+		// NB: A constraint error means a concurrent request cached this module first. Our copy is still servable.
+		return fmt.Errorf("failed to record cached module: %s, %w", mod, err)
+	}
+
+	return nil
+}
+
+// escapedVersion returns the escaped path to mod's version files (sans extension), e.g. github.com/!some/mod/@v/v1.0.0.
+func escapedVersion(mod module.Version) (string, error) {
+	path, err := module.EscapePath(mod.Path)
+	if err != nil {
+		return "", fmt.Errorf("invalid module path: %s, %w", mod.Path, err)
+	}
+
+	version, err := module.EscapeVersion(mod.Version)
+	if err != nil {
+		return "", fmt.Errorf("invalid module version: %s, %w", mod.Version, err)
+	}
+
+	return path + "/@v/" + version, nil
+}
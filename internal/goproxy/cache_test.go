@@ -0,0 +1,143 @@
+package goproxy_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	. "github.com/pseudomuto/pacman/internal/goproxy"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+)
+
+type memBucket struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (b *memBucket) Write(_ context.Context, r io.Reader, name string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	uri := "mem://cache/" + name
+	b.objects[uri] = data
+	return uri, nil
+}
+
+func (b *memBucket) Read(_ context.Context, w io.Writer, uri string) error {
+	b.Lock()
+	defer b.Unlock()
+
+	data, ok := b.objects[uri]
+	if !ok {
+		return fmt.Errorf("not found: %s", uri)
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+func TestUpstreamProxy_Cache(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	var hits atomic.Int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		switch r.URL.Path {
+		case "/github.com/!some/mod/@v/v1.0.0.mod":
+			fmt.Fprint(w, "module github.com/Some/mod\n")
+		case "/github.com/!some/mod/@v/v1.0.0.zip":
+			fmt.Fprint(w, "zip bytes")
+		default:
+			http.Error(w, "not found: "+r.URL.Path, http.StatusGone)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	bucket := &memBucket{objects: make(map[string][]byte)}
+	up := NewUpstreamProxyWithHost(
+		client,
+		bucket,
+		svr.URL,
+		WithNoSumPatterns("*.corp.example.com"),
+		WithCache(bucket),
+	)
+
+	coordinate := archive.Coordinate("github.com/Some/mod@v1.0.0")
+
+	t.Run("mod miss", func(t *testing.T) {
+		w := get(t, up, "github.com/!some/mod/@v/v1.0.0.mod")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		require.Equal(t, "module github.com/Some/mod\n", w.Body.String())
+		require.Equal(t, int32(1), hits.Load())
+
+		arch := client.Archive.Query().Where(coordinate).OnlyX(t.Context())
+		require.Equal(t, types.GoModule, arch.Type)
+		require.True(t, arch.Cached)
+		require.Empty(t, arch.Hash)
+		require.Equal(t, []schema.AssetURL{
+			{Type: types.TextFile, URL: "mem://cache/gomod/github.com/!some/mod/@v/v1.0.0.mod"},
+		}, arch.Assets)
+	})
+
+	t.Run("mod hit", func(t *testing.T) {
+		w := get(t, up, "github.com/!some/mod/@v/v1.0.0.mod")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "module github.com/Some/mod\n", w.Body.String())
+		require.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("zip miss", func(t *testing.T) {
+		w := get(t, up, "github.com/!some/mod/@v/v1.0.0.zip")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+		require.Equal(t, "zip bytes", w.Body.String())
+		require.Equal(t, int32(2), hits.Load())
+
+		arch := client.Archive.Query().Where(coordinate).OnlyX(t.Context())
+		require.Len(t, arch.Assets, 2)
+		require.Equal(t, 2, client.Asset.Query().CountX(t.Context()))
+	})
+
+	t.Run("upstream status", func(t *testing.T) {
+		w := get(t, up, "github.com/!some/mod/@v/v2.0.0.zip")
+		require.Equal(t, http.StatusGone, w.Code)
+		require.Contains(t, w.Body.String(), "not found")
+		require.False(t, client.Archive.Query().Where(archive.Coordinate("github.com/Some/mod@v2.0.0")).ExistX(t.Context()))
+	})
+
+	t.Run("private module", func(t *testing.T) {
+		before := hits.Load()
+		w := get(t, up, "git.corp.example.com/private/@v/v1.0.0.zip")
+		require.Equal(t, http.StatusNotFound, w.Code)
+		require.Equal(t, before, hits.Load())
+	})
+
+	t.Run("upstream unavailable", func(t *testing.T) {
+		svr.Close()
+
+		w := get(t, up, "github.com/!some/mod/@v/v1.0.0.zip")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "zip bytes", w.Body.String())
+
+		w = get(t, up, "github.com/!some/mod/@v/v1.0.1.zip")
+		require.Equal(t, http.StatusBadGateway, w.Code)
+	})
+}
@@ -0,0 +1,174 @@
+package goproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+)
+
+type (
+	// Upstream is a GOPROXY server (e.g. proxy.golang.org, Athens, Artifactory) public modules are fetched from.
+	Upstream struct {
+		URL *url.URL
+		// Timeout bounds each request, including reading the response body. Zero means no timeout.
+		Timeout time.Duration
+		// FallbackOnError moves on to the next upstream for any error, like "|" in GOPROXY. Otherwise, only 404 and 410
+		// responses fall back, like ",".
+		FallbackOnError bool
+		// Username and Password are sent using basic auth. When only Password is set, it's sent as a bearer token.
+		Username string
+		Password crypto.Secret
+	}
+
+	// upstreamError is returned when an upstream responds with a non-200 status.
+	upstreamError struct {
+		code int
+		msg  string
+	}
+
+	// cancelBody cancels the request's context once the body has been closed.
+	cancelBody struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+)
+
+// WithUpstreams sets the chain of upstreams to fetch public modules from. They're tried in order, falling back to the
+// next one following GOPROXY semantics (see Upstream.FallbackOnError).
+func WithUpstreams(ups ...Upstream) UpstreamOption {
+	return func(up *UpstreamProxy) {
+		if len(ups) > 0 {
+			up.upstreams = ups
+		}
+	}
+}
+
+// ParseUpstreams returns the Upstreams from the config, decrypting any credentials.
+func ParseUpstreams(c *config.Config) ([]Upstream, error) {
+	ups := make([]Upstream, len(c.Go.Upstreams))
+	for i, cu := range c.Go.Upstreams {
+		u, err := url.Parse(cu.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upstream URL: %s, %w", cu.URL, err)
+		}
+
+		// NB: GOPROXY keywords (direct, off) aren't supported, upstreams must be proxies.
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("unsupported upstream: %s", u.Redacted())
+		}
+
+		var pw crypto.Secret
+		if cu.Password != "" {
+			if err := pw.Scan(cu.Password); err != nil {
+				return nil, fmt.Errorf("invalid upstream password: %s, %w", u.Redacted(), err)
+			}
+		}
+
+		ups[i] = Upstream{
+			URL:             u,
+			Timeout:         cu.Timeout,
+			FallbackOnError: cu.FallbackOnError,
+			Username:        cu.Username,
+			Password:        pw,
+		}
+	}
+
+	return ups, nil
+}
+
+// fetch GETs name (an escaped path relative to the upstream root) from the chain of upstreams and returns the response
+// body of the first successful one. Non-200 responses are returned as an *upstreamError.
+func (s *UpstreamProxy) fetch(ctx context.Context, name string) (io.ReadCloser, error) {
+	var err error
+	for _, up := range s.upstreams {
+		var body io.ReadCloser
+		if body, err = s.fetchFrom(ctx, up, name); err == nil {
+			return body, nil
+		}
+
+		var ue *upstreamError
+		notFound := errors.As(err, &ue) && (ue.code == http.StatusNotFound || ue.code == http.StatusGone)
+		if !notFound && !up.FallbackOnError {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
+
+func (s *UpstreamProxy) fetchFrom(ctx context.Context, up Upstream, name string) (io.ReadCloser, error) {
+	cancel := context.CancelFunc(func() {})
+	if up.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, up.Timeout)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, up.URL.JoinPath(name).String(), nil)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed creating upstream request: %s, %w", name, err)
+	}
+
+	switch {
+	case up.Username != "":
+		req.SetBasicAuth(up.Username, string(up.Password))
+	case up.Password != "":
+		req.Header.Set("Authorization", "Bearer "+string(up.Password))
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed fetching from upstream: %s, %s, %w", up.URL.Redacted(), name, err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		defer cancel()
+		defer func() { _ = res.Body.Close() }()
+
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, &upstreamError{code: res.StatusCode, msg: strings.TrimSpace(string(msg))}
+	}
+
+	return &cancelBody{ReadCloser: res.Body, cancel: cancel}, nil
+}
+
+// proxy streams the upstream response for req to w using the supplied content type.
+func (s *UpstreamProxy) proxy(w http.ResponseWriter, req *http.Request, contentType string) {
+	body, err := s.fetch(req.Context(), strings.TrimPrefix(req.URL.Path, s.prefix))
+	if err != nil {
+		upstreamFailed(w, err)
+		return
+	}
+	defer func() { _ = body.Close() }()
+
+	w.Header().Set("Content-Type", contentType)
+	_, _ = io.Copy(w, body)
+}
+
+// upstreamFailed responds with the upstream status (e.g. 404/410) when available, otherwise a 502.
+func upstreamFailed(w http.ResponseWriter, err error) {
+	var ue *upstreamError
+	if errors.As(err, &ue) {
+		http.Error(w, ue.msg, ue.code)
+		return
+	}
+
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
+
+func (e *upstreamError) Error() string {
+	return fmt.Sprintf("unexpected upstream status: %d, %s", e.code, e.msg)
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,187 @@
+package goproxy_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	. "github.com/pseudomuto/pacman/internal/goproxy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamProxy_Chain(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	upstream := func(fn http.HandlerFunc) Upstream {
+		svr := httptest.NewServer(fn)
+		t.Cleanup(svr.Close)
+
+		u, err := url.Parse(svr.URL)
+		require.NoError(t, err)
+		return Upstream{URL: u}
+	}
+
+	// athens only knows about example.com/private and requires basic auth.
+	athens := upstream(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "ci" || pass != "secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path != "/example.com/private/@v/list" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprint(w, "v1.0.0\n")
+	})
+	athens.Username = "ci"
+	athens.Password = crypto.Secret("secret")
+
+	broken := upstream(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "oops", http.StatusInternalServerError)
+	})
+
+	slow := upstream(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	slow.Timeout = 10 * time.Millisecond
+
+	public := upstream(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		fmt.Fprint(w, "v2.0.0\n")
+	})
+	public.Password = crypto.Secret("token")
+
+	fallback := func(up Upstream) Upstream {
+		up.FallbackOnError = true
+		return up
+	}
+
+	tests := []struct {
+		name  string
+		chain []Upstream
+		url   string
+		code  int
+		body  string
+	}{
+		{
+			name:  "first upstream",
+			chain: []Upstream{athens, public},
+			url:   "example.com/private/@v/list",
+			code:  http.StatusOK,
+			body:  "v1.0.0\n",
+		},
+		{
+			name:  "not found falls back",
+			chain: []Upstream{athens, public},
+			url:   "example.com/public/@v/list",
+			code:  http.StatusOK,
+			body:  "v2.0.0\n",
+		},
+		{
+			name:  "error stops",
+			chain: []Upstream{broken, public},
+			url:   "example.com/public/@v/list",
+			code:  http.StatusInternalServerError,
+		},
+		{
+			name:  "error falls back",
+			chain: []Upstream{fallback(broken), public},
+			url:   "example.com/public/@v/list",
+			code:  http.StatusOK,
+			body:  "v2.0.0\n",
+		},
+		{
+			name:  "timeout stops",
+			chain: []Upstream{slow, public},
+			url:   "example.com/public/@v/list",
+			code:  http.StatusBadGateway,
+		},
+		{
+			name:  "timeout falls back",
+			chain: []Upstream{fallback(slow), public},
+			url:   "example.com/public/@v/v2.0.0.mod",
+			code:  http.StatusOK,
+			body:  "v2.0.0\n",
+		},
+		{
+			name:  "last error wins",
+			chain: []Upstream{athens},
+			url:   "example.com/public/@latest",
+			code:  http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			up := NewUpstreamProxy(client, nil, WithUpstreams(tt.chain...))
+
+			w := get(t, up, tt.url)
+			require.Equal(t, tt.code, w.Code)
+
+			if tt.body != "" {
+				require.Equal(t, tt.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestParseUpstreams(t *testing.T) {
+	t.Parallel()
+
+	pw, err := crypto.Secret("secret").Value()
+	require.NoError(t, err)
+
+	ups, err := ParseUpstreams(&config.Config{
+		Go: config.Go{
+			Upstreams: []config.Upstream{
+				{
+					URL:             "https://athens.example.com",
+					Timeout:         time.Second,
+					FallbackOnError: true,
+					Username:        "ci",
+					Password:        pw.(string),
+				},
+				{URL: "https://proxy.golang.org"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, ups, 2)
+
+	require.Equal(t, "https://athens.example.com", ups[0].URL.String())
+	require.Equal(t, time.Second, ups[0].Timeout)
+	require.True(t, ups[0].FallbackOnError)
+	require.Equal(t, "ci", ups[0].Username)
+	require.Equal(t, crypto.Secret("secret"), ups[0].Password)
+
+	require.Equal(t, "https://proxy.golang.org", ups[1].URL.String())
+	require.Empty(t, ups[1].Password)
+
+	t.Run("errors", func(t *testing.T) {
+		for _, up := range []config.Upstream{
+			{URL: "direct"},
+			{URL: "https://proxy.example.com", Password: "not encrypted"},
+		} {
+			_, err := ParseUpstreams(&config.Config{Go: config.Go{Upstreams: []config.Upstream{up}}})
+			require.Error(t, err)
+		}
+	})
+}
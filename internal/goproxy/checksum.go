@@ -0,0 +1,195 @@
+package goproxy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+const (
+	// DefaultChecksumDB is the checksum database used by the go command when GOSUMDB isn't set.
+	DefaultChecksumDB = "sum.golang.org"
+
+	// sumGolangOrgKey is the verifier key for sum.golang.org, which (like the go command) is used when GOSUMDB only
+	// names it.
+	sumGolangOrgKey = "sum.golang.org+033de0ae+Ac4zctda0e5eza+HJyk9SxEdh+s3Ne6pPm0xH3+7aK1y"
+
+	// checksumTimeout bounds each request to the checksum database.
+	checksumTimeout = 30 * time.Second
+)
+
+// ErrChecksumMismatch is returned when an upstream module doesn't match the checksum database.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+type (
+	// ChecksumDB looks up the go.sum lines for a module version in a checksum database (i.e. GOSUMDB).
+	//
+	// NB: This is satisfied by sumdb.Client.
+	ChecksumDB interface {
+		Lookup(path, version string) ([]string, error)
+	}
+
+	// checksumOps implements sumdb.ClientOps, keeping the latest signed tree head in memory. Tiles and records are
+	// cached by the sumdb.Client itself.
+	checksumOps struct {
+		name   string
+		key    string
+		url    string
+		client *http.Client
+
+		mu     sync.Mutex
+		latest []byte
+	}
+)
+
+// WithChecksumDB verifies the assets fetched from upstream against db before they're cached (see WithCache).
+func WithChecksumDB(db ChecksumDB) UpstreamOption {
+	return func(up *UpstreamProxy) { up.sums = db }
+}
+
+// NewChecksumDB returns a ChecksumDB for gosumdb, using the same syntax as GOSUMDB. That is, the name of the database
+// (only sum.golang.org is known), or its verifier key optionally followed by its URL. When the URL is omitted, it's
+// https://<name>. When gosumdb is off, nil is returned.
+func NewChecksumDB(gosumdb string) (ChecksumDB, error) {
+	fields := strings.Fields(gosumdb)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("invalid checksum database: %q", gosumdb)
+	}
+
+	key := fields[0]
+	switch key {
+	case "off":
+		return nil, nil
+	case DefaultChecksumDB:
+		key = sumGolangOrgKey
+	}
+
+	name, _, ok := strings.Cut(key, "+")
+	if !ok {
+		return nil, fmt.Errorf("unknown checksum database: %s", key)
+	}
+
+	url := "https://" + name
+	if len(fields) == 2 {
+		url = strings.TrimSuffix(fields[1], "/")
+	}
+
+	return sumdb.NewClient(&checksumOps{name: name, key: key, url: url, client: http.DefaultClient}), nil
+}
+
+// verify checks the asset for mod (the go.mod or zip, given by ext) stored in file against the checksum database.
+func (s *UpstreamProxy) verify(mod module.Version, ext string, file string) error {
+	if s.sums == nil {
+		return nil
+	}
+
+	// NB: The go.mod is recorded as a separate line, looked up as <version>/go.mod.
+	version := mod.Version
+	var (
+		sum string
+		err error
+	)
+	if ext == ".mod" {
+		version += "/go.mod"
+		sum, err = dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+			return os.Open(file) //nolint:gosec // file is our temp file
+		})
+	} else {
+		sum, err = dirhash.HashZip(file, dirhash.Hash1)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to hash upstream asset: %s%s, %w", mod, ext, err)
+	}
+
+	lines, err := s.sums.Lookup(mod.Path, version)
+	if err != nil {
+		return fmt.Errorf("failed to look up checksums: %s%s, %w", mod, ext, err)
+	}
+
+	if !slices.Contains(lines, mod.Path+" "+version+" "+sum) {
+		return fmt.Errorf("%w: %s%s, %s", ErrChecksumMismatch, mod, ext, sum)
+	}
+
+	return nil
+}
+
+func (o *checksumOps) ReadRemote(path string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), checksumTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.url+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating checksum database request: %s, %w", path, err)
+	}
+
+	res, err := o.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching from checksum database: %s, %w", o.name, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected checksum database status: %s, %s, %d", o.name, path, res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+func (o *checksumOps) ReadConfig(file string) ([]byte, error) {
+	switch file {
+	case "key":
+		return []byte(o.key), nil
+	case o.name + "/latest":
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		return bytes.Clone(o.latest), nil
+	default:
+		return nil, fmt.Errorf("unknown checksum database config: %s", file)
+	}
+}
+
+func (o *checksumOps) WriteConfig(file string, old, updated []byte) error {
+	if file != o.name+"/latest" {
+		return fmt.Errorf("unknown checksum database config: %s", file)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !bytes.Equal(o.latest, old) {
+		return sumdb.ErrWriteConflict
+	}
+
+	o.latest = bytes.Clone(updated)
+	return nil
+}
+
+func (*checksumOps) ReadCache(string) ([]byte, error) {
+	return nil, fs.ErrNotExist
+}
+
+func (*checksumOps) WriteCache(string, []byte) {}
+
+func (o *checksumOps) Log(msg string) {
+	slog.Debug(msg, "checksumdb", o.name)
+}
+
+func (o *checksumOps) SecurityError(msg string) {
+	slog.Error(msg, "checksumdb", o.name)
+}
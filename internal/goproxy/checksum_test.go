@@ -0,0 +1,168 @@
+package goproxy_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	. "github.com/pseudomuto/pacman/internal/goproxy"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/dirhash"
+	"golang.org/x/mod/sumdb/note"
+)
+
+func TestUpstreamProxy_ChecksumDB(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	goMod := "module example.com/mod\n"
+	good := modZip(t, "example.com/mod@v1.0.0", goMod)
+	bad := modZip(t, "example.com/mod@v1.0.1", "module example.com/evil\n")
+
+	// NB: v1.0.1 is recorded with the same files as v1.0.0, so the zip served upstream doesn't match.
+	db := newChecksumDB(t, func(path, version string) ([]byte, error) {
+		if path != "example.com/mod" {
+			return nil, os.ErrNotExist
+		}
+
+		return fmt.Appendf(
+			nil,
+			"%s %s %s\n%s %s/go.mod %s\n",
+			path, version, zipHash(t, modZip(t, path+"@"+version, goMod)),
+			path, version, modHash(t, goMod),
+		), nil
+	})
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/example.com/mod/@v/v1.0.0.mod", "/example.com/mod/@v/v1.0.1.mod", "/example.com/other/@v/v1.0.0.mod":
+			fmt.Fprint(w, goMod)
+		case "/example.com/mod/@v/v1.0.0.zip":
+			_, _ = w.Write(good)
+		case "/example.com/mod/@v/v1.0.1.zip":
+			_, _ = w.Write(bad)
+		default:
+			http.Error(w, "not found: "+r.URL.Path, http.StatusGone)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	bucket := &memBucket{objects: make(map[string][]byte)}
+	up := NewUpstreamProxyWithHost(client, bucket, svr.URL, WithCache(bucket), WithChecksumDB(db))
+
+	w := get(t, up, "example.com/mod/@v/v1.0.0.mod")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, goMod, w.Body.String())
+
+	w = get(t, up, "example.com/mod/@v/v1.0.0.zip")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, good, w.Body.Bytes())
+
+	arch := client.Archive.Query().Where(archive.Coordinate("example.com/mod@v1.0.0")).OnlyX(t.Context())
+	require.True(t, arch.Cached)
+	require.Len(t, arch.Assets, 2)
+
+	w = get(t, up, "example.com/mod/@v/v1.0.1.mod")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Mismatched assets are neither served nor cached.
+	w = get(t, up, "example.com/mod/@v/v1.0.1.zip")
+	require.Equal(t, http.StatusBadGateway, w.Code)
+	require.Contains(t, w.Body.String(), ErrChecksumMismatch.Error())
+	require.NotContains(t, bucket.objects, "mem://cache/gomod/example.com/mod/@v/v1.0.1.zip")
+
+	arch = client.Archive.Query().Where(archive.Coordinate("example.com/mod@v1.0.1")).OnlyX(t.Context())
+	require.Len(t, arch.Assets, 1)
+
+	// Modules unknown to the checksum database can't be verified.
+	w = get(t, up, "example.com/other/@v/v1.0.0.mod")
+	require.Equal(t, http.StatusBadGateway, w.Code)
+	require.False(t, client.Archive.Query().Where(archive.Coordinate("example.com/other@v1.0.0")).ExistX(t.Context()))
+}
+
+func TestNewChecksumDB(t *testing.T) {
+	t.Parallel()
+
+	db, err := NewChecksumDB("off")
+	require.NoError(t, err)
+	require.Nil(t, db)
+
+	db, err = NewChecksumDB(DefaultChecksumDB)
+	require.NoError(t, err)
+	require.NotNil(t, db)
+
+	_, vkey, err := note.GenerateKey(rand.Reader, "sum.example.com")
+	require.NoError(t, err)
+
+	db, err = NewChecksumDB(vkey + " https://sum.example.com/sumdb/")
+	require.NoError(t, err)
+	require.NotNil(t, db)
+
+	for _, gosumdb := range []string{"", "sum.example.com", "a b c"} {
+		_, err = NewChecksumDB(gosumdb)
+		require.Error(t, err, gosumdb)
+	}
+}
+
+// newChecksumDB returns a ChecksumDB backed by a sumdb test server, which records the go.sum lines returned by gosum.
+func newChecksumDB(t *testing.T, gosum func(path, version string) ([]byte, error)) ChecksumDB {
+	t.Helper()
+
+	skey, vkey, err := note.GenerateKey(rand.Reader, "sum.example.com")
+	require.NoError(t, err)
+
+	svr := httptest.NewServer(sumdb.NewServer(sumdb.NewTestServer(skey, gosum)))
+	t.Cleanup(svr.Close)
+
+	db, err := NewChecksumDB(vkey + " " + svr.URL)
+	require.NoError(t, err)
+	return db
+}
+
+// modZip returns a module zip for mod (path@version) containing only its go.mod.
+func modZip(t *testing.T, mod, goMod string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	f, err := zw.Create(mod + "/go.mod")
+	require.NoError(t, err)
+	_, err = io.WriteString(f, goMod)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func zipHash(t *testing.T, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "mod.zip")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	sum, err := dirhash.HashZip(path, dirhash.Hash1)
+	require.NoError(t, err)
+	return sum
+}
+
+func modHash(t *testing.T, goMod string) string {
+	t.Helper()
+
+	sum, err := dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader([]byte(goMod))), nil
+	})
+	require.NoError(t, err)
+	return sum
+}
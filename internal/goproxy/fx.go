@@ -1,18 +1,66 @@
 package goproxy
 
 import (
+	"cmp"
+	"fmt"
 	"log/slog"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/pseudomuto/pacman/internal/storage"
+	"github.com/pseudomuto/pacman/internal/types"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Module(
 	"goproxy",
 	fx.Provide(
+		func(c *config.Config, db *ent.Client, reg *prometheus.Registry) (*UpstreamProxy, error) {
+			ups, err := ParseUpstreams(c)
+			if err != nil {
+				return nil, err
+			}
+
+			opts := []UpstreamOption{
+				WithUpstreams(ups...),
+				WithNoSumPatterns(c.Go.NoSumPatterns...),
+				WithRegistry(reg),
+			}
+
+			if c.Go.CacheBucket != "" {
+				up, err := storage.NewUploader(c.Go.CacheBucket)
+				if err != nil {
+					return nil, fmt.Errorf("invalid cache bucket: %s, %w", c.Go.CacheBucket, err)
+				}
+
+				sums, err := NewChecksumDB(cmp.Or(c.Go.ChecksumDB, DefaultChecksumDB))
+				if err != nil {
+					return nil, err
+				}
+
+				opts = append(opts, WithCache(up))
+				if sums != nil {
+					opts = append(opts, WithChecksumDB(sums))
+				}
+			}
+
+			return NewUpstreamProxy(db, ReaderFunc(storage.Read), opts...), nil
+		},
 		NewServerPool,
+		fx.Annotate(
+			func(p *ServerPool) types.Router { return p },
+			fx.ResultTags(types.FXServerRouters),
+		),
+		NewLookupCache,
+		fx.Annotate(
+			func(c *LookupCache) publisher.Invalidator { return c },
+			fx.ResultTags(publisher.FXInvalidators),
+		),
 	),
 	// NB: this is a forcing function to trigger NewServerPool.
-	fx.Invoke(func(log *slog.Logger, svrs []*Server) {
-		log.Debug("Initialized goproxy servers", "n", len(svrs))
+	fx.Invoke(func(log *slog.Logger, _ *ServerPool) {
+		log.Debug("Initialized goproxy servers")
 	}),
 )
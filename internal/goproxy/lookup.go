@@ -0,0 +1,47 @@
+package goproxy
+
+import (
+	"cmp"
+	"time"
+
+	"github.com/pseudomuto/goproxy"
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/config"
+)
+
+type (
+	// LookupCache caches Store lookups for every tree. Recorded module versions never change, so they're cached until
+	// evicted, while version lists (used for @v/list and @latest) expire after the configured TTL. They're also dropped
+	// when the module is published or retracted (see Invalidate).
+	LookupCache struct {
+		ttl      time.Duration
+		modules  *cache.Cache[moduleKey, *goproxy.ModuleVersion]
+		versions *cache.Cache[versionsKey, []*goproxy.ModuleVersion]
+	}
+
+	moduleKey struct {
+		tree    int
+		path    string
+		version string
+	}
+
+	versionsKey struct {
+		tree int
+		path string
+	}
+)
+
+// NewLookupCache creates a LookupCache using the MemoryCache config.
+func NewLookupCache(c *config.Config, m *cache.Metrics) *LookupCache {
+	mc := c.Go.MemoryCache
+	return &LookupCache{
+		ttl:      cmp.Or(mc.TTL, cache.DefaultTTL),
+		modules:  cache.New[moduleKey, *goproxy.ModuleVersion]("goproxy_modules", mc.Size, cache.WithMetrics(m)),
+		versions: cache.New[versionsKey, []*goproxy.ModuleVersion]("goproxy_versions", mc.Size, cache.WithMetrics(m)),
+	}
+}
+
+// Invalidate drops the cached version lists for the module at path. It implements publisher.Invalidator.
+func (c *LookupCache) Invalidate(path string) {
+	c.versions.DeleteFunc(func(k versionsKey) bool { return k.path == path })
+}
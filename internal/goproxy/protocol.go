@@ -0,0 +1,247 @@
+package goproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/types"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// Info is the metadata for a module version as returned by the .info and @latest endpoints.
+type Info struct {
+	Version string         `json:"Version"`
+	Time    *time.Time     `json:"Time,omitempty"`
+	Origin  *schema.Origin `json:"Origin,omitempty"`
+}
+
+// info serves $module/@v/$version.info.
+//
+// Archives which have been released (published, or cached from upstream) are served locally. Otherwise, the info is
+// requested from upstream (and recorded when caching is enabled).
+func (s *UpstreamProxy) info(w http.ResponseWriter, req *http.Request, mod module.Version) {
+	arch, err := s.findArchive(req.Context(), mod)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case arch != nil && arch.ReleasedAt != nil:
+	case s.private(mod.Path):
+		if arch == nil {
+			s.block(w, mod.String())
+			return
+		}
+	case s.cache != nil:
+		inf, err := s.pullInfo(req.Context(), mod)
+		if err != nil {
+			// NB: Keep serving what we have when the upstream is unavailable.
+			if arch == nil {
+				upstreamFailed(w, err)
+				return
+			}
+
+			break
+		}
+
+		writeJSON(w, inf)
+		return
+	case arch == nil:
+		// Fallback to proxying upstream.
+		s.proxy(w, req, "application/json; charset=utf-8")
+		return
+	}
+
+	writeJSON(w, archiveInfo(arch))
+}
+
+// list serves $module/@v/list, merging archived versions with those known upstream (unless private). Pseudo-versions
+// are excluded, but retracted versions are listed, leaving the go command to filter them.
+func (s *UpstreamProxy) list(w http.ResponseWriter, req *http.Request, modPath string) {
+	archs, err := s.findArchives(req.Context(), modPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var versions []string
+	for _, arch := range archs {
+		if v := archiveVersion(arch); !module.IsPseudoVersion(v) {
+			versions = append(versions, v)
+		}
+	}
+
+	if s.private(modPath) {
+		if len(archs) == 0 {
+			s.block(w, modPath)
+			return
+		}
+	} else {
+		upstream, err := s.fetchUpstream(req.Context(), modPath, "/@v/list")
+		switch {
+		case err == nil:
+			versions = append(versions, strings.Fields(string(upstream))...)
+		case len(archs) == 0:
+			upstreamFailed(w, err)
+			return
+		}
+	}
+
+	semver.Sort(versions)
+	versions = slices.Compact(versions)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	for _, v := range versions {
+		_, _ = fmt.Fprintln(w, v)
+	}
+}
+
+// latest serves $module/@latest, returning the newest of the archived and upstream (unless private) versions.
+// Retracted versions are skipped.
+func (s *UpstreamProxy) latest(w http.ResponseWriter, req *http.Request, modPath string) {
+	archs, err := s.findArchives(req.Context(), modPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	retracted, err := s.db.Retraction.Query().
+		Where(retraction.Path(modPath)).
+		Select(retraction.FieldVersion).
+		Strings(req.Context())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to find retracted versions: %s, %s", modPath, err), http.StatusInternalServerError)
+		return
+	}
+
+	var best *Info
+	for _, arch := range archs {
+		if slices.Contains(retracted, archiveVersion(arch)) {
+			continue
+		}
+
+		if inf := archiveInfo(arch); newer(inf, best) {
+			best = inf
+		}
+	}
+
+	if s.private(modPath) {
+		if best == nil {
+			s.block(w, modPath)
+			return
+		}
+	} else {
+		upstream, err := s.fetchUpstream(req.Context(), modPath, "/@latest")
+		if err != nil && best == nil {
+			upstreamFailed(w, err)
+			return
+		}
+
+		var inf Info
+		if err == nil && json.Unmarshal(upstream, &inf) == nil && newer(&inf, best) {
+			best = &inf
+		}
+	}
+
+	writeJSON(w, best)
+}
+
+// findArchives returns the Archives for the module at modPath, including retracted versions.
+func (s *UpstreamProxy) findArchives(ctx context.Context, modPath string) ([]*ent.Archive, error) {
+	archs, err := s.db.Archive.Query().
+		Where(
+			archive.TypeEQ(types.GoModule),
+			archive.CoordinateHasPrefix(modPath+"@"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archives: %s, %w", modPath, err)
+	}
+
+	return archs, nil
+}
+
+// fetchUpstream fetches the contents of route (e.g. /@latest) for modPath from upstream.
+func (s *UpstreamProxy) fetchUpstream(ctx context.Context, modPath, route string) ([]byte, error) {
+	path, err := module.EscapePath(modPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path: %s, %w", modPath, err)
+	}
+
+	body, err := s.fetch(ctx, path+route)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading from upstream: %s%s, %w", modPath, route, err)
+	}
+
+	return data, nil
+}
+
+// archiveInfo returns the Info for arch. When the release time is unknown, the time it was archived is used.
+func archiveInfo(arch *ent.Archive) *Info {
+	t := arch.CreatedAt
+	if arch.ReleasedAt != nil {
+		t = *arch.ReleasedAt
+	}
+
+	return &Info{
+		Version: archiveVersion(arch),
+		Time:    &t,
+		Origin:  arch.Origin,
+	}
+}
+
+// archiveVersion returns the version from the arch's coordinate (path@version).
+func archiveVersion(arch *ent.Archive) string {
+	_, v, _ := strings.Cut(arch.Coordinate, "@")
+	return v
+}
+
+// newer reports whether a should be preferred over b as the latest version. Releases are preferred over pre-releases,
+// which are preferred over pseudo-versions. Within the same class, the highest semver wins.
+func newer(a, b *Info) bool {
+	if b == nil {
+		return true
+	}
+
+	if ra, rb := versionRank(a.Version), versionRank(b.Version); ra != rb {
+		return ra > rb
+	}
+
+	return semver.Compare(a.Version, b.Version) > 0
+}
+
+func versionRank(v string) int {
+	switch {
+	case module.IsPseudoVersion(v):
+		return 0
+	case semver.Prerelease(v) != "":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, "failed writing response: "+err.Error(), http.StatusInternalServerError)
+	}
+}
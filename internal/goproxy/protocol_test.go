@@ -0,0 +1,416 @@
+package goproxy_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pacarchive "github.com/pseudomuto/pacman/internal/archive"
+	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	. "github.com/pseudomuto/pacman/internal/goproxy"
+	"github.com/pseudomuto/pacman/internal/packager"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/pseudomuto/pacman/internal/storage"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+func TestUpstreamProxy_Protocol(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	released := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	origin := &schema.Origin{VCS: "git", URL: "https://github.com/example/mod", Ref: "v1.0.0"}
+	for _, coord := range []string{
+		"example.com/mod@v1.0.0",
+		"example.com/mod@v1.1.0-rc.1",
+		"example.com/mod@v1.1.1-0.20250101000000-abcdefabcdef",
+		"git.corp.example.com/private@v0.1.0",
+		"git.corp.example.com/private@v0.1.1",
+	} {
+		client.Archive.Create().
+			SetType(types.GoModule).
+			SetCoordinate(coord).
+			SetAssets([]schema.AssetURL{}).
+			SetReleasedAt(released).
+			SetOrigin(origin).
+			SaveX(t.Context())
+	}
+
+	// Retracted versions are listed (the go command filters them), but skipped by @latest.
+	client.Retraction.Create().SetPath("git.corp.example.com/private").SetVersion("v0.1.1").SaveX(t.Context())
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/example.com/mod/@v/list":
+			_, _ = w.Write([]byte("v0.9.0\nv1.0.0\n"))
+		case "/example.com/mod/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v0.9.0","Time":"2024-01-01T00:00:00Z"}`))
+		case "/example.com/upstream/@v/list":
+			_, _ = w.Write([]byte("v2.0.0\nv1.0.0\n"))
+		case "/example.com/upstream/@latest", "/example.com/upstream/@v/v2.0.0.info":
+			_, _ = w.Write([]byte(`{"Version":"v2.0.0","Time":"2024-06-01T00:00:00Z","Origin":{"VCS":"git","Hash":"abc"}}`))
+		case "/example.com/upstream/@v/main.info":
+			_, _ = w.Write([]byte(`{"Version":"v2.0.1-0.20240602000000-abcdefabcdef","Time":"2024-06-02T00:00:00Z"}`))
+		default:
+			http.Error(w, "not found", http.StatusGone)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	tests := []struct {
+		name  string
+		url   string
+		code  int
+		cType string
+		body  string
+		info  *Info
+	}{
+		{
+			name:  "list merged",
+			url:   "example.com/mod/@v/list",
+			code:  http.StatusOK,
+			cType: "text/plain; charset=utf-8",
+			body:  "v0.9.0\nv1.0.0\nv1.1.0-rc.1\n",
+		},
+		{
+			name: "list upstream",
+			url:  "example.com/upstream/@v/list",
+			code: http.StatusOK,
+			body: "v1.0.0\nv2.0.0\n",
+		},
+		{
+			name: "list private",
+			url:  "git.corp.example.com/private/@v/list",
+			code: http.StatusOK,
+			body: "v0.1.0\nv0.1.1\n",
+		},
+		{
+			name: "list unknown private",
+			url:  "git.corp.example.com/unknown/@v/list",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "list unknown",
+			url:  "example.com/unknown/@v/list",
+			code: http.StatusGone,
+		},
+		{
+			name:  "latest archived",
+			url:   "example.com/mod/@latest",
+			code:  http.StatusOK,
+			cType: "application/json; charset=utf-8",
+			info:  &Info{Version: "v1.0.0", Time: &released, Origin: origin},
+		},
+		{
+			name: "latest upstream",
+			url:  "example.com/upstream/@latest",
+			code: http.StatusOK,
+			info: &Info{
+				Version: "v2.0.0",
+				Time:    ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				Origin:  &schema.Origin{VCS: "git", Hash: "abc"},
+			},
+		},
+		{
+			name: "latest private",
+			url:  "git.corp.example.com/private/@latest",
+			code: http.StatusOK,
+			info: &Info{Version: "v0.1.0", Time: &released, Origin: origin},
+		},
+		{
+			name: "latest unknown",
+			url:  "example.com/unknown/@latest",
+			code: http.StatusGone,
+		},
+		{
+			name:  "info archived",
+			url:   "example.com/mod/@v/v1.1.1-0.20250101000000-abcdefabcdef.info",
+			code:  http.StatusOK,
+			cType: "application/json; charset=utf-8",
+			info:  &Info{Version: "v1.1.1-0.20250101000000-abcdefabcdef", Time: &released, Origin: origin},
+		},
+		{
+			name: "info upstream",
+			url:  "example.com/upstream/@v/v2.0.0.info",
+			code: http.StatusOK,
+			info: &Info{
+				Version: "v2.0.0",
+				Time:    ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				Origin:  &schema.Origin{VCS: "git", Hash: "abc"},
+			},
+		},
+		{
+			name: "info unknown private",
+			url:  "git.corp.example.com/private/@v/v0.2.0.info",
+			code: http.StatusNotFound,
+		},
+	}
+
+	up := NewUpstreamProxyWithHost(client, nil, svr.URL, WithNoSumPatterns("*.corp.example.com"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := get(t, up, tt.url)
+			require.Equal(t, tt.code, w.Code)
+
+			if tt.cType != "" {
+				require.Equal(t, tt.cType, w.Header().Get("Content-Type"))
+			}
+
+			if tt.body != "" {
+				require.Equal(t, tt.body, w.Body.String())
+			}
+
+			if tt.info != nil {
+				var inf Info
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &inf))
+				require.Equal(t, tt.info.Version, inf.Version)
+				require.True(t, tt.info.Time.Equal(*inf.Time))
+				require.Equal(t, tt.info.Origin, inf.Origin)
+			}
+		})
+	}
+
+	t.Run("cached info", func(t *testing.T) {
+		cached := NewUpstreamProxyWithHost(
+			client,
+			nil,
+			svr.URL,
+			WithCache(&memBucket{objects: make(map[string][]byte)}),
+		)
+
+		w := get(t, cached, "example.com/upstream/@v/v2.0.0.info")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		arch := client.Archive.Query().Where(archive.Coordinate("example.com/upstream@v2.0.0")).OnlyX(t.Context())
+		require.True(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Equal(*arch.ReleasedAt))
+		require.Equal(t, &schema.Origin{VCS: "git", Hash: "abc"}, arch.Origin)
+
+		// Queries resolve to other versions and aren't recorded.
+		w = get(t, cached, "example.com/upstream/@v/main.info")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), "v2.0.1-0.20240602000000-abcdefabcdef")
+		require.False(t, client.Archive.Query().
+			Where(archive.CoordinateHasPrefix("example.com/upstream@v2.0.1")).
+			ExistX(t.Context()))
+	})
+
+	t.Run("upstream unavailable", func(t *testing.T) {
+		svr.Close()
+
+		w := get(t, up, "example.com/mod/@v/list")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "v1.0.0\nv1.1.0-rc.1\n", w.Body.String())
+
+		w = get(t, up, "example.com/upstream/@latest")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"Version":"v2.0.0"`)
+
+		w = get(t, up, "example.com/unknown/@latest")
+		require.Equal(t, http.StatusBadGateway, w.Code)
+	})
+}
+
+func get(t *testing.T, up *UpstreamProxy, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	up.ServeHTTP(w, httptest.NewRequestWithContext(
+		t.Context(),
+		http.MethodGet,
+		"/goproxy/proxy.golang.org/"+path,
+		nil,
+	))
+	return w
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestUpstreamProxy_Retractions(t *testing.T) {
+	// NB: Not parallel, since storage buckets are registered globally.
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	bucket := "file://" + t.TempDir()
+	require.NoError(t, storage.RegisterBuckets(t.Context(), bucket))
+	uploader, err := storage.NewUploader(bucket)
+	require.NoError(t, err)
+
+	pub := publisher.New(publisher.PublisherParams{
+		DB:          client,
+		Packagers:   []publisher.Packager{packager.NewGoModule()},
+		Uploaders:   []publisher.Uploader{uploader},
+		VCSFetchers: []publisher.VCSFetcher{dirFetcher("../../testdata/gomodule")},
+	})
+
+	const mod = "testdata.io/gomodule"
+	for _, v := range []string{"v1.0.0", "v1.1.0"} {
+		_, err := pub.Publish(t.Context(), publisher.PublishOptions{
+			Type:    types.GoModule,
+			Storage: types.FileSystem,
+			VCS:     types.Git,
+			Repo:    "test/repo",
+			Ref:     v,
+			Package: mod,
+			Version: v,
+		})
+		require.NoError(t, err)
+	}
+
+	up := NewUpstreamProxyWithHost(client, ReaderFunc(storage.Read), "http://upstream.invalid",
+		WithNoSumPatterns("testdata.io"))
+
+	state := goList(t, up, mod)
+	require.Equal(t, "v1.1.0", state.latest)
+	require.Empty(t, state.retracted)
+	require.Empty(t, state.deprecated)
+
+	// Retracting publishes the next patch version, which declares the retraction.
+	require.NoError(t, pub.Retract(t.Context(), mod, []string{"v1.0.0"}, "Broken build."))
+	state = goList(t, up, mod)
+	require.Equal(t, "v1.1.1", state.latest)
+	require.Equal(t, map[string]string{"v1.0.0": "Broken build."}, state.retracted)
+	require.Empty(t, state.deprecated)
+
+	// The zip has the same contents, with the go.mod as served.
+	w := get(t, up, mod+"/@v/v1.1.1.zip")
+	require.Equal(t, http.StatusOK, w.Code)
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if f.Name == mod+"@v1.1.1/go.mod" {
+			r, err := f.Open()
+			require.NoError(t, err)
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, get(t, up, mod+"/@v/v1.1.1.mod").Body.String(), string(data))
+		}
+	}
+	require.Contains(t, names, mod+"@v1.1.1/go.mod")
+	require.Contains(t, names, mod+"@v1.1.1/pkg/info/info.go")
+
+	require.NoError(t, pub.Deprecate(t.Context(), mod, "use testdata.io/other instead."))
+	state = goList(t, up, mod)
+	require.Equal(t, "v1.1.2", state.latest)
+	require.Equal(t, map[string]string{"v1.0.0": "Broken build."}, state.retracted)
+	require.Equal(t, "use testdata.io/other instead.", state.deprecated)
+
+	// Retracting the latest version publishes a retraction-only version, which retracts itself.
+	require.NoError(t, pub.Retract(t.Context(), mod, []string{"v1.1.2"}, "Published accidentally."))
+	state = goList(t, up, mod)
+	require.Equal(t, "v1.1.1", state.latest)
+	require.Equal(t, map[string]string{
+		"v1.0.0": "Broken build.",
+		"v1.1.2": "Published accidentally.",
+		"v1.1.3": "Contains retractions only.",
+	}, state.retracted)
+	require.Equal(t, "use testdata.io/other instead.", state.deprecated)
+
+	require.NoError(t, pub.Deprecate(t.Context(), mod, ""))
+	state = goList(t, up, mod)
+	require.Equal(t, "v1.1.1", state.latest)
+	require.Len(t, state.retracted, 4)
+	require.Equal(t, "Contains retractions only.", state.retracted["v1.1.4"])
+	require.Empty(t, state.deprecated)
+
+	// Nothing changed, so nothing is published.
+	require.NoError(t, pub.Retract(t.Context(), mod, []string{"v1.0.0"}, "Broken build."))
+	require.NoError(t, pub.Deprecate(t.Context(), mod, ""))
+	require.Equal(t, state, goList(t, up, mod))
+}
+
+// moduleState is what go list -m -u -retracted reports for a module.
+type moduleState struct {
+	latest     string
+	versions   []string
+	retracted  map[string]string
+	deprecated string
+}
+
+// goList resolves the state of the module at path like the go command does. That is, @latest is resolved by the
+// proxy, while retractions and the deprecation are read from the go.mod of the latest listed version (releases first),
+// whether or not it's retracted.
+func goList(t *testing.T, up *UpstreamProxy, path string) moduleState {
+	t.Helper()
+
+	w := get(t, up, path+"/@latest")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var inf Info
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &inf))
+
+	w = get(t, up, path+"/@v/list")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	state := moduleState{latest: inf.Version, versions: strings.Fields(w.Body.String())}
+	highest := state.versions[0]
+	for _, v := range state.versions {
+		if (semver.Prerelease(highest) != "") != (semver.Prerelease(v) != "") {
+			if semver.Prerelease(v) == "" {
+				highest = v
+			}
+
+			continue
+		}
+
+		highest = semver.Max(highest, v)
+	}
+
+	w = get(t, up, path+"/@v/"+highest+".mod")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	f, err := modfile.ParseLax(path+"@"+highest+"/go.mod", w.Body.Bytes(), nil)
+	require.NoError(t, err)
+
+	state.deprecated = f.Module.Deprecated
+	for _, v := range state.versions {
+		for _, r := range f.Retract {
+			if semver.Compare(r.Low, v) <= 0 && semver.Compare(v, r.High) <= 0 {
+				if state.retracted == nil {
+					state.retracted = make(map[string]string)
+				}
+
+				state.retracted[v] = r.Rationale
+			}
+		}
+	}
+
+	return state
+}
+
+// dirFetcher is a publisher.VCSFetcher serving the source in the dir for every repo and ref.
+type dirFetcher string
+
+func (d dirFetcher) Type() types.VCSType {
+	return types.Git
+}
+
+func (d dirFetcher) FetchArchive(w io.Writer, _ string, _ types.VCSOptions) error {
+	return pacarchive.Compress(w, pacarchive.TarGz, string(d), pacarchive.PrefixComponents("repo"))
+}
+
+func (d dirFetcher) FetchCommit(repo string, _ types.VCSOptions) (*types.Commit, error) {
+	return nil, errors.New("not supported: " + repo)
+}
+
+func (d dirFetcher) RepoURL(repo string) string {
+	return "https://git.example.com/" + repo
+}
@@ -1,53 +1,207 @@
 package goproxy
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
 	"github.com/gin-gonic/gin"
 	"github.com/pseudomuto/goproxy"
+	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/ent"
-	"github.com/pseudomuto/pacman/internal/storage"
-	"github.com/pseudomuto/pacman/internal/types"
-	"go.uber.org/fx"
 )
 
 type (
 	Server struct {
-		prefix string
-		store  *Store
+		prefix  string
+		store   *Store
+		handler http.Handler
+	}
+
+	// protocol is the goproxy.DefaultProtocol, except @latest is resolved like the go command would (see newer),
+	// skipping retracted versions, and responds with a 404 when there are no versions (e.g. they've all been
+	// retracted). Like upstream proxies, @v/list includes retracted versions.
+	protocol struct {
+		goproxy.DefaultProtocol
+		st *Store
 	}
 
+	// ServerPool serves every tree which hasn't been retired (see boot.Trees) at /goproxy/<tree>, along with the
+	// UpstreamProxy and VirtualServer (when configured). Servers are created when their tree is first requested, so
+	// trees created at runtime are served without registering routes, while retired trees are no longer found.
 	ServerPool struct {
-		fx.Out
+		db    *ent.Client
+		up    *UpstreamProxy
+		virt  *VirtualServer
+		trees *boot.Trees
+		opts  []StoreOption
 
-		Routers []types.Router `group:"server_routers,flatten"`
-		Servers []*Server
+		mu      sync.Mutex
+		servers map[int]*Server
 	}
 )
 
-func NewServerPool(db *ent.Client, trees []*ent.SumDBTree) (ServerPool, error) {
-	var pool ServerPool
-	pool.Routers = make([]types.Router, len(trees)+1)
-	pool.Servers = make([]*Server, len(trees))
+// NewServerPool creates the ServerPool for trees, along with the VirtualServer (when configured).
+func NewServerPool(
+	c *config.Config,
+	db *ent.Client,
+	up *UpstreamProxy,
+	trees *boot.Trees,
+	lc *LookupCache,
+) (*ServerPool, error) {
+	pool := &ServerPool{
+		db:      db,
+		up:      up,
+		trees:   trees,
+		opts:    []StoreOption{WithLookupCache(lc)},
+		servers: make(map[int]*Server),
+	}
+
+	if c.Go.Virtual != nil {
+		all, err := trees.List(context.Background())
+		if err != nil {
+			return nil, err
+		}
 
-	up := NewUpstreamProxy(db, ReaderFunc(storage.Read))
-	pool.Routers[0] = up
+		names := make([]string, len(all))
+		for i := range all {
+			names[i] = all[i].Name
+		}
 
-	for i := range trees {
-		svr := NewServer(db, trees[i])
-		pool.Routers[i+1] = svr
-		pool.Servers[i] = svr
+		if pool.virt, err = NewVirtualServer(c.Go.Virtual, up, names, pool.Stores); err != nil {
+			return nil, err
+		}
 	}
 
 	return pool, nil
 }
 
-func NewServer(db *ent.Client, t *ent.SumDBTree) *Server {
-	return &Server{
+// Server returns the Server for the named tree, or nil when the tree isn't served.
+func (p *ServerPool) Server(ctx context.Context, name string) (*Server, error) {
+	tree, ok, err := p.trees.Find(ctx, name)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	svr, ok := p.servers[tree.ID]
+	if !ok {
+		svr = NewServer(p.db, tree, p.up.rdr, p.opts...)
+		p.servers[tree.ID] = svr
+	}
+
+	return svr, nil
+}
+
+// Stores implements StoreFunc, returning the Stores of the named trees (or all trees when no names are given).
+func (p *ServerPool) Stores(ctx context.Context, names ...string) ([]*Store, error) {
+	trees, err := p.trees.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		names = make([]string, len(trees))
+		for i := range trees {
+			names[i] = trees[i].Name
+		}
+	}
+
+	var stores []*Store
+	for _, name := range names {
+		// NB: Routes may refer to trees which have since been retired.
+		if !slices.ContainsFunc(trees, func(t *ent.SumDBTree) bool { return t.Name == name }) {
+			continue
+		}
+
+		svr, err := p.Server(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if svr != nil {
+			stores = append(stores, svr.store)
+		}
+	}
+
+	return stores, nil
+}
+
+func (p *ServerPool) RegisterRoutes(g *gin.Engine) {
+	g.GET("/goproxy/:name/*action", p.serve)
+}
+
+func (p *ServerPool) serve(ctx *gin.Context) {
+	name := ctx.Param("name")
+	switch {
+	case "/goproxy/"+name == p.up.prefix:
+		p.up.ServeHTTP(ctx.Writer, ctx.Request)
+	case p.virt != nil && "/goproxy/"+name == p.virt.prefix:
+		p.virt.ServeHTTP(ctx.Writer, ctx.Request)
+	default:
+		svr, err := p.Server(ctx, name)
+		if err != nil {
+			http.Error(ctx.Writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if svr == nil {
+			http.Error(ctx.Writer, "unknown tree: "+name, http.StatusNotFound)
+			return
+		}
+
+		svr.ServeHTTP(ctx.Writer, ctx.Request)
+	}
+}
+
+func NewServer(db *ent.Client, t *ent.SumDBTree, rdr Reader, opts ...StoreOption) *Server {
+	svr := &Server{
 		prefix: "/goproxy/" + t.Name,
-		store:  NewStore(db, t.ID, nil),
+		store:  NewStore(db, t.ID, rdr, opts...),
 	}
+
+	svr.handler = goproxy.NewServer(svr.store, goproxy.WithPathPrefix(svr.prefix), goproxy.WithProtocol(&protocol{st: svr.store}))
+	return svr
 }
 
 func (s *Server) RegisterRoutes(g *gin.Engine) {
-	h := goproxy.NewServer(s.store, goproxy.WithPathPrefix(s.prefix))
-	g.GET(s.prefix+"/*action", gin.WrapH(h))
+	g.GET(s.prefix+"/*action", gin.WrapH(s))
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.handler.ServeHTTP(w, req)
+}
+
+func (p *protocol) Latest(ctx context.Context, path string) (*goproxy.VersionInfo, error) {
+	mvs, err := p.st.GetVersions(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions for module: %s, %w", path, err)
+	}
+
+	retracted, err := p.st.Retracted(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *Info
+	for _, mv := range mvs {
+		if slices.Contains(retracted, mv.Version) {
+			continue
+		}
+
+		if inf := (&Info{Version: mv.Version, Time: &mv.CreatedAt}); newer(inf, best) {
+			best = inf
+		}
+	}
+
+	if best == nil {
+		return nil, goproxy.ErrModuleNotFound
+	}
+
+	return &goproxy.VersionInfo{Version: best.Version, Time: best.Time}, nil
 }
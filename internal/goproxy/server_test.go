@@ -1,29 +1,170 @@
 package goproxy_test
 
 import (
-	"strconv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
 	. "github.com/pseudomuto/pacman/internal/goproxy"
+	"github.com/pseudomuto/pacman/internal/types"
 	"github.com/stretchr/testify/require"
 )
 
-func TestNewServerPool(t *testing.T) {
-	pool, err := NewServerPool(nil, []*ent.SumDBTree{
-		{ID: 1, Name: "tree1"},
-		{ID: 2, Name: "tree2"},
-	})
+func TestServerPool(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	createTree := func(name string) *ent.SumDBTree {
+		return client.SumDBTree.Create().
+			SetName(name).
+			SetSize(0).
+			SetSignerKey(crypto.Secret("shh")).
+			SetVerifierKey("good").
+			SaveX(t.Context())
+	}
+
+	createTree("tree1")
+	createTree("tree2")
+	trees := boot.NewTrees(client, "tree2", "tree1")
+
+	pool, err := NewServerPool(&config.Config{}, client, NewUpstreamProxy(nil, nil), trees, nil)
 	require.NoError(t, err)
-	require.Len(t, pool.Servers, len(pool.Routers)-1)
 
 	engine := gin.New()
-	for i, svr := range pool.Servers {
-		svr.RegisterRoutes(engine)
+	pool.RegisterRoutes(engine)
+	require.Len(t, engine.Routes(), 1)
+	require.Equal(t, "/goproxy/:name/*action", engine.Routes()[0].Path)
 
-		route := engine.Routes()[i]
-		require.Equal(t, "/goproxy/tree"+strconv.Itoa(i+1)+"/*action", route.Path)
-		require.NotNil(t, route.Handler)
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil))
+		return w
 	}
+
+	require.Equal(t, http.StatusOK, get("/goproxy/tree1/example.com/mod/@v/list").Code)
+	require.Equal(t, http.StatusNotFound, get("/goproxy/tree3/example.com/mod/@v/list").Code)
+
+	stores, err := pool.Stores(t.Context())
+	require.NoError(t, err)
+	require.Len(t, stores, 2)
+
+	// Trees created at runtime are served without registering routes, once the trees are reloaded.
+	tree3 := createTree("tree3")
+	require.Equal(t, http.StatusNotFound, get("/goproxy/tree3/example.com/mod/@v/list").Code)
+	trees.Reload()
+	require.Equal(t, http.StatusOK, get("/goproxy/tree3/example.com/mod/@v/list").Code)
+
+	// Retired trees are no longer served.
+	client.SumDBTree.UpdateOne(tree3).SetStatus(sumdbtree.StatusRetired).ExecX(t.Context())
+	trees.Reload()
+	require.Equal(t, http.StatusNotFound, get("/goproxy/tree3/example.com/mod/@v/list").Code)
+
+	stores, err = pool.Stores(t.Context(), "tree3", "tree1")
+	require.NoError(t, err)
+	require.Len(t, stores, 1)
+
+	t.Run("virtual", func(t *testing.T) {
+		c := &config.Config{
+			Go: config.Go{
+				Virtual: &config.Virtual{
+					Name:   "all",
+					Routes: []config.Route{{Pattern: "example.com/*", Trees: []string{"tree2"}}},
+				},
+			},
+		}
+
+		_, err := NewServerPool(c, client, NewUpstreamProxy(nil, nil), trees, nil)
+		require.NoError(t, err)
+
+		for _, v := range []config.Virtual{
+			{Name: ""},
+			{Name: "tree1"},
+			{Name: "proxy.golang.org"},
+			{Name: "all", Routes: []config.Route{{Pattern: "*", Trees: []string{"unknown"}}}},
+			{Name: "all", Routes: []config.Route{{Pattern: "*", Trees: []string{"tree3"}}}},
+		} {
+			c.Go.Virtual = &v
+			_, err := NewServerPool(c, client, NewUpstreamProxy(nil, nil), trees, nil)
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestServer_Retracted(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := client.SumDBTree.Create().
+		SetName("test.sumdb.com").
+		SetSize(0).
+		SetSignerKey(crypto.Secret("shh")).
+		SetVerifierKey("good").
+		SaveX(t.Context())
+
+	for i, v := range []string{"v1.0.0", "v1.1.0", "v1.2.0-rc.1", "v2.0.0+incompatible"} {
+		assets := client.Asset.CreateBulk(
+			client.Asset.Create().SetType(types.TextFile).SetURI(v+".mod"),
+			client.Asset.Create().SetType(types.Archive).SetURI(v+".zip"),
+		).SaveX(t.Context())
+
+		client.SumDBRecord.Create().
+			AddAssets(assets...).
+			SetTree(tree).
+			SetRecordID(int64(i)).
+			SetPath("example.com/mod").
+			SetVersion(v).
+			SetData([]byte("data")).
+			SaveX(t.Context())
+	}
+
+	engine := gin.New()
+	NewServer(client, tree, nil).RegisterRoutes(engine)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/goproxy/test.sumdb.com/"+path, nil)
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	latest := func() string {
+		w := get("example.com/mod/@latest")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var inf Info
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &inf))
+		return inf.Version
+	}
+
+	require.Equal(t, "v2.0.0+incompatible", latest())
+
+	retract := func(versions ...string) {
+		for _, v := range versions {
+			client.Retraction.Create().SetPath("example.com/mod").SetVersion(v).SaveX(t.Context())
+		}
+	}
+
+	// Like the go command, releases are preferred over pre-releases.
+	retract("v2.0.0+incompatible")
+	require.Equal(t, "v1.1.0", latest())
+	require.Equal(t, "v1.0.0\nv1.1.0\nv1.2.0-rc.1\nv2.0.0+incompatible", get("example.com/mod/@v/list").Body.String())
+
+	retract("v1.0.0", "v1.1.0")
+	require.Equal(t, "v1.2.0-rc.1", latest())
+
+	retract("v1.2.0-rc.1")
+	require.Equal(t, http.StatusNotFound, get("example.com/mod/@latest").Code)
+	require.Equal(t, http.StatusOK, get("example.com/mod/@v/v1.0.0.info").Code)
 }
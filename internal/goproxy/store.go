@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/pseudomuto/goproxy"
 	"github.com/pseudomuto/pacman/internal/ent"
-	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
 	"github.com/pseudomuto/pacman/internal/types"
@@ -15,11 +16,15 @@ import (
 
 type (
 	Store struct {
-		id  int
-		db  *ent.Client
-		rdr Reader
+		id    int
+		db    *ent.Client
+		rdr   Reader
+		cache *LookupCache
 	}
 
+	// StoreOption configures a Store.
+	StoreOption func(*Store)
+
 	Reader interface {
 		Read(context.Context, io.Writer, string) error
 	}
@@ -29,19 +34,41 @@ type (
 	}
 )
 
-func NewStore(db *ent.Client, treeID int, r Reader) *Store {
-	return &Store{
-		db:  db,
-		id:  treeID,
-		rdr: r,
+// WithLookupCache caches the Store's lookups in c. A nil cache is ignored.
+func WithLookupCache(c *LookupCache) StoreOption {
+	return func(s *Store) {
+		if c != nil {
+			s.cache = c
+		}
+	}
+}
+
+func NewStore(db *ent.Client, treeID int, r Reader, opts ...StoreOption) *Store {
+	s := &Store{
+		db:    db,
+		id:    treeID,
+		rdr:   r,
+		cache: new(LookupCache),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 func ReaderFunc(fn func(context.Context, io.Writer, string) error) Reader {
 	return &reader{fn: fn}
 }
 
+// Get returns path@version, along with the URIs of its go.mod and zip.
 func (s *Store) Get(ctx context.Context, path, version string) (*goproxy.ModuleVersion, error) {
+	key := moduleKey{tree: s.id, path: path, version: version}
+	if mv, ok := s.cache.modules.Get(key); ok {
+		return mv, nil
+	}
+
 	rec, err := s.db.SumDBRecord.Query().
 		Where(
 			sumdbrecord.HasTreeWith(sumdbtree.ID(s.id)),
@@ -58,29 +85,25 @@ func (s *Store) Get(ctx context.Context, path, version string) (*goproxy.ModuleV
 		return nil, fmt.Errorf("failed to find module: %s@%s, %w", path, version, err)
 	}
 
-	modURI, err := rec.
-		QueryAssets().
-		Where(asset.TypeEQ(types.TextFile)).
-		Only(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get go.mod URI for: %s@%s, %w", path, version, err)
-	}
-
-	zipURI, err := rec.
-		QueryAssets().
-		Where(asset.TypeEQ(types.Archive)).
-		Only(ctx)
+	mv, err := toModuleVersion(rec)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get zip URI for: %s@%s, %w", path, version, err)
+		return nil, err
 	}
 
-	mv := toModuleVersion(rec)
-	mv.ModURI = modURI.URI
-	mv.ZipURI = zipURI.URI
+	s.cache.modules.Set(key, mv, 0)
 	return mv, nil
 }
 
+// GetVersions returns every version of the module at path, including retracted ones. Like upstream proxies, the go
+// command is left to filter them (using the retract directives in the go.mod of the latest version).
+//
+// NB: Assets are loaded with the records, so the number of queries doesn't depend on the number of versions.
 func (s *Store) GetVersions(ctx context.Context, path string) ([]*goproxy.ModuleVersion, error) {
+	key := versionsKey{tree: s.id, path: path}
+	if mvs, ok := s.cache.versions.Get(key); ok {
+		return slices.Clone(mvs), nil
+	}
+
 	recs, err := s.db.SumDBRecord.Query().
 		Where(
 			sumdbrecord.HasTreeWith(sumdbtree.ID(s.id)),
@@ -94,28 +117,26 @@ func (s *Store) GetVersions(ctx context.Context, path string) ([]*goproxy.Module
 
 	mvs := make([]*goproxy.ModuleVersion, len(recs))
 	for i := range recs {
-		modURI, err := recs[i].
-			QueryAssets().
-			Where(asset.TypeEQ(types.TextFile)).
-			Only(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get go.mod URI for: %s, %w", path, err)
+		if mvs[i], err = toModuleVersion(recs[i]); err != nil {
+			return nil, err
 		}
+	}
 
-		zipURI, err := recs[i].
-			QueryAssets().
-			Where(asset.TypeEQ(types.Archive)).
-			Only(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get zip URI for: %s, %w", path, err)
-		}
+	s.cache.versions.Set(key, slices.Clone(mvs), s.cache.ttl)
+	return mvs, nil
+}
 
-		mvs[i] = toModuleVersion(recs[i])
-		mvs[i].ModURI = modURI.URI
-		mvs[i].ZipURI = zipURI.URI
+// Retracted returns the retracted versions of the module at path.
+func (s *Store) Retracted(ctx context.Context, path string) ([]string, error) {
+	versions, err := s.db.Retraction.Query().
+		Where(retraction.Path(path)).
+		Select(retraction.FieldVersion).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find retracted versions: %s, %w", path, err)
 	}
 
-	return mvs, nil
+	return versions, nil
 }
 
 func (s *Store) ReadFile(ctx context.Context, w io.Writer, uri string) error {
@@ -130,10 +151,30 @@ func (r *reader) Read(ctx context.Context, w io.Writer, uri string) error {
 	return r.fn(ctx, w, uri)
 }
 
-func toModuleVersion(r *ent.SumDBRecord) *goproxy.ModuleVersion {
-	return &goproxy.ModuleVersion{
+// toModuleVersion converts r to a ModuleVersion, using its (eager loaded) assets for the go.mod and zip URIs.
+func toModuleVersion(r *ent.SumDBRecord) (*goproxy.ModuleVersion, error) {
+	mv := &goproxy.ModuleVersion{
 		Path:      r.Path,
 		Version:   r.Version,
 		CreatedAt: r.CreatedAt,
 	}
+
+	for _, a := range r.Edges.Assets {
+		switch a.Type {
+		case types.TextFile:
+			mv.ModURI = a.URI
+		case types.Archive:
+			mv.ZipURI = a.URI
+		}
+	}
+
+	if mv.ModURI == "" {
+		return nil, fmt.Errorf("failed to get go.mod URI for: %s@%s", r.Path, r.Version)
+	}
+
+	if mv.ZipURI == "" {
+		return nil, fmt.Errorf("failed to get zip URI for: %s@%s", r.Path, r.Version)
+	}
+
+	return mv, nil
 }
@@ -1,11 +1,19 @@
 package goproxy_test
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/pseudomuto/goproxy"
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent"
 	"github.com/pseudomuto/pacman/internal/ent/enttest"
 	. "github.com/pseudomuto/pacman/internal/goproxy"
 	"github.com/pseudomuto/pacman/internal/types"
@@ -39,6 +47,16 @@ func TestStore(t *testing.T) {
 		SetData([]byte("data right hur")).
 		SaveX(t.Context())
 
+	// Pseudo-versions and deep monorepo paths exceed typical column sizes.
+	long := client.SumDBRecord.Create().
+		AddAssetIDs(assets[0].ID, assets[1].ID).
+		SetTree(tree).
+		SetRecordID(2).
+		SetPath("github.com/pseudomuto/" + strings.Repeat("nested/", 50) + "where").
+		SetVersion("v0.0.0-20191109021931-daa7c04131f5").
+		SetData([]byte("data right hur")).
+		SaveX(t.Context())
+
 	store := NewStore(client, tree.ID, nil)
 
 	t.Run("Get", func(t *testing.T) {
@@ -47,6 +65,13 @@ func TestStore(t *testing.T) {
 		require.Equal(t, mod.Path, v.Path)
 		require.Equal(t, mod.Version, v.Version)
 		require.Equal(t, mod.CreatedAt, v.CreatedAt)
+		require.Equal(t, "go.mod", v.ModURI)
+		require.Equal(t, "go.zip", v.ZipURI)
+
+		v, err = store.Get(t.Context(), long.Path, long.Version)
+		require.NoError(t, err)
+		require.Equal(t, long.Path, v.Path)
+		require.Equal(t, long.Version, v.Version)
 
 		v, err = store.Get(t.Context(), mod.Path, mod.Version+"1")
 		require.Nil(t, v)
@@ -60,5 +85,168 @@ func TestStore(t *testing.T) {
 		require.Equal(t, mod.Path, vs[0].Path)
 		require.Equal(t, mod.Version, vs[0].Version)
 		require.Equal(t, mod.CreatedAt, vs[0].CreatedAt)
+		require.Equal(t, "go.mod", vs[0].ModURI)
+		require.Equal(t, "go.zip", vs[0].ZipURI)
+
+		client.Retraction.Create().SetPath(mod.Path).SetVersion(mod.Version).SaveX(t.Context())
+
+		// Retracted versions are still listed, leaving the go command to filter them.
+		vs, err = store.GetVersions(t.Context(), mod.Path)
+		require.NoError(t, err)
+		require.Len(t, vs, 1)
+
+		retracted, err := store.Retracted(t.Context(), mod.Path)
+		require.NoError(t, err)
+		require.Equal(t, []string{mod.Version}, retracted)
+
+		// Retracted versions can still be fetched.
+		v, err := store.Get(t.Context(), mod.Path, mod.Version)
+		require.NoError(t, err)
+		require.Equal(t, mod.Version, v.Version)
+	})
+}
+
+func TestStore_Queries(t *testing.T) {
+	t.Parallel()
+
+	var queries atomic.Int64
+	client := enttest.Open(
+		t,
+		"sqlite3",
+		"file:ent?mode=memory&_fk=1",
+		enttest.WithOptions(ent.Debug(), ent.Log(func(...any) { queries.Add(1) })),
+	)
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedVersions(t, client, map[string]int{
+		"example.com/small": 1,
+		"example.com/large": 50,
+	})
+
+	store := NewStore(client, tree.ID, nil)
+	count := func(fn func() error) int64 {
+		queries.Store(0)
+		require.NoError(t, fn())
+		return queries.Load()
+	}
+
+	small := count(func() error {
+		vs, err := store.GetVersions(t.Context(), "example.com/small")
+		require.Len(t, vs, 1)
+		return err
+	})
+
+	large := count(func() error {
+		vs, err := store.GetVersions(t.Context(), "example.com/large")
+		require.Len(t, vs, 50)
+		return err
+	})
+
+	require.Equal(t, small, large)
+
+	get := count(func() error {
+		_, err := store.Get(t.Context(), "example.com/large", "v1.0.49")
+		return err
 	})
+
+	require.LessOrEqual(t, get, int64(2))
+}
+
+func BenchmarkStore_GetVersions(b *testing.B) {
+	var queries atomic.Int64
+	client := enttest.Open(
+		b,
+		"sqlite3",
+		"file:ent?mode=memory&_fk=1",
+		enttest.WithOptions(ent.Debug(), ent.Log(func(...any) { queries.Add(1) })),
+	)
+	b.Cleanup(func() { _ = client.Close() })
+
+	tree := seedVersions(b, client, map[string]int{"example.com/mod": 300})
+	store := NewStore(client, tree.ID, nil)
+
+	queries.Store(0)
+	for b.Loop() {
+		if _, err := store.GetVersions(b.Context(), "example.com/mod"); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(queries.Load())/float64(b.N), "queries/op")
+}
+
+// seedVersions creates a tree with the given number of versions (v1.0.0, v1.0.1, ...) for each module path.
+func seedVersions(tb testing.TB, client *ent.Client, mods map[string]int) *ent.SumDBTree {
+	tb.Helper()
+
+	ctx := context.Background()
+	tree := client.SumDBTree.Create().
+		SetName("test.example.com").
+		SetSize(0).
+		SetSignerKey(crypto.Secret("shh")).
+		SetVerifierKey("good").
+		SaveX(ctx)
+
+	var id int64
+	for path, n := range mods {
+		for i := range n {
+			version := fmt.Sprintf("v1.0.%d", i)
+			assets := client.Asset.CreateBulk(
+				client.Asset.Create().SetType(types.TextFile).SetURI(path+"/@v/"+version+".mod"),
+				client.Asset.Create().SetType(types.Archive).SetURI(path+"/@v/"+version+".zip"),
+			).SaveX(ctx)
+
+			client.SumDBRecord.Create().
+				AddAssets(assets...).
+				SetTree(tree).
+				SetRecordID(id).
+				SetPath(path).
+				SetVersion(version).
+				SetData([]byte("data")).
+				ExecX(ctx)
+
+			id++
+		}
+	}
+
+	return client.SumDBTree.UpdateOne(tree).SetSize(id).SaveX(ctx)
+}
+
+func TestStore_Cache(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedVersions(t, client, map[string]int{"example.com/mod": 2})
+	lc := NewLookupCache(&config.Config{}, cache.NewMetrics(prometheus.NewRegistry()))
+	store := NewStore(client, tree.ID, nil, WithLookupCache(lc))
+
+	mv, err := store.Get(t.Context(), "example.com/mod", "v1.0.0")
+	require.NoError(t, err)
+
+	vs, err := store.GetVersions(t.Context(), "example.com/mod")
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+
+	// Changes in the database aren't seen until the version list is invalidated.
+	client.SumDBRecord.Delete().ExecX(t.Context())
+
+	cached, err := store.Get(t.Context(), "example.com/mod", "v1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, mv, cached)
+
+	vs, err = store.GetVersions(t.Context(), "example.com/mod")
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+
+	lc.Invalidate("example.com/other")
+	vs, err = store.GetVersions(t.Context(), "example.com/mod")
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+
+	lc.Invalidate("example.com/mod")
+	vs, err = store.GetVersions(t.Context(), "example.com/mod")
+	require.NoError(t, err)
+	require.Empty(t, vs)
 }
@@ -0,0 +1,79 @@
+package goproxy
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type (
+	// handlerTransport is an http.RoundTripper that serves requests in-process using the wrapped handler.
+	handlerTransport struct {
+		h http.Handler
+	}
+
+	// responseBuffer is a minimal http.ResponseWriter that buffers the response in memory.
+	responseBuffer struct {
+		code   int
+		header http.Header
+		body   bytes.Buffer
+	}
+)
+
+// HTTPClient returns an http.Client that serves all requests with this proxy in-process, regardless of the host.
+//
+// This allows the sumdb to resolve modules (published archives first, then upstream) without a network round trip to
+// ourselves.
+func (s *UpstreamProxy) HTTPClient() *http.Client {
+	return &http.Client{Transport: &handlerTransport{h: s}}
+}
+
+func (t *handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := &responseBuffer{header: make(http.Header)}
+	t.h.ServeHTTP(w, req)
+
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(w.code) + " " + http.StatusText(w.code),
+		StatusCode:    w.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
+
+func (w *responseBuffer) Header() http.Header {
+	return w.header
+}
+
+func (w *responseBuffer) Write(p []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+
+	return w.body.Write(p)
+}
+
+func (w *responseBuffer) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+// copyTo writes the buffered response to w.
+func (w *responseBuffer) copyTo(dst http.ResponseWriter) {
+	for k, v := range w.header {
+		dst.Header()[k] = v
+	}
+
+	dst.WriteHeader(w.code)
+	_, _ = w.body.WriteTo(dst)
+}
@@ -1,16 +1,17 @@
 package goproxy
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"path"
 	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/pseudomuto/pacman/internal/ent"
 	"github.com/pseudomuto/pacman/internal/ent/archive"
 	"github.com/pseudomuto/pacman/internal/ent/schema"
@@ -20,44 +21,78 @@ import (
 
 const defaultProxy = "https://proxy.golang.org"
 
-// UpstreamProxy is used by our SumDB server to fetch unknown modules.
+// UpstreamProxy is used by our SumDB server to fetch unknown modules. It implements the full GOPROXY protocol, so it can
+// also be used directly in GOPROXY (e.g. https://pacman.example.com/goproxy/proxy.golang.org) for public and private
+// modules alike.
 //
-// This proxy will check for published Archives in the archives table before proxying upstream to proxy.golang.org. When
-// the archive exists, the SumDB server will receive the values from the archive and use those to populate SumDBRecords
-// for the appropriate tree. After which, it will never ask again for the particular path/version.
+// This proxy will check for published Archives in the archives table before proxying upstream (e.g. proxy.golang.org).
+// When the archive exists, the SumDB server will receive the values from the archive and use those to populate
+// SumDBRecords for the appropriate tree. After which, it will never ask again for the particular path/version.
 //
-// When not found, the request will be proxied upstream to the supplied goProxy handler. The only exception here is if
-// the path is specified in Config.Go.NoSumPatterns, in which case no upstream proxying will be done.
+// When not found, the request will be proxied to the configured upstreams (see WithUpstreams). The only exception here is if
+// the path is specified in Config.Go.NoSumPatterns, in which case no upstream proxying will be done. When a cache is
+// configured (see WithCache), upstream assets are written to storage and recorded as Archives rather than streamed back,
+// meaning each asset is only ever fetched from upstream once. Cached assets are verified against the checksum database
+// first (see WithChecksumDB).
 //
-// NB: This should not be in your GOPROXY list. This is an implementation detail for pacman and only responds for .mod
-// and .zip endpoints.
+// The list and @latest endpoints merge archived versions with those known upstream, while .info is served from the
+// archive when its release time is known.
 type UpstreamProxy struct {
-	prefix string
-	db     *ent.Client
-	rp     http.Handler
-	rdr    Reader
+	prefix        string
+	db            *ent.Client
+	rdr           Reader
+	upstreams     []Upstream
+	client        *http.Client
+	cache         Writer
+	sums          ChecksumDB
+	noSumPatterns string
+	blocked       prometheus.Counter
 }
 
-func NewUpstreamProxy(db *ent.Client, rdr Reader) *UpstreamProxy {
-	return NewUpstreamProxyWithHost(db, rdr, defaultProxy)
+// UpstreamOption configures an UpstreamProxy.
+type UpstreamOption func(*UpstreamProxy)
+
+// WithNoSumPatterns sets the glob patterns (GONOSUMDB/GOPRIVATE syntax) for module paths which must never be proxied
+// upstream.
+func WithNoSumPatterns(patterns ...string) UpstreamOption {
+	return func(up *UpstreamProxy) { up.noSumPatterns = strings.Join(patterns, ",") }
+}
+
+// WithRegistry registers the proxy's metrics with reg.
+func WithRegistry(reg prometheus.Registerer) UpstreamOption {
+	return func(up *UpstreamProxy) { reg.MustRegister(up.blocked) }
 }
 
-func NewUpstreamProxyWithHost(db *ent.Client, rdr Reader, host string) *UpstreamProxy {
+// NewUpstreamProxy creates an UpstreamProxy which fetches public modules from proxy.golang.org (see WithUpstreams).
+func NewUpstreamProxy(db *ent.Client, rdr Reader, opts ...UpstreamOption) *UpstreamProxy {
+	return NewUpstreamProxyWithHost(db, rdr, defaultProxy, opts...)
+}
+
+// NewUpstreamProxyWithHost creates an UpstreamProxy which fetches public modules from the GOPROXY server at host.
+func NewUpstreamProxyWithHost(db *ent.Client, rdr Reader, host string, opts ...UpstreamOption) *UpstreamProxy {
 	url, _ := url.Parse(host)
-	rp := httputil.NewSingleHostReverseProxy(url)
-	rp.Director = func(req *http.Request) {
-		req.Host = url.Host
-		req.URL.Scheme = url.Scheme
-		req.URL.Host = url.Host
-		req.URL.Path = strings.TrimPrefix(req.URL.Path, "/goproxy/proxy.golang.org")
+	up := &UpstreamProxy{
+		prefix:    "/goproxy/proxy.golang.org",
+		db:        db,
+		rdr:       rdr,
+		upstreams: []Upstream{{URL: url}},
+		client:    http.DefaultClient,
+		blocked: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "goproxy_upstream_blocked_total",
+			Help: "Number of lookups not proxied upstream because the path matched a NoSumPattern",
+		}),
 	}
 
-	return &UpstreamProxy{
-		prefix: "/goproxy/proxy.golang.org",
-		db:     db,
-		rp:     rp,
-		rdr:    rdr,
+	for _, opt := range opts {
+		opt(up)
 	}
+
+	return up
+}
+
+// Name returns the name the proxy is served under (i.e. /goproxy/<name>).
+func (s *UpstreamProxy) Name() string {
+	return path.Base(s.prefix)
 }
 
 func (s *UpstreamProxy) RegisterRoutes(g *gin.Engine) {
@@ -65,39 +100,29 @@ func (s *UpstreamProxy) RegisterRoutes(g *gin.Engine) {
 }
 
 func (s *UpstreamProxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	// NB: We only need to respond to .mod and .zip requests from the sumdb
-	if !slices.Contains([]string{".mod", ".zip"}, path.Ext(req.URL.Path)) {
-		http.NotFound(w, req)
-		return
-	}
-
-	mod, err := parseModule(strings.TrimPrefix(req.URL.Path, s.prefix))
+	rel := strings.TrimPrefix(req.URL.Path, s.prefix)
+	mod, err := parseModule(rel)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	arch, err := s.db.Archive.Query().
-		Where(
-			archive.TypeEQ(types.GoModule),
-			archive.Coordinate(mod.String()),
-		).
-		Only(req.Context())
-	if err != nil {
-		var nfe *ent.NotFoundError
-		if errors.As(err, &nfe) {
-			// TODO: check for NoSumPatterns
-
-			// Fallback to proxying upstream.
-			s.rp.ServeHTTP(w, req)
-			return
-		}
-
-		// bad error
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	switch {
+	case strings.HasSuffix(rel, "/@latest"):
+		s.latest(w, req, mod.Path)
+	case strings.HasSuffix(rel, "/@v/list"):
+		s.list(w, req, mod.Path)
+	case path.Ext(rel) == ".info":
+		s.info(w, req, mod)
+	case path.Ext(rel) == ".mod", path.Ext(rel) == ".zip":
+		s.asset(w, req, mod)
+	default:
+		http.NotFound(w, req)
 	}
+}
 
+// asset serves the go.mod ($module/@v/$version.mod) or zip ($module/@v/$version.zip) for mod.
+func (s *UpstreamProxy) asset(w http.ResponseWriter, req *http.Request, mod module.Version) {
 	at := types.TextFile
 	ct := "text/plain; charset=utf-8"
 	if strings.HasSuffix(req.URL.Path, ".zip") {
@@ -105,19 +130,76 @@ func (s *UpstreamProxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		ct = "application/octet-stream"
 	}
 
-	idx := slices.IndexFunc(arch.Assets, func(e schema.AssetURL) bool { return e.Type == at })
-	if idx < 0 {
-		http.Error(w, "asset not found", http.StatusInternalServerError)
+	arch, err := s.findArchive(req.Context(), mod)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	var uri string
+	if arch != nil {
+		if idx := slices.IndexFunc(arch.Assets, func(e schema.AssetURL) bool { return e.Type == at }); idx > -1 {
+			uri = arch.Assets[idx].URL
+		}
+	}
+
+	if uri == "" {
+		switch {
+		case s.private(mod.Path):
+			s.block(w, mod.String())
+			return
+		case s.cache != nil:
+			if uri, err = s.pull(req.Context(), mod, at, path.Ext(req.URL.Path)); err != nil {
+				upstreamFailed(w, err)
+				return
+			}
+		case arch == nil:
+			// Fallback to proxying upstream.
+			s.proxy(w, req, ct)
+			return
+		default:
+			http.Error(w, "asset not found", http.StatusInternalServerError)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", ct)
-	if err := s.rdr.Read(req.Context(), w, arch.Assets[idx].URL); err != nil {
-		http.Error(w, "failed writing asset: %v"+err.Error(), http.StatusInternalServerError)
+	if err := s.rdr.Read(req.Context(), w, uri); err != nil {
+		http.Error(w, "failed writing asset: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 }
 
+// findArchive returns the Archive for mod, or nil when it doesn't exist.
+func (s *UpstreamProxy) findArchive(ctx context.Context, mod module.Version) (*ent.Archive, error) {
+	arch, err := s.db.Archive.Query().
+		Where(
+			archive.TypeEQ(types.GoModule),
+			archive.Coordinate(mod.String()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to find archive: %s, %w", mod, err)
+	}
+
+	return arch, nil
+}
+
+// private reports whether modPath matches the NoSumPatterns, meaning it must never be requested from upstream.
+func (s *UpstreamProxy) private(modPath string) bool {
+	return module.MatchPrefixPatterns(s.noSumPatterns, modPath)
+}
+
+// block responds with a 404 for a private module which isn't available locally.
+func (s *UpstreamProxy) block(w http.ResponseWriter, target string) {
+	s.blocked.Inc()
+	http.Error(w, "not found: "+target, http.StatusNotFound)
+}
+
 func parseModule(path string) (module.Version, error) {
 	// <prefix>/<mod>/@v/<version>.<ext>
 
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/pseudomuto/pacman/internal/ent/enttest"
 	"github.com/pseudomuto/pacman/internal/ent/schema"
 	. "github.com/pseudomuto/pacman/internal/goproxy"
@@ -45,6 +46,24 @@ func TestUpstreamProxy(t *testing.T) {
 			code: http.StatusOK,
 			body: "proxied: /go.example.com/module/@v/v0.1.1.zip",
 		},
+		{
+			name: "private module",
+			url:  "git.corp.example.com/private/@v/v0.1.0.zip",
+			code: http.StatusNotFound,
+			body: "not found: git.corp.example.com/private@v0.1.0\n",
+		},
+		{
+			name: "private module (exact prefix)",
+			url:  "go.private.io/mod/sub/@v/v0.1.0.mod",
+			code: http.StatusNotFound,
+		},
+		{
+			name:  "published private module",
+			url:   "git.corp.example.com/published/@v/v0.1.0.mod",
+			code:  http.StatusOK,
+			cType: "text/plain; charset=utf-8",
+			body:  "gs://test-bucket/private.mod",
+		},
 		{
 			name: "malformed module",
 			url:  "thing!/@v/v0.1.0.mod",
@@ -57,7 +76,7 @@ func TestUpstreamProxy(t *testing.T) {
 		},
 		{
 			name: "invalid extension",
-			url:  "go.example.com/module/@v/v0.1.0.info",
+			url:  "go.example.com/module/@v/v0.1.0.txt",
 			code: http.StatusNotFound,
 		},
 	}
@@ -80,6 +99,18 @@ func TestUpstreamProxy(t *testing.T) {
 		SetType(types.GoModule).
 		SaveX(t.Context())
 
+	client.Archive.Create().
+		SetAssets([]schema.AssetURL{
+			{
+				Type: types.TextFile,
+				URL:  "gs://test-bucket/private.mod",
+			},
+		}).
+		SetCoordinate("git.corp.example.com/published@v0.1.0").
+		SetType(types.GoModule).
+		SaveX(t.Context())
+
+	reg := prometheus.NewRegistry()
 	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "proxied: %s", r.URL.Path)
 	}))
@@ -92,6 +123,8 @@ func TestUpstreamProxy(t *testing.T) {
 			return nil
 		}),
 		svr.URL,
+		WithNoSumPatterns("*.corp.example.com", "go.private.io"),
+		WithRegistry(reg),
 	)
 
 	req := func(p string) *http.Request {
@@ -103,6 +136,25 @@ func TestUpstreamProxy(t *testing.T) {
 		)
 	}
 
+	t.Run("in-process client", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(
+			t.Context(),
+			http.MethodGet,
+			"https://proxy.golang.org/go.example.com/module/@v/v0.1.0.mod",
+			nil,
+		)
+		require.NoError(t, err)
+
+		res, err := up.HTTPClient().Do(req)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "gs://test-bucket/go.mod", string(body))
+	})
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			w := httptest.NewRecorder()
@@ -118,4 +170,12 @@ func TestUpstreamProxy(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("blocked metric", func(t *testing.T) {
+		mfs, err := reg.Gather()
+		require.NoError(t, err)
+		require.Len(t, mfs, 1)
+		require.Equal(t, "goproxy_upstream_blocked_total", mfs[0].GetName())
+		require.InDelta(t, 2, mfs[0].GetMetric()[0].GetCounter().GetValue(), 0)
+	})
 }
@@ -0,0 +1,273 @@
+package goproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/goproxy"
+	"github.com/pseudomuto/pacman/internal/config"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+type (
+	// VirtualServer serves modules from several sumdb trees and the upstream proxies behind a single GOPROXY URL.
+	//
+	// Each module path is resolved using the first matching Route, searching its trees in order before falling back to
+	// the UpstreamProxy (when enabled). The list and @latest endpoints merge the results from all of them.
+	VirtualServer struct {
+		prefix   string
+		up       *UpstreamProxy
+		stores   StoreFunc
+		routes   []route
+		fallback route
+	}
+
+	// StoreFunc returns the Stores of the named trees (in order), skipping those which are no longer served. The Stores
+	// of all trees are returned when no names are given.
+	StoreFunc func(ctx context.Context, names ...string) ([]*Store, error)
+
+	route struct {
+		pattern  string
+		trees    []string
+		all      bool
+		upstream bool
+	}
+)
+
+// NewVirtualServer creates a VirtualServer for the config. The routes may only reference the named trees, whose Stores
+// are resolved by stores for each request. Modules matching none of the routes are resolved from all trees.
+func NewVirtualServer(
+	c *config.Virtual,
+	up *UpstreamProxy,
+	trees []string,
+	stores StoreFunc,
+) (*VirtualServer, error) {
+	if c.Name == "" || slices.Contains(trees, c.Name) || c.Name == up.Name() {
+		return nil, fmt.Errorf("invalid virtual proxy name: %q", c.Name)
+	}
+
+	svr := &VirtualServer{
+		prefix:   "/goproxy/" + c.Name,
+		up:       up,
+		stores:   stores,
+		routes:   make([]route, len(c.Routes)),
+		fallback: route{all: true, upstream: true},
+	}
+
+	for i, r := range c.Routes {
+		for _, name := range r.Trees {
+			if !slices.Contains(trees, name) {
+				return nil, fmt.Errorf("unknown tree in virtual proxy route: %s, %s", r.Pattern, name)
+			}
+		}
+
+		svr.routes[i] = route{pattern: r.Pattern, trees: r.Trees, upstream: r.Upstream}
+	}
+
+	return svr, nil
+}
+
+func (s *VirtualServer) RegisterRoutes(g *gin.Engine) {
+	g.GET(s.prefix+"/*action", gin.WrapH(s))
+}
+
+func (s *VirtualServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	rel := strings.TrimPrefix(req.URL.Path, s.prefix)
+	mod, err := parseModule(rel)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r := s.route(mod.Path)
+	switch {
+	case strings.HasSuffix(rel, "/@latest"):
+		s.latest(w, req, r, rel, mod.Path)
+	case strings.HasSuffix(rel, "/@v/list"):
+		s.list(w, req, r, rel, mod.Path)
+	case slices.Contains([]string{".info", ".mod", ".zip"}, path.Ext(rel)):
+		s.version(w, req, r, rel, mod)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// version serves the .info, .mod, or .zip for mod from the first tree that has it, falling back to upstream.
+func (s *VirtualServer) version(w http.ResponseWriter, req *http.Request, r route, rel string, mod module.Version) {
+	ctx := req.Context()
+	stores, err := s.routeStores(req, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, st := range stores {
+		mv, err := st.Get(ctx, mod.Path, mod.Version)
+		if errors.Is(err, goproxy.ErrModuleNotFound) {
+			continue
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		switch path.Ext(rel) {
+		case ".info":
+			writeJSON(w, &Info{Version: mv.Version, Time: &mv.CreatedAt})
+		case ".mod":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			err = st.ReadFile(ctx, w, mv.ModURI)
+		default:
+			w.Header().Set("Content-Type", "application/octet-stream")
+			err = st.ReadFile(ctx, w, mv.ZipURI)
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	if !r.upstream {
+		http.Error(w, "not found: "+mod.String(), http.StatusNotFound)
+		return
+	}
+
+	s.upstream(w, req, rel)
+}
+
+// list serves the deduplicated versions from all of the route's trees and the upstream.
+func (s *VirtualServer) list(w http.ResponseWriter, req *http.Request, r route, rel, modPath string) {
+	var versions []string
+	stores, err := s.routeStores(req, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, st := range stores {
+		mvs, err := st.GetVersions(req.Context(), modPath)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, mv := range mvs {
+			if !module.IsPseudoVersion(mv.Version) {
+				versions = append(versions, mv.Version)
+			}
+		}
+	}
+
+	if r.upstream {
+		res := s.capture(req, rel)
+		switch {
+		case res.code == http.StatusOK:
+			versions = append(versions, strings.Fields(res.body.String())...)
+		case len(versions) == 0:
+			res.copyTo(w)
+			return
+		}
+	} else if len(versions) == 0 {
+		http.Error(w, "not found: "+modPath, http.StatusNotFound)
+		return
+	}
+
+	semver.Sort(versions)
+	versions = slices.Compact(versions)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	for _, v := range versions {
+		_, _ = fmt.Fprintln(w, v)
+	}
+}
+
+// latest serves the newest version from all of the route's trees and the upstream.
+func (s *VirtualServer) latest(w http.ResponseWriter, req *http.Request, r route, rel, modPath string) {
+	var best *Info
+	stores, err := s.routeStores(req, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, st := range stores {
+		mvs, err := st.GetVersions(req.Context(), modPath)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, mv := range mvs {
+			if inf := (&Info{Version: mv.Version, Time: &mv.CreatedAt}); newer(inf, best) {
+				best = inf
+			}
+		}
+	}
+
+	if r.upstream {
+		res := s.capture(req, rel)
+		if res.code != http.StatusOK && best == nil {
+			res.copyTo(w)
+			return
+		}
+
+		var inf Info
+		if res.code == http.StatusOK && json.Unmarshal(res.body.Bytes(), &inf) == nil && newer(&inf, best) {
+			best = &inf
+		}
+	} else if best == nil {
+		http.Error(w, "not found: "+modPath, http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, best)
+}
+
+// route returns the first route matching modPath, or the fallback when none match.
+func (s *VirtualServer) route(modPath string) route {
+	for _, r := range s.routes {
+		if module.MatchPrefixPatterns(r.pattern, modPath) {
+			return r
+		}
+	}
+
+	return s.fallback
+}
+
+// routeStores returns the Stores of the route's trees.
+func (s *VirtualServer) routeStores(req *http.Request, r route) ([]*Store, error) {
+	if !r.all && len(r.trees) == 0 {
+		return nil, nil
+	}
+
+	return s.stores(req.Context(), r.trees...)
+}
+
+// upstream serves req (rel being the path relative to our prefix) using the UpstreamProxy.
+func (s *VirtualServer) upstream(w http.ResponseWriter, req *http.Request, rel string) {
+	up := req.Clone(req.Context())
+	up.URL.Path = s.up.prefix + rel
+	s.up.ServeHTTP(w, up)
+}
+
+// capture serves req using the UpstreamProxy, buffering the response.
+func (s *VirtualServer) capture(req *http.Request, rel string) *responseBuffer {
+	res := &responseBuffer{header: make(http.Header)}
+	s.upstream(res, req, rel)
+	if res.code == 0 {
+		res.code = http.StatusOK
+	}
+
+	return res
+}
@@ -0,0 +1,222 @@
+package goproxy_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	. "github.com/pseudomuto/pacman/internal/goproxy"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualServer(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	bucket := &memBucket{objects: make(map[string][]byte)}
+	stores := make(map[string]*Store)
+	trees := make(map[string]*ent.SumDBTree)
+	for _, name := range []string{"private.sumdb.com", "public.sumdb.com"} {
+		trees[name] = client.SumDBTree.Create().
+			SetName(name).
+			SetSize(0).
+			SetSignerKey(crypto.Secret("shh")).
+			SetVerifierKey("good").
+			SaveX(t.Context())
+		stores[name] = NewStore(client, trees[name].ID, bucket)
+	}
+
+	var id int64
+	addRecord := func(tree, path, version string) {
+		id++
+		uri := "mem://" + tree + "/" + path + "@" + version
+		bucket.objects[uri+".mod"] = []byte("module " + path + " // " + tree + "\n")
+		bucket.objects[uri+".zip"] = []byte("zip")
+
+		assets := client.Asset.CreateBulk(
+			client.Asset.Create().SetType(types.TextFile).SetURI(uri+".mod"),
+			client.Asset.Create().SetType(types.Archive).SetURI(uri+".zip"),
+		).SaveX(t.Context())
+
+		client.SumDBRecord.Create().
+			AddAssets(assets...).
+			SetTree(trees[tree]).
+			SetRecordID(id).
+			SetPath(path).
+			SetVersion(version).
+			SetData([]byte("data")).
+			SaveX(t.Context())
+	}
+
+	addRecord("private.sumdb.com", "example.com/private", "v1.0.0")
+	addRecord("private.sumdb.com", "example.com/private", "v1.1.0")
+	addRecord("private.sumdb.com", "example.com/shared", "v1.0.0")
+	addRecord("public.sumdb.com", "example.com/private", "v2.0.0")
+	addRecord("public.sumdb.com", "example.com/shared", "v1.0.0")
+	addRecord("public.sumdb.com", "example.com/shared", "v1.2.0")
+
+	var hits atomic.Int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		switch r.URL.Path {
+		case "/example.com/shared/@v/list":
+			fmt.Fprint(w, "v1.2.0\nv1.3.0\n")
+		case "/example.com/shared/@latest":
+			fmt.Fprint(w, `{"Version":"v1.3.0","Time":"2025-01-01T00:00:00Z"}`)
+		case "/example.com/shared/@v/v1.3.0.mod":
+			fmt.Fprint(w, "module example.com/shared // upstream\n")
+		default:
+			http.Error(w, "not found", http.StatusGone)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	virt, err := NewVirtualServer(
+		&config.Virtual{
+			Name: "all",
+			Routes: []config.Route{
+				{Pattern: "example.com/private", Trees: []string{"private.sumdb.com"}},
+			},
+		},
+		NewUpstreamProxyWithHost(client, bucket, svr.URL),
+		[]string{"private.sumdb.com", "public.sumdb.com"},
+		func(_ context.Context, names ...string) ([]*Store, error) {
+			if len(names) == 0 {
+				names = []string{"private.sumdb.com", "public.sumdb.com"}
+			}
+
+			res := make([]*Store, len(names))
+			for i, name := range names {
+				res[i] = stores[name]
+			}
+
+			return res, nil
+		},
+	)
+	require.NoError(t, err)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		virt.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/goproxy/all/"+path, nil))
+		return w
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		code    int
+		body    string
+		version string
+	}{
+		{
+			name: "merged list",
+			url:  "example.com/shared/@v/list",
+			code: http.StatusOK,
+			body: "v1.0.0\nv1.2.0\nv1.3.0\n",
+		},
+		{
+			name:    "merged latest",
+			url:     "example.com/shared/@latest",
+			code:    http.StatusOK,
+			version: "v1.3.0",
+		},
+		{
+			name: "first tree wins",
+			url:  "example.com/shared/@v/v1.0.0.mod",
+			code: http.StatusOK,
+			body: "module example.com/shared // private.sumdb.com\n",
+		},
+		{
+			name: "second tree",
+			url:  "example.com/shared/@v/v1.2.0.mod",
+			code: http.StatusOK,
+			body: "module example.com/shared // public.sumdb.com\n",
+		},
+		{
+			name: "upstream",
+			url:  "example.com/shared/@v/v1.3.0.mod",
+			code: http.StatusOK,
+			body: "module example.com/shared // upstream\n",
+		},
+		{
+			name:    "tree info",
+			url:     "example.com/shared/@v/v1.2.0.info",
+			code:    http.StatusOK,
+			version: "v1.2.0",
+		},
+		{
+			name: "zip",
+			url:  "example.com/shared/@v/v1.2.0.zip",
+			code: http.StatusOK,
+			body: "zip",
+		},
+		{
+			name: "unknown",
+			url:  "example.com/unknown/@v/list",
+			code: http.StatusGone,
+		},
+		{
+			name: "routed list",
+			url:  "example.com/private/@v/list",
+			code: http.StatusOK,
+			body: "v1.0.0\nv1.1.0\n",
+		},
+		{
+			name:    "routed latest",
+			url:     "example.com/private/@latest",
+			code:    http.StatusOK,
+			version: "v1.1.0",
+		},
+		{
+			name: "routed version in other tree",
+			url:  "example.com/private/@v/v2.0.0.mod",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "bad path",
+			url:  "example.com/private/@v/v1.0.0.txt",
+			code: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := get(tt.url)
+			require.Equal(t, tt.code, w.Code)
+
+			if tt.body != "" {
+				require.Equal(t, tt.body, w.Body.String())
+			}
+
+			if tt.version != "" {
+				var inf Info
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &inf))
+				require.Equal(t, tt.version, inf.Version)
+			}
+		})
+	}
+
+	t.Run("routes without upstream", func(t *testing.T) {
+		before := hits.Load()
+		for _, path := range []string{
+			"example.com/private/@v/list",
+			"example.com/private/@latest",
+			"example.com/private/@v/v9.0.0.zip",
+		} {
+			get(path)
+		}
+
+		require.Equal(t, before, hits.Load())
+	})
+}
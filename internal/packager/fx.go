@@ -1,13 +1,16 @@
 package packager
 
 import (
+	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/publisher"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Module("packager", fx.Provide(
 	fx.Annotate(
-		NewGoModule,
+		func(c *config.Config) *GoModule {
+			return NewGoModule(WithLimits(c.Go.Limits))
+		},
 		fx.As(new(publisher.Packager)),
 		fx.ResultTags(publisher.FXPackagers),
 	),
@@ -5,26 +5,52 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/types"
 	"golang.org/x/mod/module"
 	"golang.org/x/mod/zip"
 )
 
-type GoModule struct{}
+type (
+	GoModule struct {
+		limits config.Limits
+	}
+
+	GoModuleOption func(*GoModule)
+)
+
+func NewGoModule(opts ...GoModuleOption) *GoModule {
+	g := new(GoModule)
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
 
-func NewGoModule() *GoModule {
-	return new(GoModule)
+// WithLimits restricts the size of the modules that can be packaged.
+func WithLimits(l config.Limits) GoModuleOption {
+	return func(g *GoModule) {
+		g.limits = l
+	}
 }
 
 func (g *GoModule) Type() types.ArchiveType {
 	return types.GoModule
 }
 
+// Package validates the module in opts.Dir (see Validate) and writes its module zip to w.
 func (g *GoModule) Package(ctx context.Context, w io.Writer, opts types.PackageOptions) error {
-	if err := zip.CreateFromDir(w, module.Version{
+	mod := module.Version{
 		Path:    opts.Package,
 		Version: opts.Version,
-	}, opts.Dir); err != nil {
+	}
+
+	if err := g.Validate(opts.Dir, mod); err != nil {
+		return err
+	}
+
+	if err := zip.CreateFromDir(w, mod, opts.Dir); err != nil {
 		return fmt.Errorf("failed to create go archive: %w", err)
 	}
 
@@ -0,0 +1,213 @@
+package packager
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/mod/zip"
+)
+
+// Validate checks that the module in dir can be published as mod. When it can't, a *publisher.ValidationError listing
+// every problem is returned.
+func (g *GoModule) Validate(dir string, mod module.Version) error {
+	goMod, err := os.ReadFile(filepath.Join(dir, "go.mod")) //nolint:gosec // dir is the package dir
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	files, err := checkFiles(dir, g.limits)
+	if err != nil {
+		return err
+	}
+
+	var problems []publisher.Problem
+	problems = append(problems, checkVersion(mod, goMod != nil)...)
+	problems = append(problems, checkGoMod(mod, goMod)...)
+	problems = append(problems, files...)
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &publisher.ValidationError{Package: mod.Path, Version: mod.Version, Problems: problems}
+}
+
+// checkVersion verifies the module path and version, including the major version suffix rules.
+func checkVersion(mod module.Version, hasGoMod bool) []publisher.Problem {
+	if err := module.CheckPath(mod.Path); err != nil {
+		return []publisher.Problem{{Rule: publisher.RulePath, Message: err.Error()}}
+	}
+
+	if len(mod.Path) > schema.MaxPathLen {
+		return []publisher.Problem{{
+			Rule:    publisher.RulePath,
+			Message: fmt.Sprintf("module path is longer than %d characters", schema.MaxPathLen),
+		}}
+	}
+
+	if len(mod.Version) > schema.MaxVersionLen {
+		return []publisher.Problem{{
+			Rule:    publisher.RuleVersion,
+			Message: fmt.Sprintf("version is longer than %d characters", schema.MaxVersionLen),
+		}}
+	}
+
+	if !semver.IsValid(mod.Version) || module.CanonicalVersion(mod.Version) != mod.Version {
+		return []publisher.Problem{{
+			Rule:    publisher.RuleVersion,
+			Message: fmt.Sprintf("%q is not a canonical semantic version (e.g. v1.2.3)", mod.Version),
+		}}
+	}
+
+	_, pathMajor, _ := module.SplitPathVersion(mod.Path)
+	if err := module.CheckPathMajor(mod.Version, pathMajor); err != nil {
+		return []publisher.Problem{{Rule: publisher.RuleMajorVersion, Message: err.Error()}}
+	}
+
+	if semver.Build(mod.Version) != "+incompatible" {
+		return nil
+	}
+
+	switch {
+	case pathMajor != "":
+		return []publisher.Problem{{
+			Rule:    publisher.RuleMajorVersion,
+			Message: "+incompatible versions can't be used with a major version suffix",
+		}}
+	case semver.Compare(mod.Version, "v2.0.0") < 0:
+		return []publisher.Problem{{
+			Rule:    publisher.RuleMajorVersion,
+			Message: "+incompatible is only valid for major versions v2 and above",
+		}}
+	case hasGoMod:
+		return []publisher.Problem{{
+			Rule:    publisher.RuleMajorVersion,
+			File:    "go.mod",
+			Message: "+incompatible versions can't have a go.mod, add a /" + semver.Major(mod.Version) + " suffix instead",
+		}}
+	}
+
+	return nil
+}
+
+// checkGoMod verifies the module line matches the module path and that there are no local replace directives. A nil
+// goMod means the module doesn't have one, which is only allowed for paths without a major version suffix.
+func checkGoMod(mod module.Version, goMod []byte) []publisher.Problem {
+	if goMod == nil {
+		if _, pathMajor, _ := module.SplitPathVersion(mod.Path); pathMajor != "" {
+			return []publisher.Problem{{
+				Rule:    publisher.RuleModule,
+				File:    "go.mod",
+				Message: "go.mod is required for modules with a major version suffix",
+			}}
+		}
+
+		return nil
+	}
+
+	f, err := modfile.Parse("go.mod", goMod, nil)
+	if err != nil {
+		return []publisher.Problem{{Rule: publisher.RuleModule, File: "go.mod", Message: err.Error()}}
+	}
+
+	var problems []publisher.Problem
+	switch {
+	case f.Module == nil:
+		problems = append(problems, publisher.Problem{
+			Rule:    publisher.RuleModule,
+			File:    "go.mod",
+			Message: "missing module directive",
+		})
+	case f.Module.Mod.Path != mod.Path:
+		problems = append(problems, publisher.Problem{
+			Rule:    publisher.RuleModule,
+			File:    "go.mod",
+			Message: fmt.Sprintf("module path %q doesn't match %q", f.Module.Mod.Path, mod.Path),
+		})
+	}
+
+	for _, r := range f.Replace {
+		if r.New.Version == "" || modfile.IsDirectoryPath(r.New.Path) {
+			problems = append(problems, publisher.Problem{
+				Rule:    publisher.RuleReplace,
+				File:    "go.mod",
+				Message: fmt.Sprintf("replace directive for %s points to a local path: %s", r.Old.Path, r.New.Path),
+			})
+		}
+	}
+
+	return problems
+}
+
+// checkFiles runs zip.CheckDir against dir, and applies the configured limits to the files that'd be packaged.
+func checkFiles(dir string, limits config.Limits) ([]publisher.Problem, error) {
+	// NB: CheckDir returns an error describing invalid files too, we only care about failing to list them.
+	cf, err := zip.CheckDir(dir)
+	if err != nil && cf.Valid == nil && cf.Invalid == nil && cf.SizeError == nil {
+		return nil, fmt.Errorf("failed to check module files: %w", err)
+	}
+
+	var problems []publisher.Problem
+	for _, fe := range cf.Invalid {
+		problems = append(problems, publisher.Problem{
+			Rule:    publisher.RuleZip,
+			File:    rel(dir, fe.Path),
+			Message: fe.Err.Error(),
+		})
+	}
+
+	if cf.SizeError != nil {
+		problems = append(problems, publisher.Problem{Rule: publisher.RuleSize, Message: cf.SizeError.Error()})
+	}
+
+	if limits.MaxFiles > 0 && len(cf.Valid) > limits.MaxFiles {
+		problems = append(problems, publisher.Problem{
+			Rule:    publisher.RuleSize,
+			Message: fmt.Sprintf("module has %d files, exceeding the limit of %d", len(cf.Valid), limits.MaxFiles),
+		})
+	}
+
+	var total int64
+	for _, path := range cf.Valid {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat file: %s, %w", path, err)
+		}
+
+		total += info.Size()
+		if limits.MaxFileSize > 0 && info.Size() > limits.MaxFileSize {
+			problems = append(problems, publisher.Problem{
+				Rule:    publisher.RuleSize,
+				File:    rel(dir, path),
+				Message: fmt.Sprintf("file is %d bytes, exceeding the limit of %d", info.Size(), limits.MaxFileSize),
+			})
+		}
+	}
+
+	if limits.MaxSize > 0 && total > limits.MaxSize {
+		problems = append(problems, publisher.Problem{
+			Rule:    publisher.RuleSize,
+			Message: fmt.Sprintf("module is %d bytes, exceeding the limit of %d", total, limits.MaxSize),
+		})
+	}
+
+	return problems, nil
+}
+
+// rel returns path relative to dir, using forward slashes like module zip paths.
+func rel(dir, path string) string {
+	if r, err := filepath.Rel(dir, path); err == nil {
+		return filepath.ToSlash(r)
+	}
+
+	return path
+}
@@ -0,0 +1,220 @@
+package packager_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	. "github.com/pseudomuto/pacman/internal/packager"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+)
+
+func TestGoModule_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mod      module.Version
+		files    map[string]string
+		limits   config.Limits
+		problems []publisher.Problem
+	}{
+		{
+			name:  "valid",
+			mod:   module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files: map[string]string{"go.mod": "module example.com/mod\n", "mod.go": "package mod\n"},
+		},
+		{
+			name:  "valid major suffix",
+			mod:   module.Version{Path: "example.com/mod/v2", Version: "v2.1.0"},
+			files: map[string]string{"go.mod": "module example.com/mod/v2\n"},
+		},
+		{
+			name:  "valid incompatible",
+			mod:   module.Version{Path: "example.com/mod", Version: "v3.0.0+incompatible"},
+			files: map[string]string{"mod.go": "package mod\n"},
+		},
+		{
+			name: "valid remote replace",
+			mod:  module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files: map[string]string{
+				"go.mod": "module example.com/mod\n\nreplace example.com/dep => example.com/fork v1.0.0\n",
+			},
+		},
+		{
+			name:  "module mismatch",
+			mod:   module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files: map[string]string{"go.mod": "module example.com/other\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleModule,
+				File:    "go.mod",
+				Message: `module path "example.com/other" doesn't match "example.com/mod"`,
+			}},
+		},
+		{
+			name:     "missing module directive",
+			mod:      module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files:    map[string]string{"go.mod": "go 1.25\n"},
+			problems: []publisher.Problem{{Rule: publisher.RuleModule, File: "go.mod", Message: "missing module directive"}},
+		},
+		{
+			name: "local replace",
+			mod:  module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files: map[string]string{
+				"go.mod": "module example.com/mod\n\nreplace example.com/dep => ../dep\n",
+			},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleReplace,
+				File:    "go.mod",
+				Message: "replace directive for example.com/dep points to a local path: ../dep",
+			}},
+		},
+		{
+			name:  "non-canonical version",
+			mod:   module.Version{Path: "example.com/mod", Version: "v1.0"},
+			files: map[string]string{"go.mod": "module example.com/mod\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleVersion,
+				Message: `"v1.0" is not a canonical semantic version (e.g. v1.2.3)`,
+			}},
+		},
+		{
+			name:     "invalid path",
+			mod:      module.Version{Path: "example.com/Mod!", Version: "v1.0.0"},
+			files:    map[string]string{"mod.go": "package mod\n"},
+			problems: []publisher.Problem{{Rule: publisher.RulePath}},
+		},
+		{
+			name:  "path too long",
+			mod:   module.Version{Path: "example.com/" + strings.Repeat("a/", 250) + "mod", Version: "v1.0.0"},
+			files: map[string]string{"mod.go": "package mod\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RulePath,
+				Message: "module path is longer than 500 characters",
+			}},
+		},
+		{
+			name:  "version too long",
+			mod:   module.Version{Path: "example.com/mod", Version: "v1.0.0-" + strings.Repeat("a", 200)},
+			files: map[string]string{"mod.go": "package mod\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleVersion,
+				Message: "version is longer than 200 characters",
+			}},
+		},
+		{
+			name:     "missing major suffix",
+			mod:      module.Version{Path: "example.com/mod", Version: "v2.0.0"},
+			files:    map[string]string{"go.mod": "module example.com/mod\n"},
+			problems: []publisher.Problem{{Rule: publisher.RuleMajorVersion}},
+		},
+		{
+			name:     "wrong major suffix",
+			mod:      module.Version{Path: "example.com/mod/v3", Version: "v2.0.0"},
+			files:    map[string]string{"go.mod": "module example.com/mod/v3\n"},
+			problems: []publisher.Problem{{Rule: publisher.RuleMajorVersion}},
+		},
+		{
+			name:  "incompatible with go.mod",
+			mod:   module.Version{Path: "example.com/mod", Version: "v2.0.0+incompatible"},
+			files: map[string]string{"go.mod": "module example.com/mod\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleMajorVersion,
+				File:    "go.mod",
+				Message: "+incompatible versions can't have a go.mod, add a /v2 suffix instead",
+			}},
+		},
+		{
+			name:  "incompatible v1",
+			mod:   module.Version{Path: "example.com/mod", Version: "v1.0.0+incompatible"},
+			files: map[string]string{"mod.go": "package mod\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleMajorVersion,
+				Message: "+incompatible is only valid for major versions v2 and above",
+			}},
+		},
+		{
+			name:  "major suffix without go.mod",
+			mod:   module.Version{Path: "example.com/mod/v2", Version: "v2.0.0"},
+			files: map[string]string{"mod.go": "package mod\n"},
+			problems: []publisher.Problem{{
+				Rule:    publisher.RuleModule,
+				File:    "go.mod",
+				Message: "go.mod is required for modules with a major version suffix",
+			}},
+		},
+		{
+			name: "invalid file",
+			mod:  module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files: map[string]string{
+				"go.mod":     "module example.com/mod\n",
+				"bad\x01.go": "package mod\n",
+			},
+			problems: []publisher.Problem{{Rule: publisher.RuleZip, File: "bad\x01.go"}},
+		},
+		{
+			name: "limits",
+			mod:  module.Version{Path: "example.com/mod", Version: "v1.0.0"},
+			files: map[string]string{
+				"go.mod": "module example.com/mod\n",
+				"big.go": "package mod // this file is too big\n",
+			},
+			limits: config.Limits{MaxSize: 50, MaxFileSize: 30, MaxFiles: 1},
+			problems: []publisher.Problem{
+				{Rule: publisher.RuleSize, Message: "module has 2 files, exceeding the limit of 1"},
+				{Rule: publisher.RuleSize, File: "big.go", Message: "file is 36 bytes, exceeding the limit of 30"},
+				{Rule: publisher.RuleSize, Message: "module is 59 bytes, exceeding the limit of 50"},
+			},
+		},
+		{
+			name: "reports all problems",
+			mod:  module.Version{Path: "example.com/mod", Version: "v2.0.0+incompatible"},
+			files: map[string]string{
+				"go.mod": "module example.com/other\n\nreplace example.com/dep => ./dep\n",
+			},
+			problems: []publisher.Problem{
+				{Rule: publisher.RuleMajorVersion},
+				{Rule: publisher.RuleModule},
+				{Rule: publisher.RuleReplace},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+
+			err := NewGoModule(WithLimits(tt.limits)).Validate(dir, tt.mod)
+			if tt.problems == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var verr *publisher.ValidationError
+			require.ErrorAs(t, err, &verr)
+			require.Equal(t, tt.mod.Path, verr.Package)
+			require.Equal(t, tt.mod.Version, verr.Version)
+			require.Len(t, verr.Problems, len(tt.problems), verr.Problems)
+
+			for i, p := range tt.problems {
+				require.Equal(t, p.Rule, verr.Problems[i].Rule)
+				if p.File != "" {
+					require.Equal(t, p.File, verr.Problems[i].File)
+				}
+
+				if p.Message != "" {
+					require.Equal(t, p.Message, verr.Problems[i].Message)
+				}
+			}
+		})
+	}
+}
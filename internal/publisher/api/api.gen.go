@@ -0,0 +1,353 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AssetType.
+const (
+	Archive AssetType = "archive"
+	Text    AssetType = "text"
+)
+
+// Defines values for GoPublishRequestStorage.
+const (
+	Fs  GoPublishRequestStorage = "fs"
+	Gcs GoPublishRequestStorage = "gcs"
+)
+
+// Defines values for GoPublishRequestVcs.
+const (
+	Git    GoPublishRequestVcs = "git"
+	Github GoPublishRequestVcs = "github"
+	Gitlab GoPublishRequestVcs = "gitlab"
+)
+
+// Defines values for ProblemRule.
+const (
+	MajorVersion ProblemRule = "major-version"
+	Module       ProblemRule = "module"
+	Path         ProblemRule = "path"
+	Replace      ProblemRule = "replace"
+	Size         ProblemRule = "size"
+	Version      ProblemRule = "version"
+	Zip          ProblemRule = "zip"
+)
+
+// Asset defines model for Asset.
+type Asset struct {
+	Type AssetType `json:"type"`
+	Url  string    `json:"url"`
+}
+
+// AssetType defines model for Asset.Type.
+type AssetType string
+
+// ConflictError defines model for ConflictError.
+type ConflictError struct {
+	Code int `json:"code"`
+
+	// ExistingHash The h1 dirhash of the published module, omitted when it isn't known
+	ExistingHash *string `json:"existingHash,omitempty"`
+
+	// Hash The h1 dirhash of the module being published
+	Hash    string `json:"hash"`
+	Message string `json:"message"`
+}
+
+// DeprecateRequest defines model for DeprecateRequest.
+type DeprecateRequest struct {
+	// Message The deprecation message. When empty, the deprecation is removed.
+	Message string `json:"message"`
+
+	// Module The module path (e.g. example.com/mod)
+	Module string `json:"module"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GoPublishRequest defines model for GoPublishRequest.
+type GoPublishRequest struct {
+	// Module The module path (e.g. example.com/mod)
+	Module string `json:"module"`
+
+	// Ref SHA, branch, or tag to publish
+	Ref string `json:"ref"`
+
+	// Repo The repository (e.g. group/project) to fetch. For git, this is the remote URL.
+	Repo string `json:"repo"`
+
+	// Storage Where to store the assets. Defaults to the first configured bucket.
+	Storage *GoPublishRequestStorage `json:"storage,omitempty"`
+
+	// Subdir Directory within the repo containing the module
+	Subdir *string `json:"subdir,omitempty"`
+
+	// Trees The sumdb trees to record the module in. Otherwise, it's recorded in each tree when first looked up, and can be replaced until then.
+	Trees *[]string `json:"trees,omitempty"`
+
+	// Vcs The VCS hosting the repo
+	Vcs GoPublishRequestVcs `json:"vcs"`
+
+	// Version The version to publish (e.g. v1.2.3). When omitted, ref is published as a pseudo-version.
+	Version *string `json:"version,omitempty"`
+}
+
+// GoPublishRequestStorage Where to store the assets. Defaults to the first configured bucket.
+type GoPublishRequestStorage string
+
+// GoPublishRequestVcs The VCS hosting the repo
+type GoPublishRequestVcs string
+
+// GoReplaceRequest defines model for GoReplaceRequest.
+type GoReplaceRequest struct {
+	Publish GoPublishRequest `json:"publish"`
+
+	// Reason Why the version is being replaced
+	Reason string `json:"reason"`
+}
+
+// ModuleStatus defines model for ModuleStatus.
+type ModuleStatus struct {
+	// Deprecated The deprecation message, omitted when the module isn't deprecated
+	Deprecated *string      `json:"deprecated,omitempty"`
+	Module     string       `json:"module"`
+	Retracted  []Retraction `json:"retracted"`
+}
+
+// Problem defines model for Problem.
+type Problem struct {
+	// File The file (relative to the module root) the problem was found in
+	File    *string `json:"file,omitempty"`
+	Message string  `json:"message"`
+
+	// Rule The check that failed
+	Rule ProblemRule `json:"rule"`
+}
+
+// ProblemRule The check that failed
+type ProblemRule string
+
+// PublishedArchive defines model for PublishedArchive.
+type PublishedArchive struct {
+	Assets     []Asset   `json:"assets"`
+	Coordinate string    `json:"coordinate"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// RetractRequest defines model for RetractRequest.
+type RetractRequest struct {
+	// Module The module path (e.g. example.com/mod)
+	Module string `json:"module"`
+
+	// Rationale Why the versions were retracted (e.g. "Published accidentally.")
+	Rationale *string `json:"rationale,omitempty"`
+
+	// Versions The versions to retract
+	Versions []string `json:"versions"`
+}
+
+// Retraction defines model for Retraction.
+type Retraction struct {
+	Rationale   string    `json:"rationale"`
+	RetractedAt time.Time `json:"retractedAt"`
+	Version     string    `json:"version"`
+}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	Code     int       `json:"code"`
+	Message  string    `json:"message"`
+	Problems []Problem `json:"problems"`
+}
+
+// GetGoModuleStatusParams defines parameters for GetGoModuleStatus.
+type GetGoModuleStatusParams struct {
+	// Module The module path (e.g. example.com/mod)
+	Module string `form:"module" json:"module"`
+}
+
+// DeprecateGoModuleJSONRequestBody defines body for DeprecateGoModule for application/json ContentType.
+type DeprecateGoModuleJSONRequestBody = DeprecateRequest
+
+// RetractGoModuleJSONRequestBody defines body for RetractGoModule for application/json ContentType.
+type RetractGoModuleJSONRequestBody = RetractRequest
+
+// PublishGoModuleJSONRequestBody defines body for PublishGoModule for application/json ContentType.
+type PublishGoModuleJSONRequestBody = GoPublishRequest
+
+// ReplaceGoModuleJSONRequestBody defines body for ReplaceGoModule for application/json ContentType.
+type ReplaceGoModuleJSONRequestBody = GoReplaceRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Deprecate a Go module
+	// (POST /api/v1/go/modules/deprecate)
+	DeprecateGoModule(c *gin.Context)
+	// Retract versions of a Go module
+	// (POST /api/v1/go/modules/retract)
+	RetractGoModule(c *gin.Context)
+	// Get the retraction and deprecation state of a Go module
+	// (GET /api/v1/go/modules/status)
+	GetGoModuleStatus(c *gin.Context, params GetGoModuleStatusParams)
+	// Publish a Go module from VCS
+	// (POST /api/v1/go/publish)
+	PublishGoModule(c *gin.Context)
+	// Replace the contents of a published Go module version
+	// (POST /api/v1/go/replace)
+	ReplaceGoModule(c *gin.Context)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// DeprecateGoModule operation middleware
+func (siw *ServerInterfaceWrapper) DeprecateGoModule(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeprecateGoModule(c)
+}
+
+// RetractGoModule operation middleware
+func (siw *ServerInterfaceWrapper) RetractGoModule(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RetractGoModule(c)
+}
+
+// GetGoModuleStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetGoModuleStatus(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"publish", "admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGoModuleStatusParams
+
+	// ------------- Required query parameter "module" -------------
+
+	if paramValue := c.Query("module"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument module is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "module", c.Request.URL.Query(), &params.Module)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter module: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGoModuleStatus(c, params)
+}
+
+// PublishGoModule operation middleware
+func (siw *ServerInterfaceWrapper) PublishGoModule(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"publish"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PublishGoModule(c)
+}
+
+// ReplaceGoModule operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceGoModule(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReplaceGoModule(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/api/v1/go/modules/deprecate", wrapper.DeprecateGoModule)
+	router.POST(options.BaseURL+"/api/v1/go/modules/retract", wrapper.RetractGoModule)
+	router.GET(options.BaseURL+"/api/v1/go/modules/status", wrapper.GetGoModuleStatus)
+	router.POST(options.BaseURL+"/api/v1/go/publish", wrapper.PublishGoModule)
+	router.POST(options.BaseURL+"/api/v1/go/replace", wrapper.ReplaceGoModule)
+}
@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// ErrRecorded is returned when replacing a version which has already been recorded in a sumdb tree. Since the tree is
+// append only, clients may have verified the published contents, so they can never be replaced.
+var ErrRecorded = errors.New("version has been recorded in a checksum database")
+
+// ConflictError is returned when publishing a version which already exists with different contents. Only admins can
+// replace a published version (see PublishOptions.Replace).
+type ConflictError struct {
+	Package string
+	Version string
+	// Hash is the h1: dirhash of the package being published.
+	Hash string
+	// Existing is the h1: dirhash of the published package, empty when it isn't known.
+	Existing string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf(
+		"conflicting package: %s@%s, %s doesn't match the published %s",
+		e.Package,
+		e.Version,
+		e.Hash,
+		cmp.Or(e.Existing, "package (unknown hash)"),
+	)
+}
@@ -1,11 +1,25 @@
 package publisher
 
-import "go.uber.org/fx"
+import (
+	"github.com/pseudomuto/pacman/internal/types"
+	"go.uber.org/fx"
+)
 
 const (
-	FXPackagers   = `group:"publisher_packagers"`
-	FXUploaders   = `group:"publisher_uploaders"`
-	FXVCSFetchers = `group:"publisher_vcs_fetchers"`
+	FXPackagers    = `group:"publisher_packagers"`
+	FXUploaders    = `group:"publisher_uploaders"`
+	FXVCSFetchers  = `group:"publisher_vcs_fetchers"`
+	FXInvalidators = `group:"publisher_invalidators"`
 )
 
-var Module = fx.Module("publisher", fx.Provide(New))
+var Module = fx.Module(
+	"publisher",
+	fx.Provide(
+		New,
+		fx.Annotate(
+			NewHandler,
+			fx.As(new(types.Router)),
+			fx.ResultTags(types.FXServerRouters),
+		),
+	),
+)
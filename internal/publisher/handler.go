@@ -0,0 +1,277 @@
+package publisher
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/api/common"
+	"github.com/pseudomuto/pacman/internal/auth"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/publisher/api"
+	"github.com/pseudomuto/pacman/internal/types"
+)
+
+// Handler implements the generated api.ServerInterface for publishing packages.
+type Handler struct {
+	auth *auth.Authenticator
+	pub  *Publisher
+}
+
+// NewHandler creates a new publisher API handler.
+func NewHandler(a *auth.Authenticator, p *Publisher) *Handler {
+	return &Handler{auth: a, pub: p}
+}
+
+// PublishGoModule implements api.ServerInterface.
+func (h *Handler) PublishGoModule(ctx *gin.Context) {
+	var req api.GoPublishRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	opts, err := h.publishOptions(&req)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	h.publish(ctx, opts)
+}
+
+// ReplaceGoModule implements api.ServerInterface.
+func (h *Handler) ReplaceGoModule(ctx *gin.Context) {
+	var req api.GoReplaceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	if strings.TrimSpace(req.Reason) == "" {
+		common.JSONError(ctx, http.StatusBadRequest, errors.New("a reason is required to replace a version"))
+		return
+	}
+
+	opts, err := h.publishOptions(&req.Publish)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	opts.Replace = &Replacement{Principal: auth.Principal(ctx), Reason: req.Reason}
+	h.publish(ctx, opts)
+}
+
+// RetractGoModule implements api.ServerInterface.
+func (h *Handler) RetractGoModule(ctx *gin.Context) {
+	var req api.RetractRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	var rationale string
+	if req.Rationale != nil {
+		rationale = *req.Rationale
+	}
+
+	if err := h.pub.Retract(ctx, req.Module, req.Versions, rationale); err != nil {
+		h.moduleError(ctx, err)
+		return
+	}
+
+	h.moduleStatus(ctx, req.Module)
+}
+
+// DeprecateGoModule implements api.ServerInterface.
+func (h *Handler) DeprecateGoModule(ctx *gin.Context) {
+	var req api.DeprecateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	if err := h.pub.Deprecate(ctx, req.Module, req.Message); err != nil {
+		h.moduleError(ctx, err)
+		return
+	}
+
+	h.moduleStatus(ctx, req.Module)
+}
+
+// GetGoModuleStatus implements api.ServerInterface.
+func (h *Handler) GetGoModuleStatus(ctx *gin.Context, params api.GetGoModuleStatusParams) {
+	h.moduleStatus(ctx, params.Module)
+}
+
+// RegisterRoutes implements types.Router interface.
+func (h *Handler) RegisterRoutes(engine *gin.Engine) {
+	api.RegisterHandlersWithOptions(engine, h, api.GinServerOptions{
+		Middlewares: []api.MiddlewareFunc{h.auth.Middleware()},
+	})
+}
+
+func (h *Handler) publish(ctx *gin.Context, opts PublishOptions) {
+	arch, err := h.pub.Publish(ctx, opts)
+	if err != nil {
+		var (
+			verr *ValidationError
+			cerr *ConflictError
+		)
+
+		switch {
+		case errors.As(err, &verr):
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, toValidationError(verr))
+		case errors.As(err, &cerr):
+			ctx.AbortWithStatusJSON(http.StatusConflict, toConflictError(cerr))
+		case errors.Is(err, ErrRecorded):
+			common.JSONError(ctx, http.StatusConflict, err)
+		case errors.Is(err, ErrUnknownTree), errors.Is(err, ErrFrozenTree):
+			common.JSONError(ctx, http.StatusBadRequest, err)
+		default:
+			common.JSONError(ctx, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toPublishedArchive(arch))
+}
+
+func (h *Handler) moduleStatus(ctx *gin.Context, path string) {
+	status, err := h.pub.ModuleStatus(ctx, path)
+	if err != nil {
+		common.JSONError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toModuleStatus(status))
+}
+
+func (h *Handler) moduleError(ctx *gin.Context, err error) {
+	if errors.Is(err, ErrInvalidModule) {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	common.JSONError(ctx, http.StatusInternalServerError, err)
+}
+
+func (h *Handler) publishOptions(req *api.GoPublishRequest) (PublishOptions, error) {
+	vcs, err := types.ParseVCSType(string(req.Vcs))
+	if err != nil {
+		return PublishOptions{}, err
+	}
+
+	storage, err := h.storage(req.Storage)
+	if err != nil {
+		return PublishOptions{}, err
+	}
+
+	opts := PublishOptions{
+		Type:    types.GoModule,
+		Storage: storage,
+		VCS:     vcs,
+		Repo:    req.Repo,
+		Ref:     req.Ref,
+		Package: req.Module,
+	}
+
+	if req.Subdir != nil {
+		opts.Subdir = *req.Subdir
+	}
+
+	if req.Version != nil {
+		opts.Version = *req.Version
+	}
+
+	if req.Trees != nil {
+		opts.Trees = *req.Trees
+	}
+
+	return opts, nil
+}
+
+func (h *Handler) storage(s *api.GoPublishRequestStorage) (types.StorageType, error) {
+	if s != nil {
+		return types.ParseStorageType(string(*s))
+	}
+
+	if len(h.pub.uploaders) == 0 {
+		return 0, errors.New("no storage configured for publishing")
+	}
+
+	return h.pub.uploaders[0].Type(), nil
+}
+
+func toPublishedArchive(a *ent.Archive) api.PublishedArchive {
+	res := api.PublishedArchive{
+		Coordinate: a.Coordinate,
+		Assets:     make([]api.Asset, len(a.Assets)),
+		CreatedAt:  a.CreatedAt,
+	}
+
+	for i := range a.Assets {
+		res.Assets[i] = api.Asset{
+			Type: api.AssetType(a.Assets[i].Type.String()),
+			Url:  a.Assets[i].URL,
+		}
+	}
+
+	return res
+}
+
+func toValidationError(e *ValidationError) api.ValidationError {
+	res := api.ValidationError{
+		Code:     http.StatusUnprocessableEntity,
+		Message:  e.Error(),
+		Problems: make([]api.Problem, len(e.Problems)),
+	}
+
+	for i, p := range e.Problems {
+		res.Problems[i] = api.Problem{Rule: api.ProblemRule(p.Rule), Message: p.Message}
+		if p.File != "" {
+			res.Problems[i].File = &p.File
+		}
+	}
+
+	return res
+}
+
+func toConflictError(e *ConflictError) api.ConflictError {
+	res := api.ConflictError{
+		Code:    http.StatusConflict,
+		Message: e.Error(),
+		Hash:    e.Hash,
+	}
+
+	if e.Existing != "" {
+		res.ExistingHash = &e.Existing
+	}
+
+	return res
+}
+
+func toModuleStatus(s *ModuleStatus) api.ModuleStatus {
+	res := api.ModuleStatus{
+		Module:    s.Path,
+		Retracted: make([]api.Retraction, len(s.Retracted)),
+	}
+
+	if s.Deprecated != "" {
+		res.Deprecated = &s.Deprecated
+	}
+
+	for i, r := range s.Retracted {
+		res.Retracted[i] = api.Retraction{
+			Version:     r.Version,
+			Rationale:   r.Rationale,
+			RetractedAt: r.CreatedAt,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,372 @@
+package publisher_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/archive"
+	"github.com/pseudomuto/pacman/internal/auth"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/packager"
+	. "github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/pseudomuto/pacman/internal/publisher/api"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_PublishGoModule(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	fetcher := NewMockVCSFetcher(ctrl)
+	fetcher.EXPECT().Type().Return(types.GitLab).AnyTimes()
+	expectRepoURL(fetcher, "https://gitlab.example.com")
+	fetcher.EXPECT().
+		FetchArchive(gomock.Any(), "test/repo", types.VCSOptions{Ref: "v1.0.0"}).
+		DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+			return archive.Compress(w, archive.TarGz, "../../testdata/gomodule", archive.PrefixComponents("repo"))
+		}).
+		Times(2)
+
+	uploader := NewMockUploader(ctrl)
+	uploader.EXPECT().Type().Return(types.GCS).AnyTimes()
+	uploader.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ io.Reader, name string) (string, error) {
+			return "gs://test-bucket/" + name, nil
+		}).
+		Times(2)
+
+	h := NewHandler(
+		newAuth(t, config.Token{Name: "ci", Token: "secret", Scopes: []string{auth.ScopePublish}}),
+		New(PublisherParams{
+			DB:          client,
+			Packagers:   []Packager{packager.NewGoModule()},
+			Uploaders:   []Uploader{uploader},
+			VCSFetchers: []VCSFetcher{fetcher},
+		}),
+	)
+
+	engine := gin.New()
+	h.RegisterRoutes(engine)
+
+	publish := func(token string, body any) *httptest.ResponseRecorder {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/api/v1/go/publish", bytes.NewReader(data))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		w := publish("nope", api.GoPublishRequest{})
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		w := publish("secret", map[string]string{"vcs": "svn"})
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("unknown tree", func(t *testing.T) {
+		w := publish("secret", api.GoPublishRequest{
+			Vcs:     api.Gitlab,
+			Repo:    "test/repo",
+			Ref:     "v1.0.0",
+			Module:  "testdata.io/gomodule",
+			Version: ptr("v1.0.0"),
+			Trees:   ptr([]string{"nope.sumdb.com"}),
+		})
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("published", func(t *testing.T) {
+		w := publish("secret", api.GoPublishRequest{
+			Vcs:     api.Gitlab,
+			Repo:    "test/repo",
+			Ref:     "v1.0.0",
+			Module:  "testdata.io/gomodule",
+			Version: ptr("v1.0.0"),
+		})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		var res api.PublishedArchive
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, "testdata.io/gomodule@v1.0.0", res.Coordinate)
+
+		arch := client.Archive.Query().OnlyX(t.Context())
+		require.Equal(t, []api.Asset{
+			{Type: api.Text, Url: "gs://test-bucket/" + assetName(t, arch, ".mod")},
+			{Type: api.Archive, Url: "gs://test-bucket/" + assetName(t, arch, ".zip")},
+		}, res.Assets)
+	})
+
+	t.Run("invalid module", func(t *testing.T) {
+		w := publish("secret", api.GoPublishRequest{
+			Vcs:     api.Gitlab,
+			Repo:    "test/repo",
+			Ref:     "v1.0.0",
+			Module:  "testdata.io/gomodule/v2",
+			Version: ptr("v2.0.0"),
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code, w.Body.String())
+
+		var res api.ValidationError
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		require.Equal(t, []api.Problem{{
+			Rule:    api.Module,
+			File:    ptr("go.mod"),
+			Message: `module path "testdata.io/gomodule" doesn't match "testdata.io/gomodule/v2"`,
+		}}, res.Problems)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestHandler_GoModuleStatus(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	h := NewHandler(
+		newAuth(
+			t,
+			config.Token{Name: "ci", Token: "secret", Scopes: []string{auth.ScopePublish}},
+			config.Token{Name: "ops", Token: "admin", Scopes: []string{auth.ScopeAdmin}},
+		),
+		New(PublisherParams{DB: client}),
+	)
+
+	engine := gin.New()
+	h.RegisterRoutes(engine)
+
+	do := func(method, path, token string, body any) *httptest.ResponseRecorder {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequestWithContext(t.Context(), method, path, bytes.NewReader(data))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	status := func(w *httptest.ResponseRecorder) api.ModuleStatus {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var res api.ModuleStatus
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		return res
+	}
+
+	t.Run("requires admin", func(t *testing.T) {
+		w := do(http.MethodPost, "/api/v1/go/modules/retract", "secret", api.RetractRequest{
+			Module:   "example.com/mod",
+			Versions: []string{"v1.0.0"},
+		})
+		require.Equal(t, http.StatusForbidden, w.Code)
+
+		w = do(http.MethodPost, "/api/v1/go/modules/deprecate", "secret", api.DeprecateRequest{
+			Module:  "example.com/mod",
+			Message: "nope",
+		})
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		w := do(http.MethodPost, "/api/v1/go/modules/retract", "admin", api.RetractRequest{
+			Module:   "example.com/mod",
+			Versions: []string{"latest"},
+		})
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("retract", func(t *testing.T) {
+		res := status(do(http.MethodPost, "/api/v1/go/modules/retract", "admin", api.RetractRequest{
+			Module:    "example.com/mod",
+			Versions:  []string{"v1.0.0"},
+			Rationale: ptr("Published accidentally."),
+		}))
+		require.Equal(t, "example.com/mod", res.Module)
+		require.Nil(t, res.Deprecated)
+		require.Len(t, res.Retracted, 1)
+		require.Equal(t, "v1.0.0", res.Retracted[0].Version)
+		require.Equal(t, "Published accidentally.", res.Retracted[0].Rationale)
+	})
+
+	t.Run("deprecate", func(t *testing.T) {
+		res := status(do(http.MethodPost, "/api/v1/go/modules/deprecate", "admin", api.DeprecateRequest{
+			Module:  "example.com/mod",
+			Message: "use example.com/other instead.",
+		}))
+		require.Equal(t, ptr("use example.com/other instead."), res.Deprecated)
+		require.Len(t, res.Retracted, 1)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		res := status(do(http.MethodGet, "/api/v1/go/modules/status?module=example.com/mod", "secret", nil))
+		require.Equal(t, ptr("use example.com/other instead."), res.Deprecated)
+		require.Len(t, res.Retracted, 1)
+
+		res = status(do(http.MethodGet, "/api/v1/go/modules/status?module=example.com/other", "admin", nil))
+		require.Nil(t, res.Deprecated)
+		require.Empty(t, res.Retracted)
+
+		w := do(http.MethodGet, "/api/v1/go/modules/status", "admin", nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandler_ReplaceGoModule(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	changed := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(changed, "go.mod"), []byte("module testdata.io/gomodule\n"), 0o600))
+
+	src := "../../testdata/gomodule"
+	fetcher := NewMockVCSFetcher(ctrl)
+	fetcher.EXPECT().Type().Return(types.GitLab).AnyTimes()
+	expectRepoURL(fetcher, "https://gitlab.example.com")
+	fetcher.EXPECT().
+		FetchArchive(gomock.Any(), "test/repo", types.VCSOptions{Ref: "v1.0.0"}).
+		DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+			return archive.Compress(w, archive.TarGz, src, archive.PrefixComponents("repo"))
+		}).
+		AnyTimes()
+
+	uploader := NewMockUploader(ctrl)
+	uploader.EXPECT().Type().Return(types.GCS).AnyTimes()
+	uploader.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ io.Reader, name string) (string, error) {
+			return "gs://test-bucket/" + name, nil
+		}).
+		AnyTimes()
+
+	h := NewHandler(
+		newAuth(
+			t,
+			config.Token{Name: "ci", Token: "secret", Scopes: []string{auth.ScopePublish}},
+			config.Token{Name: "ops", Token: "admin", Scopes: []string{auth.ScopeAdmin}},
+		),
+		New(PublisherParams{
+			DB:          client,
+			Packagers:   []Packager{packager.NewGoModule()},
+			Uploaders:   []Uploader{uploader},
+			VCSFetchers: []VCSFetcher{fetcher},
+		}),
+	)
+
+	engine := gin.New()
+	h.RegisterRoutes(engine)
+
+	post := func(path, token string, body any) *httptest.ResponseRecorder {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, path, bytes.NewReader(data))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	pubReq := api.GoPublishRequest{
+		Vcs:     api.Gitlab,
+		Repo:    "test/repo",
+		Ref:     "v1.0.0",
+		Module:  "testdata.io/gomodule",
+		Version: ptr("v1.0.0"),
+	}
+
+	w := post("/api/v1/go/publish", "secret", pubReq)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	t.Run("idempotent", func(t *testing.T) {
+		w := post("/api/v1/go/publish", "secret", pubReq)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		src = changed
+		t.Cleanup(func() { src = "../../testdata/gomodule" })
+
+		w := post("/api/v1/go/publish", "secret", pubReq)
+		require.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+		var res api.ConflictError
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, http.StatusConflict, res.Code)
+		require.NotEmpty(t, res.Hash)
+		require.NotNil(t, res.ExistingHash)
+		require.NotEqual(t, res.Hash, *res.ExistingHash)
+	})
+
+	t.Run("replace requires admin", func(t *testing.T) {
+		w := post("/api/v1/go/replace", "secret", api.GoReplaceRequest{Publish: pubReq, Reason: "broken"})
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("replace requires reason", func(t *testing.T) {
+		w := post("/api/v1/go/replace", "admin", api.GoReplaceRequest{Publish: pubReq})
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		src = changed
+		t.Cleanup(func() { src = "../../testdata/gomodule" })
+
+		w := post("/api/v1/go/replace", "admin", api.GoReplaceRequest{Publish: pubReq, Reason: "broken build"})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		audit := client.ArchiveReplacement.Query().OnlyX(t.Context())
+		require.Equal(t, "ops", audit.Principal)
+		require.Equal(t, "broken build", audit.Reason)
+	})
+}
+
+func newAuth(t *testing.T, tokens ...config.Token) *auth.Authenticator {
+	t.Helper()
+
+	// NB: Tokens are encrypted in the config.
+	for i := range tokens {
+		ct, err := crypto.Secret(tokens[i].Token).Value()
+		require.NoError(t, err)
+		tokens[i].Token = ct.(string)
+	}
+
+	a, err := auth.New(&config.Config{Auth: config.Auth{Tokens: tokens}})
+	require.NoError(t, err)
+	return a
+}
@@ -94,6 +94,34 @@ func (m *MockUploader) EXPECT() *MockUploaderMockRecorder {
 	return m.recorder
 }
 
+// Contains mocks base method.
+func (m *MockUploader) Contains(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Contains indicates an expected call of Contains.
+func (mr *MockUploaderMockRecorder) Contains(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockUploader)(nil).Contains), arg0)
+}
+
+// Read mocks base method.
+func (m *MockUploader) Read(arg0 context.Context, arg1 io.Writer, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockUploaderMockRecorder) Read(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockUploader)(nil).Read), arg0, arg1, arg2)
+}
+
 // Type mocks base method.
 func (m *MockUploader) Type() types.StorageType {
 	m.ctrl.T.Helper()
@@ -161,6 +189,35 @@ func (mr *MockVCSFetcherMockRecorder) FetchArchive(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchArchive", reflect.TypeOf((*MockVCSFetcher)(nil).FetchArchive), arg0, arg1, arg2)
 }
 
+// FetchCommit mocks base method.
+func (m *MockVCSFetcher) FetchCommit(arg0 string, arg1 types.VCSOptions) (*types.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchCommit", arg0, arg1)
+	ret0, _ := ret[0].(*types.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchCommit indicates an expected call of FetchCommit.
+func (mr *MockVCSFetcherMockRecorder) FetchCommit(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchCommit", reflect.TypeOf((*MockVCSFetcher)(nil).FetchCommit), arg0, arg1)
+}
+
+// RepoURL mocks base method.
+func (m *MockVCSFetcher) RepoURL(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepoURL", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RepoURL indicates an expected call of RepoURL.
+func (mr *MockVCSFetcherMockRecorder) RepoURL(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepoURL", reflect.TypeOf((*MockVCSFetcher)(nil).RepoURL), arg0)
+}
+
 // Type mocks base method.
 func (m *MockVCSFetcher) Type() types.VCSType {
 	m.ctrl.T.Helper()
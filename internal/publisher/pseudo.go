@@ -0,0 +1,41 @@
+package publisher
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pseudomuto/pacman/internal/types"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// pseudoVersion returns the pseudo-version for the commit, following the same rules as the go command. The base version
+// is the latest tag (for the module in opts.Subdir) reachable from the commit that's valid for the module's major
+// version. When there isn't one, the base is v0.0.0 (or vN.0.0 for paths with a /vN suffix).
+func pseudoVersion(opts PublishOptions, c *types.Commit) (string, error) {
+	_, pathMajor, ok := module.SplitPathVersion(opts.Package)
+	if !ok {
+		return "", fmt.Errorf("invalid module path: %s", opts.Package)
+	}
+
+	prefix := strings.Trim(path.Clean("/"+opts.Subdir), "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var base string
+	for _, tag := range c.Tags {
+		v, ok := strings.CutPrefix(tag, prefix)
+		if !ok || semver.Canonical(v) != v || module.IsPseudoVersion(v) {
+			continue
+		}
+
+		if module.CheckPathMajor(v, pathMajor) == nil && semver.Compare(v, base) > 0 {
+			base = v
+		}
+	}
+
+	major := strings.TrimSuffix(strings.TrimLeft(pathMajor, "/."), "-unstable")
+	return module.PseudoVersion(major, base, c.Time, c.SHA[:min(len(c.SHA), 12)]), nil
+}
@@ -1,30 +1,66 @@
 package publisher
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/pseudomuto/pacman/internal/archive"
+	"github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
+	entarchive "github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
 	"github.com/pseudomuto/pacman/internal/fsutil"
 	"github.com/pseudomuto/pacman/internal/types"
 	"go.uber.org/fx"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+var (
+	// ErrUnknownTree is returned when publishing to a sumdb tree which doesn't exist (or has been retired).
+	ErrUnknownTree = errors.New("unknown sumdb tree")
+	// ErrFrozenTree is returned when publishing to a sumdb tree which has been frozen.
+	ErrFrozenTree = errors.New("sumdb tree is frozen")
 )
 
 type (
 	PublisherParams struct {
 		fx.In
 
+		DB          *ent.Client
 		Packagers   []Packager   `group:"publisher_packagers"`
 		Uploaders   []Uploader   `group:"publisher_uploaders"`
 		VCSFetchers []VCSFetcher `group:"publisher_vcs_fetchers"`
+		// Recorder adds published Go modules to the sumdb trees named by PublishOptions.Trees. When nil, they're only
+		// recorded when first looked up.
+		Recorder Recorder `optional:"true"`
+		// Invalidators are notified when a module is published or retracted.
+		Invalidators []Invalidator `group:"publisher_invalidators"`
 	}
 
 	Publisher struct {
+		db        *ent.Client
 		archivers []Packager
 		uploaders []Uploader
 		vcs       []VCSFetcher
+		recorder  Recorder
+		notify    []Invalidator
 	}
 
 	PublishOptions struct {
@@ -36,7 +72,20 @@ type (
 		Subdir      string
 		Package     string
 		Description string
-		Version     string
+		// Version to publish. When empty, Ref is resolved to a commit and published as a pseudo-version.
+		Version string
+		// Trees are the names of the sumdb trees to record Go modules in when they're published. When empty, modules are
+		// only recorded when first looked up (so they can still be replaced until then).
+		Trees []string
+		// Replace allows replacing the contents of a published version. When nil, publishing a version which already
+		// exists with different contents fails with a *ConflictError.
+		Replace *Replacement
+	}
+
+	// Replacement describes who is replacing a published version, and why. It's recorded as an ArchiveReplacement.
+	Replacement struct {
+		Principal string
+		Reason    string
 	}
 
 	Packager interface {
@@ -47,37 +96,97 @@ type (
 	Uploader interface {
 		Type() types.StorageType
 		Write(context.Context, io.Reader, string) (string, error)
+		// Contains reports whether the URI (as returned by Write) is in the Uploader's storage.
+		Contains(string) bool
+		// Read writes the contents of the URI (as returned by Write) to w.
+		Read(context.Context, io.Writer, string) error
+	}
+
+	// Recorder appends the record for a published Go module to a sumdb tree as part of the publish transaction. When the
+	// tree already has a different record for the module, the error must wrap ErrRecorded. Errors wrapping
+	// data.ErrConflict retry the publish transaction.
+	Recorder interface {
+		Record(ctx context.Context, tx *ent.Tx, tree *ent.SumDBTree, mod module.Version, zipHash, modHash string) error
+	}
+
+	// Invalidator drops cached state for a module when it changes (i.e. it's published or retracted).
+	Invalidator interface {
+		Invalidate(path string)
 	}
 
 	VCSFetcher interface {
 		Type() types.VCSType
 		FetchArchive(io.Writer, string, types.VCSOptions) error
+		FetchCommit(string, types.VCSOptions) (*types.Commit, error)
+		// RepoURL returns the URL of repo (as given in PublishOptions.Repo), which is reported in its Origin.
+		RepoURL(string) string
+	}
+
+	// checksums are the h1: hashes of a Go module's zip and go.mod, as recorded in sumdb trees.
+	checksums struct {
+		zip string
+		mod string
 	}
 )
 
 func New(p PublisherParams) *Publisher {
 	return &Publisher{
+		db:        p.DB,
 		archivers: p.Packagers,
 		uploaders: p.Uploaders,
 		vcs:       p.VCSFetchers,
+		recorder:  p.Recorder,
+		notify:    p.Invalidators,
 	}
 }
 
-func (p *Publisher) Publish(ctx context.Context, opts PublishOptions) error {
+// Publish fetches the source for opts from VCS, builds the package and uploads its assets to storage. Once uploaded,
+// the Archive (and its Assets) are recorded so the package can be served by the proxies and sumdb trees. Go modules are
+// added to the sumdb trees named by opts.Trees in the same transaction, rather than when first looked up.
+//
+// Published versions are immutable. Publishing the same contents again returns the existing Archive (adding it to any
+// of opts.Trees which don't have it yet), while different contents fail with a *ConflictError unless opts.Replace is
+// set. Versions which have been recorded in a sumdb tree can't be replaced (see ErrRecorded).
+func (p *Publisher) Publish(ctx context.Context, opts PublishOptions) (*ent.Archive, error) {
 	packer, err := p.packager(opts.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	fetcher, err := p.fetcher(opts.VCS)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	uploader, err := p.uploader(opts.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := findTrees(ctx, p.db.SumDBTree, opts.Trees); err != nil {
+		return nil, err
+	}
+
+	orig := origin(fetcher, opts)
+	if opts.Version == "" {
+		commit, err := fetcher.FetchCommit(opts.Repo, types.VCSOptions{Ref: opts.Ref, Dir: opts.Subdir})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve ref: %s@%s, %w", opts.Repo, opts.Ref, err)
+		}
+
+		if opts.Version, err = pseudoVersion(opts, commit); err != nil {
+			return nil, err
+		}
+
+		// NB: Pin the commit so the archive matches the version, even if the ref (e.g. a branch) moves.
+		orig.Hash = commit.SHA
+	}
+
+	var arch *ent.Archive
 	if err := fsutil.WithTempFile(func(tgz *os.File) error {
 		// Download archive from VCS
 		if err := fetcher.FetchArchive(tgz, opts.Repo, types.VCSOptions{
-			Ref: opts.Ref,
+			Ref: cmp.Or(orig.Hash, opts.Ref),
 			Dir: opts.Subdir,
 		}); err != nil {
 			return fmt.Errorf("failed to download archive from VCS: %w", err)
@@ -93,21 +202,13 @@ func (p *Publisher) Publish(ctx context.Context, opts PublishOptions) error {
 				return fmt.Errorf("failed to extract archive: %w", err)
 			}
 
+			// NB: VCS archives retain the path to the subdir, the package lives beneath it.
+			pkgDir := filepath.Join(dir, opts.Subdir)
+
 			// Build package archive and publish
 			if err := fsutil.WithTempFile(func(pkg *os.File) error {
-				if err := packer.Package(ctx, pkg, types.PackageOptions{
-					Dir:     dir,
-					Package: opts.Package,
-					Version: opts.Version,
-				}); err != nil {
-					return fmt.Errorf("failed creating %s package: %w", packer.Type().String(), err)
-				}
-
-				if _, err := pkg.Seek(0, 0); err != nil {
-					return fmt.Errorf("failed to seek to beginning of package: %w", err)
-				}
-
-				return nil
+				arch, err = p.publish(ctx, packer, uploader, opts, orig, pkgDir, pkg)
+				return err
 			}); err != nil {
 				return fmt.Errorf("failed to write package: %w", err)
 			}
@@ -119,9 +220,401 @@ func (p *Publisher) Publish(ctx context.Context, opts PublishOptions) error {
 
 		return nil
 	}); err != nil {
+		return nil, err
+	}
+
+	p.invalidate(opts.Package)
+	return arch, nil
+}
+
+// publish packages the source in dir (writing it to pkg) and stores it, unless the version has already been published.
+//
+// Publishing is idempotent: when the version exists with the same h1: dirhash, the existing Archive is returned.
+// Otherwise, a *ConflictError is returned unless opts.Replace is set. The source is stored as is, so the go.mod served
+// (and its checksum) always matches the VCS.
+func (p *Publisher) publish(
+	ctx context.Context,
+	packer Packager,
+	up Uploader,
+	opts PublishOptions,
+	orig *schema.Origin,
+	dir string,
+	pkg *os.File,
+) (*ent.Archive, error) {
+	pkgOpts := types.PackageOptions{
+		Dir:     dir,
+		Package: opts.Package,
+		Version: opts.Version,
+	}
+
+	if err := pack(ctx, packer, pkg, pkgOpts); err != nil {
+		return nil, err
+	}
+
+	hash, err := dirhash.HashZip(pkg.Name(), dirhash.Hash1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash package: %w", err)
+	}
+
+	mod := module.Version{Path: opts.Package, Version: opts.Version}
+	existing, err := p.findArchive(ctx, opts.Type, mod)
+	if err != nil {
+		return nil, err
+	}
+
+	// NB: Modules cached from upstream (see goproxy.WithCache) haven't been published, so they're simply replaced.
+	// Their contents may still have been recorded in a sumdb tree though, which can't be changed.
+	if existing != nil && existing.Cached {
+		if err := p.checkReplaceable(ctx, mod); err != nil {
+			return nil, err
+		}
+	} else if existing != nil {
+		if existing.Hash == hash {
+			if err := p.recordExisting(ctx, opts, dir, pkg); err != nil {
+				return nil, err
+			}
+
+			return existing, nil
+		}
+
+		if opts.Replace == nil {
+			return nil, &ConflictError{Package: mod.Path, Version: mod.Version, Hash: hash, Existing: existing.Hash}
+		}
+
+		if err := p.checkReplaceable(ctx, mod); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := pkg.Seek(0, 0); err != nil {
+		return nil, fmt.Errorf("failed to seek to beginning of package: %w", err)
+	}
+
+	return p.store(ctx, up, opts, orig, dir, pkg, hash, existing)
+}
+
+// store uploads the go.mod (from dir) and package archive, then records the Archive, its Assets and (for Go modules)
+// the sumdb record.
+func (p *Publisher) store(
+	ctx context.Context,
+	up Uploader,
+	opts PublishOptions,
+	orig *schema.Origin,
+	dir string,
+	pkg *os.File,
+	hash string,
+	existing *ent.Archive,
+) (*ent.Archive, error) {
+	mod := module.Version{Path: opts.Package, Version: opts.Version}
+	base, err := assetPath(opts.Type, mod, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	goMod, err := readGoMod(dir, mod.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sums *checksums
+	if opts.Type == types.GoModule && p.recorder != nil {
+		if sums, err = moduleChecksums(mod, pkg.Name(), goMod); err != nil {
+			return nil, err
+		}
+	}
+
+	modURI, err := up.Write(ctx, bytes.NewReader(goMod), base+".mod")
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload go.mod: %s, %w", mod, err)
+	}
+
+	zipURI, err := up.Write(ctx, pkg, base+".zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload package: %s, %w", mod, err)
+	}
+
+	assets := []schema.AssetURL{
+		{Type: types.TextFile, URL: modURI},
+		{Type: types.Archive, URL: zipURI},
+	}
+
+	arch, err := p.record(ctx, opts, orig, assets, hash, existing, sums)
+
+	// NB: Another publish of the same version won the race. Uploads are named by their hash (see assetPath), so the
+	// winner's assets are untouched, and ours are simply left unreferenced.
+	if ent.IsConstraintError(err) && existing == nil {
+		if existing, ferr := p.findArchive(ctx, opts.Type, mod); ferr == nil && existing != nil && existing.Hash == hash {
+			return existing, nil
+		}
+
+		return nil, &ConflictError{Package: mod.Path, Version: mod.Version, Hash: hash}
+	}
+
+	return arch, err
+}
+
+// record creates the Archive (and its Assets), then records the module's checksums (when set) in the selected sumdb
+// trees. When existing is set, it's updated instead and the replacement is recorded.
+func (p *Publisher) record(
+	ctx context.Context,
+	opts PublishOptions,
+	orig *schema.Origin,
+	assets []schema.AssetURL,
+	hash string,
+	existing *ent.Archive,
+	sums *checksums,
+) (*ent.Archive, error) {
+	mod := module.Version{Path: opts.Package, Version: opts.Version}
+	// NB: Recording the module can conflict with concurrent appends to the trees, in which case it's retried.
+	return data.WithRetry(ctx, p.db, func(tx *ent.Tx) (*ent.Archive, error) {
+		if err := createAssets(ctx, tx, assets); err != nil {
+			return nil, fmt.Errorf("failed to create assets: %s, %w", mod, err)
+		}
+
+		arch, err := saveArchive(ctx, tx, opts, orig, assets, hash, existing)
+		if err != nil {
+			return nil, err
+		}
+
+		if sums == nil {
+			return arch, nil
+		}
+
+		if err := p.recordTrees(ctx, tx, opts.Trees, mod, sums); err != nil {
+			return nil, err
+		}
+
+		return arch, nil
+	})
+}
+
+// recordExisting records an already published module (whose package is in pkg) in the sumdb trees named by opts.Trees.
+// Trees which already have it are left alone.
+func (p *Publisher) recordExisting(ctx context.Context, opts PublishOptions, dir string, pkg *os.File) error {
+	if opts.Type != types.GoModule || p.recorder == nil || len(opts.Trees) == 0 {
+		return nil
+	}
+
+	mod := module.Version{Path: opts.Package, Version: opts.Version}
+	goMod, err := readGoMod(dir, mod.Path)
+	if err != nil {
+		return err
+	}
+
+	sums, err := moduleChecksums(mod, pkg.Name(), goMod)
+	if err != nil {
+		return err
+	}
+
+	_, err = data.WithRetry(ctx, p.db, func(tx *ent.Tx) (*struct{}, error) {
+		return nil, p.recordTrees(ctx, tx, opts.Trees, mod, sums)
+	})
+
+	return err
+}
+
+// recordTrees records mod in the named sumdb trees.
+func (p *Publisher) recordTrees(
+	ctx context.Context,
+	tx *ent.Tx,
+	names []string,
+	mod module.Version,
+	sums *checksums,
+) error {
+	trees, err := findTrees(ctx, tx.SumDBTree, names)
+	if err != nil {
 		return err
 	}
 
+	for _, tree := range trees {
+		if err := p.recorder.Record(ctx, tx, tree, mod, sums.zip, sums.mod); err != nil {
+			return fmt.Errorf("failed to record module in sumdb: %s, %w", tree.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// saveArchive creates the Archive, or updates existing when set. Replacing a published Archive is recorded, while
+// cached ones are simply taken over.
+func saveArchive(
+	ctx context.Context,
+	tx *ent.Tx,
+	opts PublishOptions,
+	orig *schema.Origin,
+	assets []schema.AssetURL,
+	hash string,
+	existing *ent.Archive,
+) (*ent.Archive, error) {
+	mod := module.Version{Path: opts.Package, Version: opts.Version}
+	if existing == nil {
+		arch, err := tx.Archive.Create().
+			SetType(opts.Type).
+			SetCoordinate(mod.String()).
+			SetAssets(assets).
+			SetReleasedAt(time.Now().UTC()).
+			SetOrigin(orig).
+			SetHash(hash).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create archive: %s, %w", mod, err)
+		}
+
+		return arch, nil
+	}
+
+	if !existing.Cached {
+		if err := tx.ArchiveReplacement.Create().
+			SetCoordinate(mod.String()).
+			SetPreviousHash(existing.Hash).
+			SetHash(hash).
+			SetPrincipal(opts.Replace.Principal).
+			SetReason(opts.Replace.Reason).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to record replacement: %s, %w", mod, err)
+		}
+	}
+
+	arch, err := tx.Archive.UpdateOne(existing).
+		SetAssets(assets).
+		SetReleasedAt(time.Now().UTC()).
+		SetOrigin(orig).
+		SetHash(hash).
+		SetCached(false).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace archive: %s, %w", mod, err)
+	}
+
+	return arch, nil
+}
+
+// findTrees returns the sumdb trees with the given names. An ErrUnknownTree is returned when any of them don't exist
+// (or have been retired), and an ErrFrozenTree when any of them are frozen.
+func findTrees(ctx context.Context, db *ent.SumDBTreeClient, names []string) ([]*ent.SumDBTree, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	trees, err := db.Query().
+		Where(
+			sumdbtree.StatusNEQ(sumdbtree.StatusRetired),
+			sumdbtree.NameIn(names...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sumdb trees: %w", err)
+	}
+
+	for _, name := range names {
+		idx := slices.IndexFunc(trees, func(t *ent.SumDBTree) bool { return t.Name == name })
+		if idx == -1 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTree, name)
+		}
+
+		if trees[idx].Status == sumdbtree.StatusFrozen {
+			return nil, fmt.Errorf("%w: %s", ErrFrozenTree, name)
+		}
+	}
+
+	return trees, nil
+}
+
+// moduleChecksums computes the checksums of mod from the package zip (at path) and its go.mod.
+func moduleChecksums(mod module.Version, path string, goMod []byte) (*checksums, error) {
+	zipHash, err := dirhash.HashZip(path, dirhash.Hash1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash package: %s, %w", mod, err)
+	}
+
+	modHash, err := dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(goMod)), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash go.mod: %s, %w", mod, err)
+	}
+
+	return &checksums{zip: zipHash, mod: modHash}, nil
+}
+
+// invalidate notifies the Invalidators that the module at path has changed.
+func (p *Publisher) invalidate(path string) {
+	for _, inv := range p.notify {
+		inv.Invalidate(path)
+	}
+}
+
+// findArchive returns the Archive for mod, or nil when it hasn't been published.
+func (p *Publisher) findArchive(ctx context.Context, t types.ArchiveType, mod module.Version) (*ent.Archive, error) {
+	arch, err := p.db.Archive.Query().
+		Where(
+			entarchive.TypeEQ(t),
+			entarchive.Coordinate(mod.String()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to find archive: %s, %w", mod, err)
+	}
+
+	return arch, nil
+}
+
+// checkReplaceable returns ErrRecorded when mod has been recorded in any sumdb tree, either when it was published (see
+// PublishOptions.Trees) or when it was first looked up. Recorded checksums can never change, so neither can the module.
+func (p *Publisher) checkReplaceable(ctx context.Context, mod module.Version) error {
+	recorded, err := p.db.SumDBRecord.Query().
+		Where(
+			sumdbrecord.Path(mod.Path),
+			sumdbrecord.Version(mod.Version),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find sumdb records: %s, %w", mod, err)
+	}
+
+	if recorded {
+		return fmt.Errorf("%w: %s", ErrRecorded, mod)
+	}
+
+	return nil
+}
+
+// pack (re)writes the package to pkg.
+func pack(ctx context.Context, packer Packager, pkg *os.File, opts types.PackageOptions) error {
+	if err := pkg.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate package: %w", err)
+	}
+
+	if _, err := pkg.Seek(0, 0); err != nil {
+		return fmt.Errorf("failed to seek to beginning of package: %w", err)
+	}
+
+	if err := packer.Package(ctx, pkg, opts); err != nil {
+		return fmt.Errorf("failed creating %s package: %w", packer.Type().String(), err)
+	}
+
+	return nil
+}
+
+// createAssets creates the Assets which don't already exist (e.g. when replacing an Archive).
+func createAssets(ctx context.Context, tx *ent.Tx, assets []schema.AssetURL) error {
+	for _, a := range assets {
+		exists, err := tx.Asset.Query().Where(asset.TypeEQ(a.Type), asset.URI(a.URL)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			if err := tx.Asset.Create().SetType(a.Type).SetURI(a.URL).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -144,3 +637,62 @@ func (p *Publisher) fetcher(t types.VCSType) (VCSFetcher, error) {
 
 	return nil, fmt.Errorf("unknown fetcher: %d", t)
 }
+
+func (p *Publisher) uploader(t types.StorageType) (Uploader, error) {
+	for _, up := range p.uploaders {
+		if up.Type() == t {
+			return up, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown uploader: %d", t)
+}
+
+// assetPath returns the storage path (sans extension) for the assets of mod, given the h1: hash of its contents. This
+// mirrors the GOPROXY layout, with the hex encoded hash as the file name, e.g.
+// gomod/github.com/!some/module/@v/v1.0.0/<hash>.
+//
+// NB: Naming assets by their contents means concurrent (or replacing) publishes of the same version never overwrite
+// assets referenced by another Archive.
+func assetPath(t types.ArchiveType, mod module.Version, hash string) (string, error) {
+	path, err := module.EscapePath(mod.Path)
+	if err != nil {
+		return "", fmt.Errorf("invalid module path: %s, %w", mod.Path, err)
+	}
+
+	version, err := module.EscapeVersion(mod.Version)
+	if err != nil {
+		return "", fmt.Errorf("invalid module version: %s, %w", mod.Version, err)
+	}
+
+	sum, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(hash, "h1:"))
+	if err != nil {
+		return "", fmt.Errorf("invalid hash: %s, %w", hash, err)
+	}
+
+	return t.String() + "/" + path + "/@v/" + version + "/" + hex.EncodeToString(sum), nil
+}
+
+// origin describes the VCS location opts was published from. All supported VCS types are git based.
+func origin(fetcher VCSFetcher, opts PublishOptions) *schema.Origin {
+	return &schema.Origin{
+		VCS:    "git",
+		URL:    fetcher.RepoURL(opts.Repo),
+		Subdir: opts.Subdir,
+		Ref:    opts.Ref,
+	}
+}
+
+// readGoMod reads the go.mod file in dir. When missing, a minimal go.mod is synthesized as per the GOPROXY protocol.
+func readGoMod(dir, path string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod")) //nolint:gosec // dir is our extraction dir
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []byte("module " + modfile.AutoQuote(path) + "\n"), nil
+		}
+
+		return nil, fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	return data, nil
+}
@@ -3,15 +3,44 @@ package publisher_test
 //go:generate go tool mockgen -destination=mocks_test.go -package=publisher_test . Packager,Uploader,VCSFetcher
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/pseudomuto/pacman/internal/archive"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent"
+	entarchive "github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/asset"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
 	"github.com/pseudomuto/pacman/internal/packager"
 	. "github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/pseudomuto/pacman/internal/storage"
+	"github.com/pseudomuto/pacman/internal/sumdb"
 	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/pseudomuto/pacman/internal/vcs"
+	sdb "github.com/pseudomuto/sumdb"
 	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/dirhash"
 )
 
 func TestPublisher_Publish(t *testing.T) {
@@ -20,11 +49,15 @@ func TestPublisher_Publish(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
 	fetcher := NewMockVCSFetcher(ctrl)
 	uploader := NewMockUploader(ctrl)
 
 	t.Run("go module", func(t *testing.T) {
 		publisher := New(PublisherParams{
+			DB:          client,
 			Packagers:   []Packager{packager.NewGoModule()},
 			Uploaders:   []Uploader{uploader},
 			VCSFetchers: []VCSFetcher{fetcher},
@@ -37,12 +70,13 @@ func TestPublisher_Publish(t *testing.T) {
 			Repo:        "test/repo",
 			Ref:         "abcdef12345",
 			Subdir:      "sub/dir/project",
-			Package:     "github.com/pseudomuto/test",
+			Package:     "testdata.io/gomodule",
 			Description: "My gomod package",
 			Version:     "v1.2.3",
 		}
 
 		fetcher.EXPECT().Type().Return(pubOpts.VCS)
+		fetcher.EXPECT().RepoURL(pubOpts.Repo).Return("https://ghe.example.com/test/repo")
 
 		fetcher.EXPECT().
 			FetchArchive(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -66,7 +100,46 @@ func TestPublisher_Publish(t *testing.T) {
 				)
 			})
 
-		require.NoError(t, publisher.Publish(t.Context(), pubOpts))
+		uploads := make(map[string][]byte)
+		uploader.EXPECT().Type().Return(pubOpts.Storage)
+		uploader.EXPECT().
+			Write(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, r io.Reader, name string) (string, error) {
+				data, err := io.ReadAll(r)
+				require.NoError(t, err)
+
+				uploads[name] = data
+				return "gs://test-bucket/" + name, nil
+			}).
+			Times(2)
+
+		arch, err := publisher.Publish(t.Context(), pubOpts)
+		require.NoError(t, err)
+		require.Equal(t, types.GoModule, arch.Type)
+		require.Equal(t, "testdata.io/gomodule@v1.2.3", arch.Coordinate)
+		require.Equal(t, []schema.AssetURL{
+			{Type: types.TextFile, URL: "gs://test-bucket/" + assetName(t, arch, ".mod")},
+			{Type: types.Archive, URL: "gs://test-bucket/" + assetName(t, arch, ".zip")},
+		}, arch.Assets)
+		require.NotNil(t, arch.ReleasedAt)
+		require.Equal(t, &schema.Origin{
+			VCS:    "git",
+			URL:    "https://ghe.example.com/test/repo",
+			Subdir: "sub/dir/project",
+			Ref:    "abcdef12345",
+		}, arch.Origin)
+
+		require.True(t, bytes.HasPrefix(
+			uploads[assetName(t, arch, ".mod")],
+			[]byte("module testdata.io/gomodule"),
+		))
+		require.NotEmpty(t, uploads[assetName(t, arch, ".zip")])
+
+		n, err := client.Asset.Query().
+			Where(asset.URIIn(arch.Assets[0].URL, arch.Assets[1].URL)).
+			Count(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
 	})
 
 	t.Run("misconfigured", func(t *testing.T) {
@@ -79,19 +152,735 @@ func TestPublisher_Publish(t *testing.T) {
 
 		t.Run("unknown packager", func(t *testing.T) {
 			packager.EXPECT().Type().Return(types.GoModule)
-			require.EqualError(t, publisher.Publish(t.Context(), PublishOptions{
+			_, err := publisher.Publish(t.Context(), PublishOptions{
 				Type: types.ArchiveType(100),
-			}), "unknown packager: 100")
+			})
+			require.EqualError(t, err, "unknown packager: 100")
 		})
 
 		t.Run("unknown VCS fetcher", func(t *testing.T) {
 			packager.EXPECT().Type().Return(types.GoModule)
 			fetcher.EXPECT().Type().Return(types.GitHub)
 
-			require.EqualError(t, publisher.Publish(t.Context(), PublishOptions{
+			_, err := publisher.Publish(t.Context(), PublishOptions{
 				Type: types.GoModule,
 				VCS:  types.VCSType(100),
-			}), "unknown fetcher: 100")
+			})
+			require.EqualError(t, err, "unknown fetcher: 100")
+		})
+
+		t.Run("unknown uploader", func(t *testing.T) {
+			packager.EXPECT().Type().Return(types.GoModule)
+			fetcher.EXPECT().Type().Return(types.GitHub)
+			uploader.EXPECT().Type().Return(types.GCS)
+
+			_, err := publisher.Publish(t.Context(), PublishOptions{
+				Type:    types.GoModule,
+				VCS:     types.GitHub,
+				Storage: types.FileSystem,
+			})
+			require.EqualError(t, err, "unknown uploader: 0")
+		})
+	})
+}
+
+func TestPublisher_Publish_PseudoVersion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	sha := "daa7c04131f5e0a1b2c3d4e5f60718293a4b5c6d"
+	commitTime := time.Date(2019, 11, 9, 2, 19, 31, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		pkg     string
+		subdir  string
+		tags    []string
+		version string
+	}{
+		{
+			name:    "no tags",
+			pkg:     "example.com/mod",
+			version: "v0.0.0-20191109021931-daa7c04131f5",
+		},
+		{
+			name:    "no tags with major suffix",
+			pkg:     "example.com/mod/v3",
+			version: "v3.0.0-20191109021931-daa7c04131f5",
+		},
+		{
+			name:    "latest release",
+			pkg:     "example.com/mod",
+			tags:    []string{"v0.9.0", "v1.2.3", "v1.10.0", "v1.2.4-rc.1"},
+			version: "v1.10.1-0.20191109021931-daa7c04131f5",
+		},
+		{
+			name:    "latest prerelease",
+			pkg:     "example.com/mod",
+			tags:    []string{"v1.2.3", "v1.3.0-rc.1"},
+			version: "v1.3.0-rc.1.0.20191109021931-daa7c04131f5",
+		},
+		{
+			name:    "ignores other majors and invalid tags",
+			pkg:     "example.com/mod",
+			tags:    []string{"v1.0.0", "v2.0.0", "v2.1.0+incompatible", "v1.1", "v1.5.0-0.20190101000000-abcdefabcdef"},
+			version: "v1.0.1-0.20191109021931-daa7c04131f5",
+		},
+		{
+			name:    "major suffix",
+			pkg:     "example.com/mod/v2",
+			tags:    []string{"v1.9.0", "v2.0.1", "v3.0.0"},
+			version: "v2.0.2-0.20191109021931-daa7c04131f5",
+		},
+		{
+			name:    "subdir tags",
+			pkg:     "example.com/repo/sub",
+			subdir:  "sub",
+			tags:    []string{"v5.0.0", "sub/v0.2.0", "subdir/v0.3.0"},
+			version: "v0.2.1-0.20191109021931-daa7c04131f5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkgr := NewMockPackager(ctrl)
+			fetcher := NewMockVCSFetcher(ctrl)
+			uploader := NewMockUploader(ctrl)
+
+			pkgr.EXPECT().Type().Return(types.GoModule).AnyTimes()
+			fetcher.EXPECT().Type().Return(types.GitHub)
+			expectRepoURL(fetcher, "https://github.com")
+			uploader.EXPECT().Type().Return(types.GCS)
+
+			opts := types.VCSOptions{Ref: "feature/thing", Dir: tt.subdir}
+			fetcher.EXPECT().
+				FetchCommit("test/repo", opts).
+				Return(&types.Commit{SHA: sha, Time: commitTime, Tags: tt.tags}, nil)
+
+			opts.Ref = sha
+			fetcher.EXPECT().
+				FetchArchive(gomock.Any(), "test/repo", opts).
+				DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+					return archive.Compress(w, archive.TarGz, "../../testdata/gomodule", archive.PrefixComponents("repo"))
+				})
+
+			var version string
+			pkgr.EXPECT().
+				Package(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ io.Writer, opts types.PackageOptions) error {
+					version = opts.Version
+					return errors.New("boom")
+				})
+
+			_, err := New(PublisherParams{
+				DB:          client,
+				Packagers:   []Packager{pkgr},
+				Uploaders:   []Uploader{uploader},
+				VCSFetchers: []VCSFetcher{fetcher},
+			}).Publish(t.Context(), PublishOptions{
+				Type:    types.GoModule,
+				Storage: types.GCS,
+				VCS:     types.GitHub,
+				Repo:    "test/repo",
+				Ref:     "feature/thing",
+				Subdir:  tt.subdir,
+				Package: tt.pkg,
+			})
+			require.ErrorContains(t, err, "boom")
+			require.Equal(t, tt.version, version)
+		})
+	}
+}
+
+func TestPublisher_Publish_Git(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	// A local git repo with the test module in a subdir.
+	repo := t.TempDir()
+	require.NoError(t, os.CopyFS(filepath.Join(repo, "mod"), os.DirFS("../../testdata/gomodule")))
+	git := func(args ...string) string {
+		cmd := exec.CommandContext(t.Context(), "git", args...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+			"GIT_COMMITTER_DATE=2025-01-02T03:04:05Z")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"add", "."},
+		{"commit", "-qm", "initial"},
+		{"tag", "v0.1.0"},
+		{"tag", "mod/v0.1.0"},
+		{"tag", "mod/v1.0.0-rc.1"},
+		{"commit", "-q", "--allow-empty", "-m", "second"},
+	} {
+		git(args...)
+	}
+
+	bucket := "file://" + t.TempDir()
+	require.NoError(t, storage.RegisterBuckets(t.Context(), bucket))
+	uploader, err := storage.NewUploader(bucket)
+	require.NoError(t, err)
+
+	publisher := New(PublisherParams{
+		DB:          client,
+		Packagers:   []Packager{packager.NewGoModule()},
+		Uploaders:   []Uploader{uploader},
+		VCSFetchers: []VCSFetcher{vcs.NewGit("")},
+	})
+
+	arch, err := publisher.Publish(t.Context(), PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.FileSystem,
+		VCS:     types.Git,
+		Repo:    "file://" + repo,
+		Ref:     "v0.1.0",
+		Subdir:  "mod",
+		Package: "testdata.io/gomodule",
+		Version: "v0.1.0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "testdata.io/gomodule@v0.1.0", arch.Coordinate)
+	require.Equal(t, "file://"+repo, arch.Origin.URL)
+
+	var buf bytes.Buffer
+	require.NoError(t, storage.Read(t.Context(), &buf, arch.Assets[0].URL))
+	require.Contains(t, buf.String(), "module testdata.io/gomodule")
+
+	// Untagged commits are published as pseudo-versions.
+	arch, err = publisher.Publish(t.Context(), PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.FileSystem,
+		VCS:     types.Git,
+		Repo:    "file://" + repo,
+		Ref:     "HEAD",
+		Subdir:  "mod",
+		Package: "testdata.io/gomodule",
+		Version: "v0.1.1-0.20191109021931-daa7c04131f5",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "testdata.io/gomodule@v0.1.1-0.20191109021931-daa7c04131f5", arch.Coordinate)
+
+	// Without a version, the ref is published as a pseudo-version of the latest tag for the module.
+	head := git("rev-parse", "main")
+	arch, err = publisher.Publish(t.Context(), PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.FileSystem,
+		VCS:     types.Git,
+		Repo:    "file://" + repo,
+		Ref:     "main",
+		Subdir:  "mod",
+		Package: "testdata.io/gomodule",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "testdata.io/gomodule@v1.0.0-rc.1.0.20250102030405-"+head[:12], arch.Coordinate)
+	require.Equal(t, head, arch.Origin.Hash)
+	require.Equal(t, "main", arch.Origin.Ref)
+}
+
+func TestPublisher_Publish_GitLab(t *testing.T) {
+	// NB: Not parallel, since storage buckets are registered globally.
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	var tarball bytes.Buffer
+	require.NoError(t, archive.Compress(
+		&tarball,
+		archive.TarGz,
+		"../../testdata/gomodule",
+		archive.PrefixComponents("repo-abc123", "mod"),
+	))
+
+	gl := gitlabtesting.NewTestClient(t)
+	gl.MockRepositories.EXPECT().
+		Archive("group/repo", &gitlab.ArchiveOptions{
+			Format: gitlab.Ptr("tar.gz"),
+			Path:   gitlab.Ptr("mod"),
+			SHA:    gitlab.Ptr("v1.0.0"),
+		}).
+		Return(tarball.Bytes(), nil, nil)
+
+	bucket := "file://" + t.TempDir()
+	require.NoError(t, storage.RegisterBuckets(t.Context(), bucket))
+	uploader, err := storage.NewUploader(bucket)
+	require.NoError(t, err)
+
+	publisher := New(PublisherParams{
+		DB:          client,
+		Packagers:   []Packager{packager.NewGoModule()},
+		Uploaders:   []Uploader{uploader},
+		VCSFetchers: []VCSFetcher{vcs.NewGitLabWithClient(gl.Client, "https://gitlab.example.com/")},
+	})
+
+	arch, err := publisher.Publish(t.Context(), PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.FileSystem,
+		VCS:     types.GitLab,
+		Repo:    "group/repo",
+		Ref:     "v1.0.0",
+		Subdir:  "mod",
+		Package: "testdata.io/gomodule",
+		Version: "v1.0.0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "testdata.io/gomodule@v1.0.0", arch.Coordinate)
+
+	// The Origin points at the configured GitLab instance.
+	require.Equal(t, &schema.Origin{
+		VCS:    "git",
+		URL:    "https://gitlab.example.com/group/repo",
+		Subdir: "mod",
+		Ref:    "v1.0.0",
+	}, arch.Origin)
+}
+
+func TestPublisher_Publish_Immutable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	// The same module with different contents.
+	changed := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(changed, "go.mod"), []byte("module testdata.io/gomodule\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(changed, "mod.go"), []byte("package gomodule\n"), 0o600))
+
+	src := "../../testdata/gomodule"
+	fetcher := NewMockVCSFetcher(ctrl)
+	fetcher.EXPECT().Type().Return(types.GitHub).AnyTimes()
+	expectRepoURL(fetcher, "https://github.com")
+	fetcher.EXPECT().
+		FetchArchive(gomock.Any(), "test/repo", gomock.Any()).
+		DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+			return archive.Compress(w, archive.TarGz, src, archive.PrefixComponents("repo"))
+		}).
+		AnyTimes()
+
+	uploads := make(map[string][]byte)
+	stored := make(map[string][]byte)
+	uploader := NewMockUploader(ctrl)
+	uploader.EXPECT().Type().Return(types.GCS).AnyTimes()
+	uploader.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, r io.Reader, name string) (string, error) {
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+
+			uploads[name] = data
+			stored["gs://test-bucket/"+name] = data
+			return "gs://test-bucket/" + name, nil
+		}).
+		AnyTimes()
+	uploader.EXPECT().
+		Contains(gomock.Any()).
+		DoAndReturn(func(uri string) bool { return strings.HasPrefix(uri, "gs://test-bucket/") }).
+		AnyTimes()
+	uploader.EXPECT().
+		Read(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, w io.Writer, uri string) error {
+			_, err := w.Write(stored[uri])
+			return err
+		}).
+		AnyTimes()
+
+	publisher := New(PublisherParams{
+		DB:          client,
+		Packagers:   []Packager{packager.NewGoModule()},
+		Uploaders:   []Uploader{uploader},
+		VCSFetchers: []VCSFetcher{fetcher},
+	})
+
+	opts := PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.GCS,
+		VCS:     types.GitHub,
+		Repo:    "test/repo",
+		Ref:     "v1.0.0",
+		Package: "testdata.io/gomodule",
+		Version: "v1.0.0",
+	}
+
+	arch, err := publisher.Publish(t.Context(), opts)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(arch.Hash, "h1:"))
+
+	t.Run("identical", func(t *testing.T) {
+		clear(uploads)
+
+		again, err := publisher.Publish(t.Context(), opts)
+		require.NoError(t, err)
+		require.Equal(t, arch.ID, again.ID)
+		require.Equal(t, arch.Hash, again.Hash)
+		require.Empty(t, uploads)
+	})
+
+	t.Run("identical after retraction", func(t *testing.T) {
+		require.NoError(t, publisher.Retract(t.Context(), opts.Package, []string{"v0.9.0"}, ""))
+		t.Cleanup(func() {
+			client.Retraction.Delete().ExecX(context.Background())
+			client.Archive.Delete().Where(entarchive.Coordinate("testdata.io/gomodule@v1.0.1")).ExecX(context.Background())
+			client.Asset.Delete().Where(asset.URIContains("/@v/v1.0.1/")).ExecX(context.Background())
+		})
+
+		// The retraction is published as v1.0.1, leaving v1.0.0 as is.
+		require.True(t, client.Archive.Query().
+			Where(entarchive.Coordinate("testdata.io/gomodule@v1.0.1")).
+			ExistX(t.Context()))
+
+		again, err := publisher.Publish(t.Context(), opts)
+		require.NoError(t, err)
+		require.Equal(t, arch.Hash, again.Hash)
+	})
+
+	src = changed
+
+	t.Run("conflict", func(t *testing.T) {
+		_, err := publisher.Publish(t.Context(), opts)
+
+		var cerr *ConflictError
+		require.ErrorAs(t, err, &cerr)
+		require.Equal(t, arch.Hash, cerr.Existing)
+		require.NotEqual(t, arch.Hash, cerr.Hash)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		clear(uploads)
+
+		replace := opts
+		replace.Replace = &Replacement{Principal: "ops", Reason: "broken build"}
+
+		replaced, err := publisher.Publish(t.Context(), replace)
+		require.NoError(t, err)
+		require.Equal(t, arch.ID, replaced.ID)
+		require.NotEqual(t, arch.Hash, replaced.Hash)
+		require.Equal(t, "module testdata.io/gomodule\n", string(uploads[assetName(t, replaced, ".mod")]))
+
+		audit := client.ArchiveReplacement.Query().OnlyX(t.Context())
+		require.Equal(t, "testdata.io/gomodule@v1.0.0", audit.Coordinate)
+		require.Equal(t, arch.Hash, audit.PreviousHash)
+		require.Equal(t, replaced.Hash, audit.Hash)
+		require.Equal(t, "ops", audit.Principal)
+		require.Equal(t, "broken build", audit.Reason)
+
+		// Assets are named by their contents, so the replaced ones are kept rather than overwritten.
+		require.NotEqual(t, arch.Assets, replaced.Assets)
+		require.Equal(t, 4, client.Asset.Query().CountX(t.Context()))
+	})
+
+	t.Run("replace recorded", func(t *testing.T) {
+		tree := client.SumDBTree.Create().
+			SetName("test.sumdb.com").
+			SetSize(1).
+			SetSignerKey(crypto.Secret("shh")).
+			SetVerifierKey("good").
+			SaveX(t.Context())
+
+		client.SumDBRecord.Create().
+			SetTree(tree).
+			SetRecordID(0).
+			SetPath(opts.Package).
+			SetVersion(opts.Version).
+			SetData([]byte("data")).
+			SaveX(t.Context())
+
+		src = "../../testdata/gomodule"
+		replace := opts
+		replace.Replace = &Replacement{Principal: "ops", Reason: "oops"}
+
+		_, err := publisher.Publish(t.Context(), replace)
+		require.ErrorIs(t, err, ErrRecorded)
+	})
+}
+
+func TestPublisher_Publish_Cached(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	fetcher := NewMockVCSFetcher(ctrl)
+	fetcher.EXPECT().Type().Return(types.GitHub).AnyTimes()
+	expectRepoURL(fetcher, "https://github.com")
+	fetcher.EXPECT().
+		FetchArchive(gomock.Any(), "test/repo", gomock.Any()).
+		DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+			return archive.Compress(w, archive.TarGz, "../../testdata/gomodule", archive.PrefixComponents("repo"))
+		})
+
+	uploader := NewMockUploader(ctrl)
+	uploader.EXPECT().Type().Return(types.GCS).AnyTimes()
+	uploader.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ io.Reader, name string) (string, error) {
+			return "gs://test-bucket/" + name, nil
+		}).
+		Times(2)
+
+	publisher := New(PublisherParams{
+		DB:          client,
+		Packagers:   []Packager{packager.NewGoModule()},
+		Uploaders:   []Uploader{uploader},
+		VCSFetchers: []VCSFetcher{fetcher},
+	})
+
+	// The module was cached from upstream (e.g. it was public before moving in-house).
+	cached := client.Archive.Create().
+		SetType(types.GoModule).
+		SetCoordinate("testdata.io/gomodule@v1.0.0").
+		SetAssets([]schema.AssetURL{{Type: types.TextFile, URL: "mem://cache/gomodule.mod"}}).
+		SetCached(true).
+		SaveX(t.Context())
+
+	arch, err := publisher.Publish(t.Context(), PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.GCS,
+		VCS:     types.GitHub,
+		Repo:    "test/repo",
+		Ref:     "v1.0.0",
+		Package: "testdata.io/gomodule",
+		Version: "v1.0.0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, cached.ID, arch.ID)
+	require.False(t, arch.Cached)
+	require.True(t, strings.HasPrefix(arch.Hash, "h1:"))
+	require.Len(t, arch.Assets, 2)
+	require.Zero(t, client.ArchiveReplacement.Query().CountX(t.Context()))
+}
+
+func TestPublisher_Publish_SumDB(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	// The same module with different contents.
+	changed := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(changed, "go.mod"), []byte("module testdata.io/gomodule\n"), 0o600))
+
+	src := "../../testdata/gomodule"
+	fetcher := NewMockVCSFetcher(ctrl)
+	fetcher.EXPECT().Type().Return(types.GitHub).AnyTimes()
+	expectRepoURL(fetcher, "https://github.com")
+	fetcher.EXPECT().
+		FetchArchive(gomock.Any(), "test/repo", gomock.Any()).
+		DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+			return archive.Compress(w, archive.TarGz, src, archive.PrefixComponents("repo"))
+		}).
+		AnyTimes()
+
+	uploads := make(map[string][]byte)
+	uploader := NewMockUploader(ctrl)
+	uploader.EXPECT().Type().Return(types.GCS).AnyTimes()
+	uploader.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, r io.Reader, name string) (string, error) {
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+
+			uploads[name] = data
+			return "gs://test-bucket/" + name, nil
+		}).
+		AnyTimes()
+
+	publisher := New(PublisherParams{
+		DB:          client,
+		Packagers:   []Packager{packager.NewGoModule()},
+		Uploaders:   []Uploader{uploader},
+		VCSFetchers: []VCSFetcher{fetcher},
+		Recorder:    sumdb.NewRecorder(),
+	})
+
+	trees := make([]*ent.SumDBTree, 2)
+	for i, name := range []string{"a.sumdb.com", "b.sumdb.com"} {
+		skey, vkey, err := sdb.GenerateKeys(name)
+		require.NoError(t, err)
+
+		trees[i] = client.SumDBTree.Create().
+			SetName(name).
+			SetSize(0).
+			SetSignerKey(crypto.Secret(skey)).
+			SetVerifierKey(vkey).
+			SaveX(t.Context())
+	}
+
+	opts := PublishOptions{
+		Type:    types.GoModule,
+		Storage: types.GCS,
+		VCS:     types.GitHub,
+		Repo:    "test/repo",
+		Ref:     "v1.0.0",
+		Package: "testdata.io/gomodule",
+		Version: "v1.0.0",
+	}
+
+	t.Run("unknown tree", func(t *testing.T) {
+		unknown := opts
+		unknown.Trees = []string{"a.sumdb.com", "nope.sumdb.com"}
+
+		_, err := publisher.Publish(t.Context(), unknown)
+		require.ErrorIs(t, err, ErrUnknownTree)
+	})
+
+	t.Run("selected trees", func(t *testing.T) {
+		selected := opts
+		selected.Trees = []string{"a.sumdb.com"}
+
+		arch, err := publisher.Publish(t.Context(), selected)
+		require.NoError(t, err)
+
+		rec := client.SumDBRecord.Query().
+			Where(sumdbrecord.HasTreeWith(sumdbtree.ID(trees[0].ID))).
+			OnlyX(t.Context())
+		require.Equal(t, int64(0), rec.RecordID)
+		require.Equal(t, 2, rec.QueryAssets().CountX(t.Context()))
+
+		// The data matches the hashes of the uploaded module.
+		zipFile := filepath.Join(t.TempDir(), "mod.zip")
+		require.NoError(t, os.WriteFile(zipFile, uploads[assetName(t, arch, ".zip")], 0o600))
+		zipHash, err := dirhash.HashZip(zipFile, dirhash.Hash1)
+		require.NoError(t, err)
+
+		modHash, err := dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(uploads[assetName(t, arch, ".mod")])), nil
 		})
+		require.NoError(t, err)
+		mod := module.Version{Path: opts.Package, Version: opts.Version}
+		require.Equal(t, sumdb.NewRecord(mod, zipHash, modHash).Data, rec.Data)
+
+		require.Equal(t, int64(1), client.SumDBTree.GetX(t.Context(), trees[0].ID).Size)
+		require.Equal(t, int64(0), client.SumDBTree.GetX(t.Context(), trees[1].ID).Size)
+
+		// Lookups are served from the tree, without fetching the module.
+		db, err := sumdb.NewSumDB(trees[0], client)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		db.ServeHTTP(w, httptest.NewRequestWithContext(
+			t.Context(),
+			http.MethodGet,
+			"/sumdb/a.sumdb.com/lookup/testdata.io/gomodule@v1.0.0",
+			nil,
+		))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Contains(t, w.Body.String(), zipHash)
+	})
+
+	t.Run("no trees", func(t *testing.T) {
+		lazy := opts
+		lazy.Ref = "v1.0.1"
+		lazy.Version = "v1.0.1"
+
+		_, err := publisher.Publish(t.Context(), lazy)
+		require.NoError(t, err)
+		require.Zero(t, client.SumDBRecord.Query().Where(sumdbrecord.Version("v1.0.1")).CountX(t.Context()))
+
+		// Republishing records it in the requested trees which don't have it yet.
+		lazy.Trees = []string{"a.sumdb.com", "b.sumdb.com"}
+		clear(uploads)
+
+		_, err = publisher.Publish(t.Context(), lazy)
+		require.NoError(t, err)
+		require.Empty(t, uploads)
+		require.Equal(t, int64(2), client.SumDBTree.GetX(t.Context(), trees[0].ID).Size)
+		require.Equal(t, int64(1), client.SumDBTree.GetX(t.Context(), trees[1].ID).Size)
+		require.Equal(t, 2, client.SumDBRecord.Query().Where(sumdbrecord.Version("v1.0.1")).CountX(t.Context()))
+
+		// Including when it's already in some of them.
+		lazy.Trees = []string{"a.sumdb.com"}
+		_, err = publisher.Publish(t.Context(), lazy)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), client.SumDBTree.GetX(t.Context(), trees[0].ID).Size)
 	})
+
+	t.Run("replace", func(t *testing.T) {
+		t.Cleanup(func() { src = "../../testdata/gomodule" })
+
+		replace := opts
+		replace.Ref = "v1.0.2"
+		replace.Version = "v1.0.2"
+
+		_, err := publisher.Publish(t.Context(), replace)
+		require.NoError(t, err)
+
+		// Until it's recorded (i.e. looked up, or published to a tree), the version can be replaced.
+		src = changed
+		replace.Replace = &Replacement{Principal: "ops", Reason: "broken build"}
+
+		replaced, err := publisher.Publish(t.Context(), replace)
+		require.NoError(t, err)
+
+		recorded := replace
+		recorded.Replace = nil
+		recorded.Trees = []string{"b.sumdb.com"}
+		_, err = publisher.Publish(t.Context(), recorded)
+		require.NoError(t, err)
+
+		rec := client.SumDBRecord.Query().Where(sumdbrecord.Version("v1.0.2")).OnlyX(t.Context())
+		require.Contains(t, string(rec.Data), replaced.Hash)
+
+		src = "../../testdata/gomodule"
+		_, err = publisher.Publish(t.Context(), replace)
+		require.ErrorIs(t, err, ErrRecorded)
+
+		// Versions recorded when published can't be replaced either.
+		src = changed
+		_, err = publisher.Publish(t.Context(), PublishOptions{
+			Type:    opts.Type,
+			Storage: opts.Storage,
+			VCS:     opts.VCS,
+			Repo:    opts.Repo,
+			Ref:     "v1.0.0",
+			Package: opts.Package,
+			Version: "v1.0.0",
+			Replace: replace.Replace,
+			Trees:   []string{"a.sumdb.com"},
+		})
+		require.ErrorIs(t, err, ErrRecorded)
+	})
+
+	t.Run("frozen and retired trees", func(t *testing.T) {
+		client.SumDBTree.UpdateOne(trees[0]).SetStatus(sumdbtree.StatusFrozen).ExecX(t.Context())
+		client.SumDBTree.UpdateOne(trees[1]).SetStatus(sumdbtree.StatusRetired).ExecX(t.Context())
+
+		frozen := opts
+		frozen.Trees = []string{"a.sumdb.com"}
+		_, err := publisher.Publish(t.Context(), frozen)
+		require.ErrorIs(t, err, ErrFrozenTree)
+
+		retired := opts
+		retired.Trees = []string{"b.sumdb.com"}
+		_, err = publisher.Publish(t.Context(), retired)
+		require.ErrorIs(t, err, ErrUnknownTree)
+	})
+}
+
+// expectRepoURL stubs fetcher.RepoURL, returning the URLs of repos on host.
+func expectRepoURL(fetcher *MockVCSFetcher, host string) {
+	fetcher.EXPECT().RepoURL(gomock.Any()).DoAndReturn(func(repo string) string { return host + "/" + repo }).AnyTimes()
+}
+
+// assetName returns the name the asset (with extension ext) of arch is uploaded as. Assets are named by the hex encoded
+// h1: hash of the module, e.g. gomod/example.com/mod/@v/v1.0.0/<hash>.zip.
+func assetName(tb testing.TB, arch *ent.Archive, ext string) string {
+	tb.Helper()
+
+	sum, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(arch.Hash, "h1:"))
+	require.NoError(tb, err)
+
+	path, version, _ := strings.Cut(arch.Coordinate, "@")
+	return arch.Type.String() + "/" + path + "/@v/" + version + "/" + hex.EncodeToString(sum) + ext
 }
@@ -0,0 +1,454 @@
+package publisher
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pseudomuto/pacman/internal/ent"
+	entarchive "github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/deprecation"
+	"github.com/pseudomuto/pacman/internal/ent/retraction"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/fsutil"
+	"github.com/pseudomuto/pacman/internal/types"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+// ErrInvalidModule is returned when retracting or deprecating an invalid module path or version.
+var ErrInvalidModule = errors.New("invalid module")
+
+// ModuleStatus is the retraction and deprecation state of a module.
+type ModuleStatus struct {
+	Path string
+	// Deprecated is the deprecation message, empty when the module isn't deprecated.
+	Deprecated string
+	// Retracted are the retracted versions, sorted by version.
+	Retracted []*ent.Retraction
+}
+
+// Retract marks the versions of the module at path as retracted. Retracting a version again updates the rationale.
+//
+// Retracted versions are still served and listed, but are skipped when resolving @latest. Since the go command reads
+// retractions from the go.mod of the latest version, and published go.mod files are never rewritten (their checksums
+// are recorded), the next patch version is published with them (see release).
+func (p *Publisher) Retract(ctx context.Context, path string, versions []string, rationale string) error {
+	if len(versions) == 0 {
+		return fmt.Errorf("%w: no versions to retract: %s", ErrInvalidModule, path)
+	}
+
+	for _, v := range versions {
+		if err := module.Check(path, v); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidModule, err)
+		}
+
+		if module.CanonicalVersion(v) != v {
+			return fmt.Errorf("%w: %q is not a canonical semantic version: %s", ErrInvalidModule, v, path)
+		}
+	}
+
+	builders := make([]*ent.RetractionCreate, len(versions))
+	for i, v := range versions {
+		builders[i] = p.db.Retraction.Create().SetPath(path).SetVersion(v).SetRationale(rationale)
+	}
+
+	if err := p.db.Retraction.CreateBulk(builders...).
+		OnConflictColumns(retraction.FieldPath, retraction.FieldVersion).
+		UpdateNewValues().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to retract versions: %s, %w", path, err)
+	}
+
+	defer p.invalidate(path)
+	return p.release(ctx, path)
+}
+
+// Deprecate marks the module at path as deprecated. An empty message removes the deprecation.
+//
+// Like retractions, the deprecation is published in the go.mod of the next patch version (see release).
+func (p *Publisher) Deprecate(ctx context.Context, path, message string) error {
+	if err := module.CheckPath(path); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidModule, err)
+	}
+
+	if message == "" {
+		if _, err := p.db.Deprecation.Delete().Where(deprecation.Path(path)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to remove deprecation: %s, %w", path, err)
+		}
+
+		defer p.invalidate(path)
+		return p.release(ctx, path)
+	}
+
+	if err := p.db.Deprecation.Create().
+		SetPath(path).
+		SetMessage(message).
+		OnConflictColumns(deprecation.FieldPath).
+		UpdateNewValues().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to deprecate module: %s, %w", path, err)
+	}
+
+	defer p.invalidate(path)
+	return p.release(ctx, path)
+}
+
+// ModuleStatus returns the retraction and deprecation state of the module at path.
+func (p *Publisher) ModuleStatus(ctx context.Context, path string) (*ModuleStatus, error) {
+	status := &ModuleStatus{Path: path}
+
+	dep, err := p.db.Deprecation.Query().Where(deprecation.Path(path)).Only(ctx)
+	switch {
+	case err == nil:
+		status.Deprecated = dep.Message
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("failed to find deprecation: %s, %w", path, err)
+	}
+
+	if status.Retracted, err = p.db.Retraction.Query().Where(retraction.Path(path)).All(ctx); err != nil {
+		return nil, fmt.Errorf("failed to find retractions: %s, %w", path, err)
+	}
+
+	slices.SortFunc(status.Retracted, func(a, b *ent.Retraction) int { return semver.Compare(a.Version, b.Version) })
+	return status, nil
+}
+
+// retractionsOnly is the rationale for a version published only to carry the module's retractions.
+const retractionsOnly = "Contains retractions only."
+
+// release publishes the next patch version of the module at path, whose go.mod declares the module's retractions and
+// deprecation. The go command reads both from the go.mod of the latest version, retracted or not, so this is how it
+// learns of them (e.g. for go list -m -u -retracted).
+//
+// The new version has the contents of the latest release, so @latest resolves to it. When the latest release is itself
+// retracted, the new version retracts itself too (like a retraction-only release in the go docs), leaving @latest at
+// the newest version which isn't retracted. Nothing is published when the module has no published releases (e.g. it's
+// only cached from upstream, or only has pre-releases), or when the latest go.mod already declares the current state.
+func (p *Publisher) release(ctx context.Context, path string) error {
+	latest, err := p.latestRelease(ctx, path)
+	if err != nil || latest == nil {
+		return err
+	}
+
+	mod := module.Version{Path: path, Version: archiveVersion(latest)}
+	up, err := p.storage(latest)
+	if err != nil {
+		return err
+	}
+
+	var goMod bytes.Buffer
+	if err := up.Read(ctx, &goMod, assetURL(latest, types.TextFile)); err != nil {
+		return fmt.Errorf("failed to read go.mod: %s, %w", mod, err)
+	}
+
+	f, err := modfile.Parse("go.mod", goMod.Bytes(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to parse go.mod: %s, %w", mod, err)
+	}
+
+	if f.Module == nil {
+		return fmt.Errorf("failed to parse go.mod: %s, missing module directive", mod)
+	}
+
+	status, err := p.ModuleStatus(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	changed, err := retract(f, status.Retracted)
+	if err != nil {
+		return fmt.Errorf("failed to add retractions: %s, %w", mod, err)
+	}
+
+	if !deprecate(f, status.Deprecated) && !changed {
+		return nil
+	}
+
+	next := module.Version{Path: path, Version: nextPatch(mod.Version)}
+	if slices.ContainsFunc(status.Retracted, func(r *ent.Retraction) bool { return r.Version == mod.Version }) {
+		if err := p.db.Retraction.Create().
+			SetPath(path).
+			SetVersion(next.Version).
+			SetRationale(retractionsOnly).
+			OnConflictColumns(retraction.FieldPath, retraction.FieldVersion).
+			UpdateNewValues().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to retract version: %s, %w", next, err)
+		}
+
+		if err := f.AddRetract(modfile.VersionInterval{Low: next.Version, High: next.Version}, retractionsOnly); err != nil {
+			return fmt.Errorf("failed to add retraction: %s, %w", next, err)
+		}
+	}
+
+	f.Cleanup()
+	out, err := f.Format()
+	if err != nil {
+		return fmt.Errorf("failed to format go.mod: %s, %w", next, err)
+	}
+
+	return p.republish(ctx, up, latest, next, out)
+}
+
+// republish stores the contents of arch (read from up) as mod, with its go.mod replaced by goMod.
+func (p *Publisher) republish(
+	ctx context.Context,
+	up Uploader,
+	arch *ent.Archive,
+	mod module.Version,
+	goMod []byte,
+) error {
+	from := module.Version{Path: mod.Path, Version: archiveVersion(arch)}
+	opts := PublishOptions{
+		Type:    types.GoModule,
+		Storage: up.Type(),
+		Package: mod.Path,
+		Version: mod.Version,
+	}
+
+	// NB: The source is still that of arch, but the version isn't tagged, so only its location is kept.
+	var orig *schema.Origin
+	if arch.Origin != nil {
+		orig = &schema.Origin{VCS: arch.Origin.VCS, URL: arch.Origin.URL, Subdir: arch.Origin.Subdir}
+	}
+
+	return fsutil.WithTempDir(func(dir string) error {
+		if err := os.WriteFile(filepath.Join(dir, "go.mod"), goMod, 0o600); err != nil {
+			return fmt.Errorf("failed to write go.mod: %w", err)
+		}
+
+		return fsutil.WithTempFile(func(src *os.File) error {
+			if err := up.Read(ctx, src, assetURL(arch, types.Archive)); err != nil {
+				return fmt.Errorf("failed to read package: %s, %w", from, err)
+			}
+
+			return fsutil.WithTempFile(func(pkg *os.File) error {
+				if err := rezip(src, pkg, from, mod, goMod); err != nil {
+					return err
+				}
+
+				hash, err := dirhash.HashZip(pkg.Name(), dirhash.Hash1)
+				if err != nil {
+					return fmt.Errorf("failed to hash package: %w", err)
+				}
+
+				if _, err := pkg.Seek(0, 0); err != nil {
+					return fmt.Errorf("failed to seek to beginning of package: %w", err)
+				}
+
+				_, err = p.store(ctx, up, opts, orig, dir, pkg, hash, nil)
+				return err
+			})
+		})
+	})
+}
+
+// latestRelease returns the published Archive of the latest release (i.e. not a pre-release or pseudo-version) of the
+// module at path, or nil when there isn't one.
+func (p *Publisher) latestRelease(ctx context.Context, path string) (*ent.Archive, error) {
+	archs, err := p.db.Archive.Query().
+		Where(
+			entarchive.TypeEQ(types.GoModule),
+			entarchive.CoordinateHasPrefix(path+"@"),
+			entarchive.Cached(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archives: %s, %w", path, err)
+	}
+
+	var latest *ent.Archive
+	for _, arch := range archs {
+		v := archiveVersion(arch)
+		if semver.Prerelease(v) != "" {
+			continue
+		}
+
+		if latest == nil || semver.Compare(v, archiveVersion(latest)) > 0 {
+			latest = arch
+		}
+	}
+
+	return latest, nil
+}
+
+// storage returns the Uploader which stored the assets of arch.
+func (p *Publisher) storage(arch *ent.Archive) (Uploader, error) {
+	uri := assetURL(arch, types.Archive)
+	for _, up := range p.uploaders {
+		if up.Contains(uri) {
+			return up, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown storage for package: %s, %s", arch.Coordinate, uri)
+}
+
+// retract adds a retract directive to f for each of the retractions it doesn't already cover. The result reports
+// whether f was changed.
+func retract(f *modfile.File, retractions []*ent.Retraction) (bool, error) {
+	var changed bool
+	for _, r := range retractions {
+		if slices.ContainsFunc(f.Retract, func(rr *modfile.Retract) bool {
+			return semver.Compare(rr.Low, r.Version) <= 0 && semver.Compare(r.Version, rr.High) <= 0
+		}) {
+			continue
+		}
+
+		if err := f.AddRetract(modfile.VersionInterval{Low: r.Version, High: r.Version}, r.Rationale); err != nil {
+			return false, err
+		}
+
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// deprecate sets the deprecation of the module in f to message, removing it when empty. The result reports whether f
+// was changed.
+func deprecate(f *modfile.File, message string) bool {
+	if f.Module.Deprecated == message {
+		return false
+	}
+
+	// NB: The deprecation is the paragraph of the module comment starting with "Deprecated:". Paragraphs are separated
+	// by empty comments, so split them up to drop the existing one (if any).
+	c := f.Module.Syntax.Comment()
+	var paragraphs [][]modfile.Comment
+	var para []modfile.Comment
+	for _, line := range append(c.Before, modfile.Comment{Token: "//"}) {
+		if strings.TrimSpace(strings.TrimPrefix(line.Token, "//")) != "" {
+			para = append(para, line)
+			continue
+		}
+
+		if len(para) > 0 && !strings.HasPrefix(strings.TrimSpace(strings.TrimPrefix(para[0].Token, "//")), "Deprecated:") {
+			paragraphs = append(paragraphs, para)
+		}
+
+		para = nil
+	}
+
+	if message != "" {
+		para = nil
+		for line := range strings.SplitSeq("Deprecated: "+message, "\n") {
+			para = append(para, modfile.Comment{Token: strings.TrimSpace("// " + line)})
+		}
+
+		paragraphs = append(paragraphs, para)
+	}
+
+	c.Before = nil
+	for i, para := range paragraphs {
+		if i > 0 {
+			c.Before = append(c.Before, modfile.Comment{Token: "//"})
+		}
+
+		c.Before = append(c.Before, para...)
+	}
+
+	// NB: The deprecation may also be a suffix comment (e.g. module example.com/mod // Deprecated: ...).
+	c.Suffix = slices.DeleteFunc(c.Suffix, func(line modfile.Comment) bool {
+		return strings.HasPrefix(strings.TrimSpace(strings.TrimPrefix(line.Token, "//")), "Deprecated:")
+	})
+
+	return true
+}
+
+// nextPatch returns the version following the release v, e.g. v1.2.4 for v1.2.3 (and v2.0.1+incompatible for
+// v2.0.0+incompatible).
+func nextPatch(v string) string {
+	mm := semver.MajorMinor(v)
+	patch, _ := strconv.Atoi(strings.TrimPrefix(semver.Canonical(v), mm+"."))
+	return mm + "." + strconv.Itoa(patch+1) + semver.Build(v)
+}
+
+// rezip copies the module zip in src (for from) to dst as mod, replacing its go.mod with goMod.
+func rezip(src, dst *os.File, from, mod module.Version, goMod []byte) error {
+	info, err := src.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat package: %s, %w", from, err)
+	}
+
+	zr, err := zip.NewReader(src, info.Size())
+	if err != nil {
+		return fmt.Errorf("failed to read package: %s, %w", from, err)
+	}
+
+	zw := zip.NewWriter(dst)
+	for _, zf := range zr.File {
+		name, ok := strings.CutPrefix(zf.Name, from.String()+"/")
+		if !ok {
+			return fmt.Errorf("failed to read package: %s, unexpected file: %s", from, zf.Name)
+		}
+
+		if name == "go.mod" {
+			continue
+		}
+
+		if err := copyZipFile(zw, zf, mod.String()+"/"+name); err != nil {
+			return fmt.Errorf("failed to copy file: %s, %w", zf.Name, err)
+		}
+	}
+
+	w, err := zw.Create(mod.String() + "/go.mod")
+	if err != nil {
+		return fmt.Errorf("failed to write go.mod: %s, %w", mod, err)
+	}
+
+	if _, err := w.Write(goMod); err != nil {
+		return fmt.Errorf("failed to write go.mod: %s, %w", mod, err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to write package: %s, %w", mod, err)
+	}
+
+	return nil
+}
+
+// copyZipFile copies zf to zw as name.
+func copyZipFile(zw *zip.Writer, zf *zip.File, name string) error {
+	r, err := zf.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: zf.Modified})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, r) //nolint:gosec // copying between archives
+	return err
+}
+
+// archiveVersion returns the version of the Go module published as arch.
+func archiveVersion(arch *ent.Archive) string {
+	_, v, _ := strings.Cut(arch.Coordinate, "@")
+	return v
+}
+
+// assetURL returns the URL of the asset of type t in arch.
+func assetURL(arch *ent.Archive, t types.AssetType) string {
+	for _, a := range arch.Assets {
+		if a.Type == t {
+			return a.URL
+		}
+	}
+
+	return ""
+}
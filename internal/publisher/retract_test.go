@@ -0,0 +1,129 @@
+package publisher_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/archive"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/packager"
+	. "github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/mod/modfile"
+)
+
+func TestPublisher_Retract(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	fetcher := NewMockVCSFetcher(ctrl)
+	fetcher.EXPECT().Type().Return(types.GitHub).AnyTimes()
+	expectRepoURL(fetcher, "https://github.com")
+
+	uploader := NewMockUploader(ctrl)
+	uploader.EXPECT().Type().Return(types.GCS).AnyTimes()
+
+	inv := new(invalidator)
+	pub := New(PublisherParams{
+		DB:           client,
+		Packagers:    []Packager{packager.NewGoModule()},
+		Uploaders:    []Uploader{uploader},
+		VCSFetchers:  []VCSFetcher{fetcher},
+		Invalidators: []Invalidator{inv},
+	})
+
+	const mod = "testdata.io/gomodule"
+
+	t.Run("invalid", func(t *testing.T) {
+		require.ErrorIs(t, pub.Retract(t.Context(), mod, nil, ""), ErrInvalidModule)
+		require.ErrorIs(t, pub.Retract(t.Context(), mod, []string{"v1.0"}, ""), ErrInvalidModule)
+		require.ErrorIs(t, pub.Retract(t.Context(), mod, []string{"v2.0.0"}, ""), ErrInvalidModule)
+		require.ErrorIs(t, pub.Deprecate(t.Context(), "Bad Path!", "nope"), ErrInvalidModule)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		status, err := pub.ModuleStatus(t.Context(), mod)
+		require.NoError(t, err)
+		require.Empty(t, status.Deprecated)
+		require.Empty(t, status.Retracted)
+
+		require.NoError(t, pub.Retract(t.Context(), mod, []string{"v1.10.0", "v1.2.0"}, "Published accidentally."))
+		require.NoError(t, pub.Retract(t.Context(), mod, []string{"v1.2.0"}, "Broken build."))
+		require.NoError(t, pub.Deprecate(t.Context(), mod, "use testdata.io/other instead."))
+		require.NoError(t, pub.Deprecate(t.Context(), "testdata.io/other", "also deprecated"))
+		require.NoError(t, pub.Deprecate(t.Context(), "testdata.io/other", ""))
+		require.Equal(t, []string{mod, mod, mod, "testdata.io/other", "testdata.io/other"}, inv.paths)
+
+		status, err = pub.ModuleStatus(t.Context(), mod)
+		require.NoError(t, err)
+		require.Equal(t, "use testdata.io/other instead.", status.Deprecated)
+		require.Len(t, status.Retracted, 2)
+		require.Equal(t, "v1.2.0", status.Retracted[0].Version)
+		require.Equal(t, "Broken build.", status.Retracted[0].Rationale)
+		require.Equal(t, "v1.10.0", status.Retracted[1].Version)
+		require.Equal(t, "Published accidentally.", status.Retracted[1].Rationale)
+
+		status, err = pub.ModuleStatus(t.Context(), "testdata.io/other")
+		require.NoError(t, err)
+		require.Empty(t, status.Deprecated)
+	})
+
+	t.Run("published go.mod", func(t *testing.T) {
+		fetcher.EXPECT().
+			FetchArchive(gomock.Any(), "test/repo", types.VCSOptions{Ref: "v1.11.0"}).
+			DoAndReturn(func(w io.Writer, _ string, _ types.VCSOptions) error {
+				return archive.Compress(w, archive.TarGz, "../../testdata/gomodule", archive.PrefixComponents("repo"))
+			})
+
+		uploads := make(map[string][]byte)
+		uploader.EXPECT().
+			Write(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, r io.Reader, name string) (string, error) {
+				data, err := io.ReadAll(r)
+				require.NoError(t, err)
+
+				uploads[name] = data
+				return "gs://test-bucket/" + name, nil
+			}).
+			Times(2)
+
+		arch, err := pub.Publish(t.Context(), PublishOptions{
+			Type:    types.GoModule,
+			Storage: types.GCS,
+			VCS:     types.GitHub,
+			Repo:    "test/repo",
+			Ref:     "v1.11.0",
+			Package: mod,
+			Version: "v1.11.0",
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, mod, inv.paths[len(inv.paths)-1])
+
+		// The go.mod is the source's, byte for byte.
+		src, err := os.ReadFile("../../testdata/gomodule/go.mod")
+		require.NoError(t, err)
+		require.Equal(t, string(src), string(uploads[assetName(t, arch, ".mod")]))
+
+		f, err := modfile.Parse("go.mod", uploads[assetName(t, arch, ".mod")], nil)
+		require.NoError(t, err)
+		require.Empty(t, f.Module.Deprecated)
+		require.Empty(t, f.Retract)
+	})
+}
+
+// invalidator records the paths it's notified about.
+type invalidator struct {
+	paths []string
+}
+
+func (i *invalidator) Invalidate(path string) {
+	i.paths = append(i.paths, path)
+}
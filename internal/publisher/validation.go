@@ -0,0 +1,53 @@
+package publisher
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validation rules reported in Problems.
+const (
+	RuleZip          = "zip"
+	RulePath         = "path"
+	RuleVersion      = "version"
+	RuleMajorVersion = "major-version"
+	RuleModule       = "module"
+	RuleReplace      = "replace"
+	RuleSize         = "size"
+)
+
+type (
+	// ValidationError is returned by Packagers when the package fails validation. It reports every problem found, so
+	// they can all be fixed before publishing again.
+	ValidationError struct {
+		Package  string
+		Version  string
+		Problems []Problem
+	}
+
+	// Problem is a single validation failure.
+	Problem struct {
+		// Rule is the check that failed (e.g. RuleModule).
+		Rule string
+		// File is the path (relative to the package root) the problem was found in, if any.
+		File    string
+		Message string
+	}
+)
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.String()
+	}
+
+	return fmt.Sprintf("invalid package: %s@%s, %s", e.Package, e.Version, strings.Join(msgs, "; "))
+}
+
+func (p Problem) String() string {
+	if p.File != "" {
+		return fmt.Sprintf("%s: %s: %s", p.Rule, p.File, p.Message)
+	}
+
+	return p.Rule + ": " + p.Message
+}
@@ -4,11 +4,35 @@ import (
 	"context"
 
 	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/publisher"
 	"go.uber.org/fx"
 )
 
+type Uploaders struct {
+	fx.Out
+
+	Uploaders []publisher.Uploader `group:"publisher_uploaders,flatten"`
+}
+
 var Module = fx.Module("storage",
+	fx.Provide(NewUploaders),
 	fx.Invoke(func(ctx context.Context, c *config.Config) error {
 		return RegisterBuckets(ctx, c.StorageBuckets...)
 	}),
 )
+
+// NewUploaders creates an Uploader for each configured bucket that supports publishing.
+func NewUploaders(c *config.Config) Uploaders {
+	var res Uploaders
+	for _, path := range c.StorageBuckets {
+		up, err := NewUploader(path)
+		if err != nil {
+			// NB: Not every bucket type can be published to (e.g. mem://), they're still readable.
+			continue
+		}
+
+		res.Uploaders = append(res.Uploaders, up)
+	}
+
+	return res
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/pseudomuto/pacman/internal/types"
+)
+
+// Uploader writes published assets beneath the root of a registered bucket. It satisfies publisher.Uploader.
+type Uploader struct {
+	kind     types.StorageType
+	rootPath string
+}
+
+// NewUploader creates an Uploader for the bucket at baseURL. The storage type is derived from the URL scheme.
+func NewUploader(baseURL string) (*Uploader, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bucket URL: %s, %w", baseURL, err)
+	}
+
+	var kind types.StorageType
+	switch u.Scheme {
+	case "file":
+		kind = types.FileSystem
+	case "gs":
+		kind = types.GCS
+	default:
+		return nil, fmt.Errorf("unsupported storage scheme: %s", u.Scheme)
+	}
+
+	// NB: Same as NewBucket, query string params are for the opener only.
+	path := baseURL
+	if idx := strings.IndexByte(path, '?'); idx != -1 {
+		path = path[:idx]
+	}
+
+	return &Uploader{
+		kind:     kind,
+		rootPath: strings.TrimSuffix(path, "/"),
+	}, nil
+}
+
+func (u *Uploader) Type() types.StorageType {
+	return u.kind
+}
+
+// Write stores the contents of r at name (relative to the bucket root) and returns the full URI of the object.
+func (u *Uploader) Write(ctx context.Context, r io.Reader, name string) (string, error) {
+	uri := u.rootPath + "/" + strings.TrimPrefix(name, "/")
+	if err := Write(ctx, r, uri); err != nil {
+		return "", err
+	}
+
+	return uri, nil
+}
+
+// Contains reports whether uri (as returned by Write) is beneath the root of the Uploader's bucket.
+func (u *Uploader) Contains(uri string) bool {
+	return strings.HasPrefix(uri, u.rootPath+"/")
+}
+
+// Read writes the contents of the object at uri (as returned by Write) to w.
+func (u *Uploader) Read(ctx context.Context, w io.Writer, uri string) error {
+	if !u.Contains(uri) {
+		return fmt.Errorf("%w: %s", ErrNoStorageForPath, uri)
+	}
+
+	return Read(ctx, w, uri)
+}
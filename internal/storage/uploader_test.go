@@ -0,0 +1,40 @@
+package storage_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/pseudomuto/pacman/internal/storage"
+	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploader(t *testing.T) {
+	base := "file://" + t.TempDir()
+	require.NoError(t, RegisterBuckets(t.Context(), base+"?create_dir=1&no_tmp_dir=1"))
+
+	up, err := NewUploader(base + "?create_dir=1&no_tmp_dir=1")
+	require.NoError(t, err)
+	require.Equal(t, types.FileSystem, up.Type())
+
+	uri, err := up.Write(t.Context(), bytes.NewBufferString("module example.com/mod"), "gomod/example.com/mod/@v/v0.1.0.mod")
+	require.NoError(t, err)
+	require.Equal(t, base+"/gomod/example.com/mod/@v/v0.1.0.mod", uri)
+
+	var buf bytes.Buffer
+	require.NoError(t, Read(t.Context(), &buf, uri))
+	require.Equal(t, "module example.com/mod", buf.String())
+
+	require.True(t, up.Contains(uri))
+	buf.Reset()
+	require.NoError(t, up.Read(t.Context(), &buf, uri))
+	require.Equal(t, "module example.com/mod", buf.String())
+
+	require.False(t, up.Contains("file:///elsewhere/mod.zip"))
+	require.ErrorIs(t, up.Read(t.Context(), &buf, "file:///elsewhere/mod.zip"), ErrNoStorageForPath)
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		_, err := NewUploader("mem://testing")
+		require.EqualError(t, err, "unsupported storage scheme: mem")
+	})
+}
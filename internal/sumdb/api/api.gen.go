@@ -12,8 +12,107 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for TreeStatus.
+const (
+	Active  TreeStatus = "active"
+	Frozen  TreeStatus = "frozen"
+	Retired TreeStatus = "retired"
+)
+
+// AuditReport defines model for AuditReport.
+type AuditReport struct {
+	// Divergences The inconsistencies found (up to 1000)
+	Divergences []string `json:"divergences"`
+
+	// Hashes The number of stored hashes
+	Hashes int64 `json:"hashes"`
+
+	// Heads The number of recorded tree heads
+	Heads int64 `json:"heads"`
+
+	// Ok Whether the tree is consistent
+	Ok      bool  `json:"ok"`
+	Records int64 `json:"records"`
+
+	// Size The size of the tree, as recorded in the database
+	Size int64 `json:"size"`
+
+	// Total The number of inconsistencies found, including those not listed in divergences
+	Total int    `json:"total"`
+	Tree  string `json:"tree"`
+}
+
+// ConsistencyProof defines model for ConsistencyProof.
+type ConsistencyProof struct {
+	From int64 `json:"from"`
+
+	// FromHash The tree hash at from (base64 encoded)
+	FromHash string `json:"fromHash"`
+
+	// Proof The hashes of the proof (base64 encoded)
+	Proof []string `json:"proof"`
+	To    int64    `json:"to"`
+
+	// ToHash The tree hash at to (base64 encoded)
+	ToHash string `json:"toHash"`
+	Tree   string `json:"tree"`
+}
+
+// Cosignature defines model for Cosignature.
+type Cosignature struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// KeyHash The hash of the witness' key (hex encoded)
+	KeyHash string `json:"keyHash"`
+
+	// Witness The name of the witness' key
+	Witness string `json:"witness"`
+}
+
+// Cosigned defines model for Cosigned.
+type Cosigned struct {
+	// Cosignatures The number of cosignatures in the note
+	Cosignatures int `json:"cosignatures"`
+
+	// Size The size of the cosigned tree head
+	Size int64 `json:"size"`
+}
+
+// CreateTreeRequest defines model for CreateTreeRequest.
+type CreateTreeRequest struct {
+	// Name The name of the tree (e.g. sum.example.com), as used in GOSUMDB.
+	Name string `json:"name"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Export defines model for Export.
+type Export struct {
+	// Size The size of the exported tree
+	Size int64  `json:"size"`
+	Tree string `json:"tree"`
+
+	// Url The base URL of the exported checksum database
+	Url string `json:"url"`
+}
+
+// ExportRequest defines model for ExportRequest.
+type ExportRequest struct {
+	// Bucket The URL of the bucket to export to (e.g. gs://some-bucket)
+	Bucket string `json:"bucket"`
+}
+
 // Hash defines model for Hash.
 type Hash struct {
+	// Hash The hash (base64 encoded)
 	Hash  string `json:"hash"`
 	Index int64  `json:"index"`
 }
@@ -21,6 +120,49 @@ type Hash struct {
 // HashList defines model for HashList.
 type HashList = []Hash
 
+// HashPage defines model for HashPage.
+type HashPage struct {
+	Items HashList `json:"items"`
+
+	// NextCursor The cursor of the next page, unless this is the last one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// InclusionProof defines model for InclusionProof.
+type InclusionProof struct {
+	// Data The record's go.sum lines
+	Data string `json:"data"`
+	Path string `json:"path"`
+
+	// Proof The hashes of the proof (base64 encoded)
+	Proof    []string `json:"proof"`
+	RecordId int64    `json:"recordId"`
+
+	// Size The tree size the record's inclusion is proven in
+	Size int64  `json:"size"`
+	Tree string `json:"tree"`
+
+	// TreeHash The tree hash at size (base64 encoded)
+	TreeHash string `json:"treeHash"`
+	Version  string `json:"version"`
+}
+
+// Key defines model for Key.
+type Key struct {
+	// CosignUntil When tree heads stop being signed with this key
+	CosignUntil *time.Time `json:"cosignUntil,omitempty"`
+
+	// Current Whether this is the tree's current key
+	Current bool `json:"current"`
+
+	// RetiredAt When the tree was rotated off this key
+	RetiredAt   *time.Time `json:"retiredAt,omitempty"`
+	VerifierKey string     `json:"verifierKey"`
+}
+
+// KeyList defines model for KeyList.
+type KeyList = []Key
+
 // Record defines model for Record.
 type Record struct {
 	CreatedAt time.Time `json:"createdAt"`
@@ -34,29 +176,202 @@ type Record struct {
 // RecordList defines model for RecordList.
 type RecordList = []Record
 
+// RecordPage defines model for RecordPage.
+type RecordPage struct {
+	Items RecordList `json:"items"`
+
+	// NextCursor The cursor of the next page, unless this is the last one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// RotateRequest defines model for RotateRequest.
+type RotateRequest struct {
+	// CosignWindow How long tree heads are signed with the previous key, as a Go duration (e.g. 72h). Defaults to 7 days.
+	CosignWindow *string `json:"cosignWindow,omitempty"`
+}
+
 // Tree defines model for Tree.
 type Tree struct {
-	CreatedAt   time.Time `json:"createdAt"`
-	Name        string    `json:"name"`
-	Size        int64     `json:"size"`
-	UpdatedAt   time.Time `json:"updatedAt"`
-	VerifierKey string    `json:"verifierKey"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Name        string     `json:"name"`
+	Size        int64      `json:"size"`
+	Status      TreeStatus `json:"status"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	VerifierKey string     `json:"verifierKey"`
+}
+
+// TreeStatus defines model for Tree.Status.
+type TreeStatus string
+
+// TreeHead defines model for TreeHead.
+type TreeHead struct {
+	Cosignatures []Cosignature `json:"cosignatures"`
+
+	// CreatedAt When the tree head was first signed
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Hash The tree hash (base64 encoded)
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// TreeHeadList defines model for TreeHeadList.
+type TreeHeadList = []TreeHead
+
+// TreeHeadPage defines model for TreeHeadPage.
+type TreeHeadPage struct {
+	Items TreeHeadList `json:"items"`
+
+	// NextCursor The cursor of the next page, unless this is the last one
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // TreeList defines model for TreeList.
 type TreeList = []Tree
 
+// CreatedAfter defines model for CreatedAfter.
+type CreatedAfter = time.Time
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// AddTreeCosignaturesTextBody defines parameters for AddTreeCosignatures.
+type AddTreeCosignaturesTextBody = string
+
+// ListTreeHashesParams defines parameters for ListTreeHashes.
+type ListTreeHashesParams struct {
+	// Cursor The nextCursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The maximum number of items on the page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// CreatedAfter Only list items created after this time
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// IfNoneMatch The ETag of a previous response, which is returned as 304 Not Modified when unchanged
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListTreeHeadsParams defines parameters for ListTreeHeads.
+type ListTreeHeadsParams struct {
+	// Cursor The nextCursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The maximum number of items on the page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// CreatedAfter Only list items created after this time
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// IfNoneMatch The ETag of a previous response, which is returned as 304 Not Modified when unchanged
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ProveConsistencyParams defines parameters for ProveConsistency.
+type ProveConsistencyParams struct {
+	// From The earlier tree size
+	From int64 `form:"from" json:"from"`
+
+	// To The later tree size. Defaults to the current size.
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ProveInclusionParams defines parameters for ProveInclusion.
+type ProveInclusionParams struct {
+	// Module The module version (e.g. golang.org/x/mod@v0.20.0)
+	Module string `form:"module" json:"module"`
+
+	// Size The tree size to prove inclusion in. Defaults to the current size.
+	Size *int64 `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListTreeRecordsParams defines parameters for ListTreeRecords.
+type ListTreeRecordsParams struct {
+	// Cursor The nextCursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The maximum number of items on the page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// PathPrefix Only list records for module paths starting with this prefix
+	PathPrefix *string `form:"pathPrefix,omitempty" json:"pathPrefix,omitempty"`
+
+	// Version Only list records for this version
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+
+	// CreatedAfter Only list items created after this time
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// IfNoneMatch The ETag of a previous response, which is returned as 304 Not Modified when unchanged
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// CreateTreeJSONRequestBody defines body for CreateTree for application/json ContentType.
+type CreateTreeJSONRequestBody = CreateTreeRequest
+
+// AddTreeCosignaturesTextRequestBody defines body for AddTreeCosignatures for text/plain ContentType.
+type AddTreeCosignaturesTextRequestBody = AddTreeCosignaturesTextBody
+
+// ExportTreeJSONRequestBody defines body for ExportTree for application/json ContentType.
+type ExportTreeJSONRequestBody = ExportRequest
+
+// RotateTreeKeyJSONRequestBody defines body for RotateTreeKey for application/json ContentType.
+type RotateTreeKeyJSONRequestBody = RotateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List available sumdb trees
 	// (GET /api/v1/sumdb/trees)
 	ListTrees(c *gin.Context)
+	// Create a sumdb tree
+	// (POST /api/v1/sumdb/trees)
+	CreateTree(c *gin.Context)
+	// Audit the specified tree
+	// (GET /api/v1/sumdb/trees/{name}/audit)
+	AuditTree(c *gin.Context, name string)
+	// Add witness cosignatures to a tree head of the specified tree
+	// (POST /api/v1/sumdb/trees/{name}/cosignatures)
+	AddTreeCosignatures(c *gin.Context, name string)
+	// Export the specified tree to a storage bucket
+	// (POST /api/v1/sumdb/trees/{name}/export)
+	ExportTree(c *gin.Context, name string)
+	// Freeze the specified tree
+	// (POST /api/v1/sumdb/trees/{name}/freeze)
+	FreezeTree(c *gin.Context, name string)
 	// List hashes in the specified tree
 	// (GET /api/v1/sumdb/trees/{name}/hashes)
-	ListTreeHashes(c *gin.Context, name string)
+	ListTreeHashes(c *gin.Context, name string, params ListTreeHashesParams)
+	// List the signed tree heads of the specified tree
+	// (GET /api/v1/sumdb/trees/{name}/heads)
+	ListTreeHeads(c *gin.Context, name string, params ListTreeHeadsParams)
+	// Get the signed tree head of the specified tree at the given size
+	// (GET /api/v1/sumdb/trees/{name}/heads/{size})
+	GetTreeHead(c *gin.Context, name string, size int64)
+	// List the verifier keys of the specified tree
+	// (GET /api/v1/sumdb/trees/{name}/keys)
+	ListTreeKeys(c *gin.Context, name string)
+	// Prove that a tree size contains an earlier one
+	// (GET /api/v1/sumdb/trees/{name}/proofs/consistency)
+	ProveConsistency(c *gin.Context, name string, params ProveConsistencyParams)
+	// Prove that a module version is included in the specified tree
+	// (GET /api/v1/sumdb/trees/{name}/proofs/inclusion)
+	ProveInclusion(c *gin.Context, name string, params ProveInclusionParams)
 	// List records in the specified tree
 	// (GET /api/v1/sumdb/trees/{name}/records)
-	ListTreeRecords(c *gin.Context, name string)
+	ListTreeRecords(c *gin.Context, name string, params ListTreeRecordsParams)
+	// Retire the specified tree
+	// (POST /api/v1/sumdb/trees/{name}/retire)
+	RetireTree(c *gin.Context, name string)
+	// Rotate the signing key of the specified tree
+	// (POST /api/v1/sumdb/trees/{name}/rotate)
+	RotateTreeKey(c *gin.Context, name string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -81,6 +396,123 @@ func (siw *ServerInterfaceWrapper) ListTrees(c *gin.Context) {
 	siw.Handler.ListTrees(c)
 }
 
+// CreateTree operation middleware
+func (siw *ServerInterfaceWrapper) CreateTree(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTree(c)
+}
+
+// AuditTree operation middleware
+func (siw *ServerInterfaceWrapper) AuditTree(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AuditTree(c, name)
+}
+
+// AddTreeCosignatures operation middleware
+func (siw *ServerInterfaceWrapper) AddTreeCosignatures(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddTreeCosignatures(c, name)
+}
+
+// ExportTree operation middleware
+func (siw *ServerInterfaceWrapper) ExportTree(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportTree(c, name)
+}
+
+// FreezeTree operation middleware
+func (siw *ServerInterfaceWrapper) FreezeTree(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FreezeTree(c, name)
+}
+
 // ListTreeHashes operation middleware
 func (siw *ServerInterfaceWrapper) ListTreeHashes(c *gin.Context) {
 
@@ -95,6 +527,54 @@ func (siw *ServerInterfaceWrapper) ListTreeHashes(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTreeHashesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -102,7 +582,236 @@ func (siw *ServerInterfaceWrapper) ListTreeHashes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListTreeHashes(c, name)
+	siw.Handler.ListTreeHashes(c, name, params)
+}
+
+// ListTreeHeads operation middleware
+func (siw *ServerInterfaceWrapper) ListTreeHeads(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTreeHeadsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTreeHeads(c, name, params)
+}
+
+// GetTreeHead operation middleware
+func (siw *ServerInterfaceWrapper) GetTreeHead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "size" -------------
+	var size int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "size", c.Param("size"), &size, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTreeHead(c, name, size)
+}
+
+// ListTreeKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListTreeKeys(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTreeKeys(c, name)
+}
+
+// ProveConsistency operation middleware
+func (siw *ServerInterfaceWrapper) ProveConsistency(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProveConsistencyParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProveConsistency(c, name, params)
+}
+
+// ProveInclusion operation middleware
+func (siw *ServerInterfaceWrapper) ProveInclusion(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProveInclusionParams
+
+	// ------------- Required query parameter "module" -------------
+
+	if paramValue := c.Query("module"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument module is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "module", c.Request.URL.Query(), &params.Module)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter module: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProveInclusion(c, name, params)
 }
 
 // ListTreeRecords operation middleware
@@ -119,6 +828,122 @@ func (siw *ServerInterfaceWrapper) ListTreeRecords(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTreeRecordsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pathPrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pathPrefix", c.Request.URL.Query(), &params.PathPrefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pathPrefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", c.Request.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTreeRecords(c, name, params)
+}
+
+// RetireTree operation middleware
+func (siw *ServerInterfaceWrapper) RetireTree(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RetireTree(c, name)
+}
+
+// RotateTreeKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateTreeKey(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -126,7 +951,7 @@ func (siw *ServerInterfaceWrapper) ListTreeRecords(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListTreeRecords(c, name)
+	siw.Handler.RotateTreeKey(c, name)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -157,6 +982,18 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/api/v1/sumdb/trees", wrapper.ListTrees)
+	router.POST(options.BaseURL+"/api/v1/sumdb/trees", wrapper.CreateTree)
+	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/audit", wrapper.AuditTree)
+	router.POST(options.BaseURL+"/api/v1/sumdb/trees/:name/cosignatures", wrapper.AddTreeCosignatures)
+	router.POST(options.BaseURL+"/api/v1/sumdb/trees/:name/export", wrapper.ExportTree)
+	router.POST(options.BaseURL+"/api/v1/sumdb/trees/:name/freeze", wrapper.FreezeTree)
 	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/hashes", wrapper.ListTreeHashes)
+	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/heads", wrapper.ListTreeHeads)
+	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/heads/:size", wrapper.GetTreeHead)
+	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/keys", wrapper.ListTreeKeys)
+	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/proofs/consistency", wrapper.ProveConsistency)
+	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/proofs/inclusion", wrapper.ProveInclusion)
 	router.GET(options.BaseURL+"/api/v1/sumdb/trees/:name/records", wrapper.ListTreeRecords)
+	router.POST(options.BaseURL+"/api/v1/sumdb/trees/:name/retire", wrapper.RetireTree)
+	router.POST(options.BaseURL+"/api/v1/sumdb/trees/:name/rotate", wrapper.RotateTreeKey)
 }
@@ -0,0 +1,351 @@
+package sumdb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+const (
+	// MaxDivergences is the maximum number of divergences listed in an AuditReport. All of them are counted.
+	MaxDivergences = 1000
+
+	auditBatchSize = 1000
+)
+
+// ErrUnknownTree is returned when a tree doesn't exist.
+var ErrUnknownTree = errors.New("unknown tree")
+
+// AuditReport is the result of auditing a tree. The tree is consistent when there are no divergences.
+type AuditReport struct {
+	// Tree is the name of the tree.
+	Tree string
+	// Size is the size of the tree, as recorded in the database.
+	Size int64
+	// Records is the number of records in the tree.
+	Records int64
+	// Hashes is the number of hashes stored for the tree.
+	Hashes int64
+	// Heads is the number of recorded tree heads.
+	Heads int64
+	// Divergences describes each inconsistency found, up to MaxDivergences.
+	Divergences []string
+	// Total is the number of inconsistencies found, including those not listed in Divergences.
+	Total int
+}
+
+// OK reports whether the tree is consistent.
+func (r *AuditReport) OK() bool {
+	return r.Total == 0
+}
+
+func (r *AuditReport) divergef(format string, args ...any) {
+	r.Total++
+	if len(r.Divergences) < MaxDivergences {
+		r.Divergences = append(r.Divergences, fmt.Sprintf(format, args...))
+	}
+}
+
+// Audit checks that the tree with the given name is internally consistent. Every hash is recomputed from the records
+// (using tlog) and compared with the stored ones, which must all exist. The tree size must match the number of records,
+// and the tree's signer key must pair with its verifier key. Every recorded tree head must also match the records,
+// otherwise different views of the tree have been signed, and its note must be verified by the tree's current or
+// previous keys (see Rotate).
+//
+// Inconsistencies are reported as divergences, while an error is only returned when the audit couldn't be run.
+func Audit(ctx context.Context, db *ent.Client, name string) (*AuditReport, error) {
+	tree, err := treeKeys(ctx, db.SumDBTree, name)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AuditReport{Tree: tree.Name, Size: tree.Size}
+	expected, err := auditRecords(ctx, db, tree, report)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auditHashes(ctx, db, tree, expected, report); err != nil {
+		return nil, err
+	}
+
+	if tree.Size != report.Records {
+		report.divergef("tree size %d doesn't match the number of records (%d)", tree.Size, report.Records)
+	}
+
+	auditKeyPair(tree, report)
+	if err := auditHeads(ctx, db, tree, expected, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// auditRecords checks the tree's records, returning the hashes computed from them. Hashes can't be computed past a
+// missing record, so only those for the records before it are returned.
+func auditRecords(
+	ctx context.Context,
+	db *ent.Client,
+	tree *ent.SumDBTree,
+	report *AuditReport,
+) ([]tlog.Hash, error) {
+	var hashes []tlog.Hash
+	reader := tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		res := make([]tlog.Hash, len(indexes))
+		for i, idx := range indexes {
+			res[i] = hashes[idx]
+		}
+
+		return res, nil
+	})
+
+	var next int64
+	contiguous := true
+	for {
+		recs, err := db.SumDBRecord.Query().
+			Where(
+				sumdbrecord.HasTreeWith(sumdbtree.ID(tree.ID)),
+				sumdbrecord.RecordIDGTE(next),
+			).
+			Order(sumdbrecord.ByRecordID(sql.OrderAsc())).
+			Limit(auditBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query records: %s, %w", tree.Name, err)
+		}
+
+		for _, rec := range recs {
+			report.Records++
+			if contiguous && rec.RecordID != next {
+				report.divergef("records %d-%d are missing, so later hashes can't be verified", next, rec.RecordID-1)
+				contiguous = false
+			}
+			next = rec.RecordID + 1
+
+			prefix := fmt.Appendf(nil, "%s %s ", rec.Path, rec.Version)
+			if !bytes.HasPrefix(rec.Data, prefix) {
+				report.divergef("record %d: data isn't for %s@%s", rec.RecordID, rec.Path, rec.Version)
+			}
+
+			if !contiguous {
+				continue
+			}
+
+			stored, err := tlog.StoredHashes(rec.RecordID, rec.Data, reader)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute hashes: %s, record %d, %w", tree.Name, rec.RecordID, err)
+			}
+
+			hashes = append(hashes, stored...)
+		}
+
+		if len(recs) < auditBatchSize {
+			return hashes, nil
+		}
+	}
+}
+
+// auditHashes compares the tree's stored hashes with the expected ones, computed from its records.
+func auditHashes(
+	ctx context.Context,
+	db *ent.Client,
+	tree *ent.SumDBTree,
+	expected []tlog.Hash,
+	report *AuditReport,
+) error {
+	var next int64
+	for {
+		hashes, err := db.SumDBHash.Query().
+			Where(
+				sumdbhash.HasTreeWith(sumdbtree.ID(tree.ID)),
+				sumdbhash.IndexGTE(next),
+			).
+			Order(sumdbhash.ByIndex(sql.OrderAsc())).
+			Limit(auditBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query hashes: %s, %w", tree.Name, err)
+		}
+
+		for _, h := range hashes {
+			report.Hashes++
+			for ; next < h.Index && next < int64(len(expected)); next++ {
+				report.divergef("hash %d is missing", next)
+			}
+			next = h.Index + 1
+
+			if h.Index >= int64(len(expected)) {
+				report.divergef("hash %d is unexpected, the records only have %d hashes", h.Index, len(expected))
+				continue
+			}
+
+			if want := expected[h.Index]; !bytes.Equal(h.Hash, want[:]) {
+				report.divergef("hash %d is %s, expected %s", h.Index, hashString(h.Hash), want)
+			}
+		}
+
+		if len(hashes) < auditBatchSize {
+			break
+		}
+	}
+
+	for ; next < int64(len(expected)); next++ {
+		report.divergef("hash %d is missing", next)
+	}
+
+	return nil
+}
+
+// auditKeyPair checks that the tree's signer key pairs with its verifier key, by signing a note with one and verifying
+// it with the other. The tree heads signed with the keys are checked by auditHeads.
+func auditKeyPair(tree *ent.SumDBTree, report *AuditReport) {
+	verifier, err := note.NewVerifier(tree.VerifierKey)
+	if err != nil {
+		report.divergef("invalid verifier key: %s", err)
+		return
+	}
+
+	signer, err := note.NewSigner(string(tree.SignerKey))
+	if err != nil {
+		report.divergef("invalid signer key: %s", err)
+		return
+	}
+
+	msg, err := note.Sign(&note.Note{Text: "audit " + tree.Name + "\n"}, signer)
+	if err != nil {
+		report.divergef("failed to sign with the signer key: %s", err)
+		return
+	}
+
+	if _, err := note.Open(msg, note.VerifierList(verifier)); err != nil {
+		report.divergef("signer key isn't paired with the verifier key: %s", err)
+	}
+}
+
+// auditHeads checks that the recorded tree heads match the tree's records, and that their notes are verified by the
+// tree's keys.
+func auditHeads(
+	ctx context.Context,
+	db *ent.Client,
+	tree *ent.SumDBTree,
+	expected []tlog.Hash,
+	report *AuditReport,
+) error {
+	verifiers := headVerifiers(tree, report)
+
+	next := int64(-1)
+	for {
+		heads, err := db.SumDBTreeHead.Query().
+			Where(
+				sumdbtreehead.HasTreeWith(sumdbtree.ID(tree.ID)),
+				sumdbtreehead.SizeGT(next),
+			).
+			Order(sumdbtreehead.BySize(sql.OrderAsc())).
+			Limit(auditBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query tree heads: %s, %w", tree.Name, err)
+		}
+
+		for _, h := range heads {
+			report.Heads++
+			next = h.Size
+			auditHeadNote(h, verifiers, report)
+
+			// NB: As above, hashes can't be computed for empty trees, or past missing records.
+			if h.Size > report.Records {
+				report.divergef("tree head %d is beyond the end of the tree", h.Size)
+				continue
+			}
+
+			if h.Size == 0 || tlog.StoredHashCount(h.Size) > int64(len(expected)) {
+				continue
+			}
+
+			want, err := tlog.TreeHash(h.Size, hashReader(expected))
+			if err != nil {
+				return fmt.Errorf("failed to compute tree hash: %s, size %d, %w", tree.Name, h.Size, err)
+			}
+
+			if !bytes.Equal(h.Hash, want[:]) {
+				report.divergef("tree head %d hash is %s, expected %s", h.Size, hashString(h.Hash), want)
+			}
+		}
+
+		if len(heads) < auditBatchSize {
+			return nil
+		}
+	}
+}
+
+// headVerifiers returns the verifiers for the tree's current and previous keys. Invalid previous keys are reported
+// (the current one is checked by auditKeyPair).
+func headVerifiers(tree *ent.SumDBTree, report *AuditReport) note.Verifiers {
+	var verifiers []note.Verifier
+	if v, err := note.NewVerifier(tree.VerifierKey); err == nil {
+		verifiers = append(verifiers, v)
+	}
+
+	for _, key := range tree.Edges.Keys {
+		v, err := note.NewVerifier(key.VerifierKey)
+		if err != nil {
+			report.divergef("invalid previous verifier key %d: %s", key.ID, err)
+			continue
+		}
+
+		verifiers = append(verifiers, v)
+	}
+
+	return note.VerifierList(verifiers...)
+}
+
+// auditHeadNote checks that the note of the recorded tree head h is verified by one of verifiers, and is for h's size
+// and hash.
+func auditHeadNote(h *ent.SumDBTreeHead, verifiers note.Verifiers, report *AuditReport) {
+	msg, err := note.Open(h.Note, verifiers)
+	if err != nil {
+		report.divergef("tree head %d note isn't verified by the tree's keys: %s", h.Size, err)
+		return
+	}
+
+	signed, err := tlog.ParseTree([]byte(msg.Text))
+	if err != nil {
+		report.divergef("tree head %d note is invalid: %s", h.Size, err)
+		return
+	}
+
+	if signed.N != h.Size || !bytes.Equal(signed.Hash[:], h.Hash) {
+		report.divergef("tree head %d note is for size %d with hash %s", h.Size, signed.N, signed.Hash)
+	}
+}
+
+// hashReader reads hashes from the given (computed) ones.
+func hashReader(hashes []tlog.Hash) tlog.HashReader {
+	return tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		res := make([]tlog.Hash, len(indexes))
+		for i, idx := range indexes {
+			res[i] = hashes[idx]
+		}
+
+		return res, nil
+	})
+}
+
+// hashString formats a stored hash the same way as tlog.Hash, unless it's the wrong size.
+func hashString(h []byte) string {
+	if len(h) != tlog.HashSize {
+		return fmt.Sprintf("%x (%d bytes)", h, len(h))
+	}
+
+	return tlog.Hash(h).String()
+}
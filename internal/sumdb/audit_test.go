@@ -0,0 +1,215 @@
+package sumdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/pseudomuto/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+func TestAudit(t *testing.T) {
+	t.Parallel()
+
+	audit := func(t *testing.T, n int, corrupt func(*ent.Client, *ent.SumDBTree)) *AuditReport {
+		t.Helper()
+
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		t.Cleanup(func() { _ = client.Close() })
+
+		tree := seedTree(t, client, n)
+		if corrupt != nil {
+			corrupt(client, tree)
+		}
+
+		report, err := Audit(t.Context(), client, tree.Name)
+		require.NoError(t, err)
+		return report
+	}
+
+	t.Run("consistent", func(t *testing.T) {
+		report := audit(t, 300, nil)
+		require.True(t, report.OK(), report.Divergences)
+		require.Equal(t, "test.sumdb.com", report.Tree)
+		require.Equal(t, int64(300), report.Size)
+		require.Equal(t, int64(300), report.Records)
+		require.Equal(t, tlog.StoredHashCount(300), report.Hashes)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		report := audit(t, 0, nil)
+		require.True(t, report.OK(), report.Divergences)
+	})
+
+	t.Run("modified hash", func(t *testing.T) {
+		// NB: The hash of records 8 and 9 is part of the tree hash (as opposed to their leaf hashes).
+		idx := tlog.StoredHashIndex(1, 4)
+		report := audit(t, 10, func(client *ent.Client, _ *ent.SumDBTree) {
+			client.SumDBHash.Update().Where(sumdbhash.Index(idx)).SetHash(make([]byte, 32)).ExecX(t.Context())
+		})
+
+		require.False(t, report.OK())
+		require.Len(t, report.Divergences, 1)
+		require.Regexp(t, fmt.Sprintf(`^hash %d is AAAA.+=, expected .+=$`, idx), report.Divergences[0])
+	})
+
+	t.Run("missing hashes", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, _ *ent.SumDBTree) {
+			client.SumDBHash.Delete().Where(sumdbhash.IndexIn(4, 17)).ExecX(t.Context())
+		})
+
+		require.False(t, report.OK())
+		require.Equal(t, []string{"hash 4 is missing", "hash 17 is missing"}, report.Divergences)
+	})
+
+	t.Run("missing record", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, _ *ent.SumDBTree) {
+			client.SumDBRecord.Delete().Where(sumdbrecord.RecordID(5)).ExecX(t.Context())
+		})
+
+		require.False(t, report.OK())
+		require.Equal(t, int64(9), report.Records)
+		require.Contains(t, report.Divergences, "records 5-5 are missing, so later hashes can't be verified")
+		require.Contains(t, report.Divergences, "hash 8 is unexpected, the records only have 8 hashes")
+		require.Contains(t, report.Divergences, "tree size 10 doesn't match the number of records (9)")
+	})
+
+	t.Run("modified record", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, _ *ent.SumDBTree) {
+			client.SumDBRecord.Update().
+				Where(sumdbrecord.RecordID(2)).
+				SetData([]byte("example.com/Other v1.0.0 h1:zip=\nexample.com/Other v1.0.0/go.mod h1:mod=\n")).
+				ExecX(t.Context())
+		})
+
+		require.False(t, report.OK())
+		require.Contains(t, report.Divergences, "record 2: data isn't for example.com/Mod@v1.0.2")
+		require.Regexp(t, `^hash 3 is .+, expected .+$`, report.Divergences[1])
+	})
+
+	t.Run("tree size", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, tree *ent.SumDBTree) {
+			client.SumDBTree.UpdateOne(tree).SetSize(12).ExecX(t.Context())
+		})
+
+		require.False(t, report.OK())
+		require.Contains(t, report.Divergences, "tree size 12 doesn't match the number of records (10)")
+	})
+
+	t.Run("verifier key", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, tree *ent.SumDBTree) {
+			_, vkey, err := sumdb.GenerateKeys(tree.Name)
+			require.NoError(t, err)
+			client.SumDBTree.UpdateOne(tree).SetVerifierKey(vkey).ExecX(t.Context())
+		})
+
+		require.False(t, report.OK())
+		require.Len(t, report.Divergences, 1)
+		require.Contains(t, report.Divergences[0], "signer key isn't paired with the verifier key")
+	})
+
+	// signHeads records a signed tree head, then rotates the tree's key and records another after appending records.
+	signHeads := func(t *testing.T, client *ent.Client, tree *ent.SumDBTree) []*ent.SumDBTreeHead {
+		t.Helper()
+
+		sign := func(tree *ent.SumDBTree) {
+			sdb, err := NewSumDB(tree, client)
+			require.NoError(t, err)
+
+			_, err = sdb.Signed(t.Context())
+			require.NoError(t, err)
+		}
+
+		sign(tree)
+		rotated, err := Rotate(t.Context(), client, tree.Name, 0)
+		require.NoError(t, err)
+
+		tx, err := client.Tx(t.Context())
+		require.NoError(t, err)
+		_, err = Append(t.Context(), tx, tree.ID, NewRecord(
+			module.Version{Path: "example.com/Other", Version: "v1.0.0"},
+			"h1:zip=",
+			"h1:mod=",
+		))
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+
+		sign(rotated)
+		return client.SumDBTreeHead.Query().Order(ent.Asc(sumdbtreehead.FieldSize)).AllX(t.Context())
+	}
+
+	// setNote replaces the (immutable) note of the recorded tree head.
+	setNote := func(t *testing.T, client *ent.Client, tree *ent.SumDBTree, head *ent.SumDBTreeHead, msg []byte) {
+		t.Helper()
+
+		client.SumDBTreeHead.DeleteOne(head).ExecX(t.Context())
+		client.SumDBTreeHead.Create().
+			SetTreeID(tree.ID).
+			SetSize(head.Size).
+			SetHash(head.Hash).
+			SetNote(msg).
+			ExecX(t.Context())
+	}
+
+	t.Run("tree heads", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, tree *ent.SumDBTree) {
+			heads := signHeads(t, client, tree)
+			require.Len(t, heads, 2)
+		})
+
+		// NB: The first head was signed before the key was rotated, so it's verified by the previous key.
+		require.True(t, report.OK(), report.Divergences)
+		require.Equal(t, int64(2), report.Heads)
+	})
+
+	t.Run("forged tree head", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, tree *ent.SumDBTree) {
+			heads := signHeads(t, client, tree)
+
+			skey, _, err := sumdb.GenerateKeys(tree.Name)
+			require.NoError(t, err)
+
+			signer, err := note.NewSigner(skey)
+			require.NoError(t, err)
+
+			text, _, _ := bytes.Cut(heads[0].Note, []byte("\n\n"))
+			forged, err := note.Sign(&note.Note{Text: string(text) + "\n"}, signer)
+			require.NoError(t, err)
+
+			setNote(t, client, tree, heads[0], forged)
+		})
+
+		require.False(t, report.OK())
+		require.Len(t, report.Divergences, 1)
+		require.Contains(t, report.Divergences[0], "tree head 10 note isn't verified by the tree's keys")
+	})
+
+	t.Run("mismatched tree head", func(t *testing.T) {
+		report := audit(t, 10, func(client *ent.Client, tree *ent.SumDBTree) {
+			heads := signHeads(t, client, tree)
+			setNote(t, client, tree, heads[0], heads[1].Note)
+		})
+
+		require.False(t, report.OK())
+		require.Len(t, report.Divergences, 1)
+		require.Regexp(t, `^tree head 10 note is for size 11 with hash .+$`, report.Divergences[0])
+	})
+
+	t.Run("unknown tree", func(t *testing.T) {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		t.Cleanup(func() { _ = client.Close() })
+
+		_, err := Audit(t.Context(), client, "unknown.sumdb.com")
+		require.ErrorIs(t, err, ErrUnknownTree)
+	})
+}
@@ -3,6 +3,7 @@ package sumdb
 import (
 	"log/slog"
 
+	"github.com/pseudomuto/pacman/internal/publisher"
 	"github.com/pseudomuto/pacman/internal/types"
 	"go.uber.org/fx"
 )
@@ -11,6 +12,21 @@ var Module = fx.Module(
 	"sumdb",
 	fx.Provide(
 		NewSumDBPool,
+		fx.Annotate(
+			func(p *SumDBPool) types.Router { return p },
+			fx.ResultTags(types.FXServerRouters),
+		),
+		NewLookupCache,
+		NewTileStore,
+		NewWitnesses,
+		fx.Annotate(
+			NewRecorder,
+			fx.As(new(publisher.Recorder)),
+		),
+		fx.Annotate(
+			func(c *LookupCache) publisher.Invalidator { return c },
+			fx.ResultTags(publisher.FXInvalidators),
+		),
 		fx.Annotate(
 			NewHandler,
 			fx.As(new(types.Router)),
@@ -19,7 +35,7 @@ var Module = fx.Module(
 	),
 	// NB: this is a forcing function to trigger NewSumDBPool.
 	// This ensures that sumdb trees are created when necessary on startup.
-	fx.Invoke(func(log *slog.Logger, sdbs []*SumDB) {
-		log.Debug("Initialized sumdbs", "n", len(sdbs))
+	fx.Invoke(func(log *slog.Logger, _ *SumDBPool) {
+		log.Debug("Initialized sumdbs")
 	}),
 )
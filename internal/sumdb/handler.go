@@ -1,25 +1,55 @@
 package sumdb
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/pseudomuto/pacman/internal/api/common"
+	"github.com/pseudomuto/pacman/internal/auth"
+	"github.com/pseudomuto/pacman/internal/config"
 	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+	"github.com/pseudomuto/pacman/internal/storage"
 	"github.com/pseudomuto/pacman/internal/sumdb/api"
+	"github.com/pseudomuto/sumdb"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
 )
 
+// maxNoteSize is the maximum size of a cosigned note.
+const maxNoteSize = 64 << 10
+
 // Handler implements the generated api.ServerInterface for the sumdb domain.
 type Handler struct {
-	db *ent.Client
+	auth     *auth.Authenticator
+	db       *ent.Client
+	pool     *SumDBPool
+	reserved []string
 }
 
 // NewHandler creates a new sumdb API handler.
-func NewHandler(db *ent.Client) *Handler {
-	return &Handler{db: db}
+func NewHandler(c *config.Config, a *auth.Authenticator, db *ent.Client, pool *SumDBPool) *Handler {
+	// NB: Trees are also served at /goproxy/<tree>, so they can't be named after the other Go proxies.
+	reserved := []string{pool.up.Name()}
+	if c.Go.Virtual != nil {
+		reserved = append(reserved, c.Go.Virtual.Name)
+	}
+
+	return &Handler{auth: a, db: db, pool: pool, reserved: reserved}
 }
 
 // ListTrees implements api.ServerInterface.
@@ -32,54 +62,170 @@ func (h *Handler) ListTrees(ctx *gin.Context) {
 
 	res := make(api.TreeList, len(trees))
 	for i := range trees {
-		res[i] = api.Tree{
-			Name:        trees[i].Name,
-			Size:        trees[i].Size,
-			VerifierKey: trees[i].VerifierKey,
-			CreatedAt:   trees[i].CreatedAt,
-			UpdatedAt:   trees[i].UpdatedAt,
-		}
+		res[i] = toTree(trees[i])
 	}
 
 	ctx.JSON(http.StatusOK, res)
 }
 
-func (h *Handler) ListTreeHashes(ctx *gin.Context, name string) {
-	hashes, err := h.db.SumDBHash.Query().
-		Where(sumdbhash.HasTreeWith(sumdbtree.NameEqualFold(name))).
-		Order(sumdbhash.ByIndex()).
-		All(ctx)
+// CreateTree implements api.ServerInterface.
+func (h *Handler) CreateTree(ctx *gin.Context) {
+	var req api.CreateTreeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	if slices.Contains(h.reserved, req.Name) {
+		treeError(ctx, fmt.Errorf("%w: %q is reserved", ErrInvalidTreeName, req.Name))
+		return
+	}
+
+	tree, err := CreateTree(ctx, h.db, req.Name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	// NB: Other replicas pick up the tree once their trees are reloaded.
+	h.pool.Reload()
+	ctx.JSON(http.StatusCreated, toTree(tree))
+}
+
+// FreezeTree implements api.ServerInterface.
+func (h *Handler) FreezeTree(ctx *gin.Context, name string) {
+	h.setStatus(ctx, name, Freeze)
+}
+
+// RetireTree implements api.ServerInterface.
+func (h *Handler) RetireTree(ctx *gin.Context, name string) {
+	h.setStatus(ctx, name, Retire)
+}
+
+// setStatus changes the status of the named tree using fn (e.g. Freeze).
+func (h *Handler) setStatus(
+	ctx *gin.Context,
+	name string,
+	fn func(context.Context, *ent.Client, string) (*ent.SumDBTree, error),
+) {
+	tree, err := fn(ctx, h.db, name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	// NB: Other replicas pick up the change once their trees are reloaded.
+	h.pool.Reload()
+	ctx.JSON(http.StatusOK, toTree(tree))
+}
+
+// ListTreeHashes implements api.ServerInterface.
+func (h *Handler) ListTreeHashes(ctx *gin.Context, name string, params api.ListTreeHashesParams) {
+	limit, err := pageSize(params.Limit)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	after, ok, err := decodeIndexCursor(params.Cursor)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.treeID(ctx, name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	q := h.db.SumDBHash.Query().Where(sumdbhash.HasTreeWith(sumdbtree.ID(id)))
+	if ok {
+		q.Where(sumdbhash.IndexGT(after))
+	}
+
+	if params.CreatedAfter != nil {
+		q.Where(sumdbhash.CreatedAtGT(*params.CreatedAfter))
+	}
+
+	hashes, err := q.Order(sumdbhash.ByIndex()).Limit(limit + 1).All(ctx)
 	if err != nil {
 		common.JSONError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
-	res := make(api.HashList, len(hashes))
+	var res api.HashPage
+	if len(hashes) > limit {
+		hashes = hashes[:limit]
+		res.NextCursor = encodeCursor(strconv.FormatInt(hashes[limit-1].Index, 10))
+	}
+
+	res.Items = make(api.HashList, len(hashes))
 	for i := range hashes {
-		res[i] = api.Hash{
+		res.Items[i] = api.Hash{
 			Index: hashes[i].Index,
-			Hash:  string(hashes[i].Hash),
+			Hash:  hashString(hashes[i].Hash),
 		}
 	}
 
-	ctx.JSON(http.StatusOK, res)
+	jsonWithETag(ctx, params.IfNoneMatch, res)
 }
 
-func (h *Handler) ListTreeRecords(ctx *gin.Context, name string) {
-	records, err := h.db.SumDBRecord.Query().
-		Where(
-			sumdbrecord.HasTreeWith(sumdbtree.NameEqualFold(name)),
-		).
-		Order(sumdbrecord.ByPath(), sumdbrecord.ByVersion()).
-		All(ctx)
+// ListTreeRecords implements api.ServerInterface.
+func (h *Handler) ListTreeRecords(ctx *gin.Context, name string, params api.ListTreeRecordsParams) {
+	limit, err := pageSize(params.Limit)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	after, err := decodeCursor(params.Cursor, 2)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.treeID(ctx, name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	q := h.db.SumDBRecord.Query().Where(sumdbrecord.HasTreeWith(sumdbtree.ID(id)))
+	if after != nil {
+		q.Where(sumdbrecord.Or(
+			sumdbrecord.PathGT(after[0]),
+			sumdbrecord.And(sumdbrecord.Path(after[0]), sumdbrecord.VersionGT(after[1])),
+		))
+	}
+
+	if params.PathPrefix != nil {
+		q.Where(sumdbrecord.PathHasPrefix(*params.PathPrefix))
+	}
+
+	if params.Version != nil {
+		q.Where(sumdbrecord.Version(*params.Version))
+	}
+
+	if params.CreatedAfter != nil {
+		q.Where(sumdbrecord.CreatedAtGT(*params.CreatedAfter))
+	}
+
+	records, err := q.Order(sumdbrecord.ByPath(), sumdbrecord.ByVersion()).Limit(limit + 1).All(ctx)
 	if err != nil {
 		common.JSONError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
-	res := make(api.RecordList, len(records))
+	var res api.RecordPage
+	if len(records) > limit {
+		records = records[:limit]
+		res.NextCursor = encodeCursor(records[limit-1].Path, records[limit-1].Version)
+	}
+
+	res.Items = make(api.RecordList, len(records))
 	for i := range records {
-		res[i] = api.Record{
+		res.Items[i] = api.Record{
 			Id:        records[i].RecordID,
 			Path:      records[i].Path,
 			Version:   records[i].Version,
@@ -89,10 +235,386 @@ func (h *Handler) ListTreeRecords(ctx *gin.Context, name string) {
 		}
 	}
 
-	ctx.JSON(http.StatusOK, res)
+	jsonWithETag(ctx, params.IfNoneMatch, res)
+}
+
+// ListTreeKeys implements api.ServerInterface.
+func (h *Handler) ListTreeKeys(ctx *gin.Context, name string) {
+	tree, err := treeKeys(ctx, h.db.SumDBTree, name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, keyList(tree))
+}
+
+// RotateTreeKey implements api.ServerInterface.
+func (h *Handler) RotateTreeKey(ctx *gin.Context, name string) {
+	var req api.RotateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	window := DefaultCosignWindow
+	if req.CosignWindow != nil {
+		d, err := time.ParseDuration(*req.CosignWindow)
+		if err != nil || d < 0 {
+			common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid cosign window: %s", *req.CosignWindow))
+			return
+		}
+
+		window = d
+	}
+
+	tree, err := Rotate(ctx, h.db, name, window)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	// NB: Other replicas pick up the new key once their cached keys expire.
+	if sdb, err := h.pool.SumDB(ctx, tree.Name); err == nil {
+		sdb.ReloadKeys()
+	}
+
+	ctx.JSON(http.StatusOK, keyList(tree))
+}
+
+// ListTreeHeads implements api.ServerInterface.
+func (h *Handler) ListTreeHeads(ctx *gin.Context, name string, params api.ListTreeHeadsParams) {
+	limit, err := pageSize(params.Limit)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	before, ok, err := decodeIndexCursor(params.Cursor)
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.treeID(ctx, name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	q := h.db.SumDBTreeHead.Query().Where(sumdbtreehead.HasTreeWith(sumdbtree.ID(id)))
+	if ok {
+		q.Where(sumdbtreehead.SizeLT(before))
+	}
+
+	if params.CreatedAfter != nil {
+		q.Where(sumdbtreehead.CreatedAtGT(*params.CreatedAfter))
+	}
+
+	heads, err := q.
+		WithCosignatures(func(q *ent.SumDBCosignatureQuery) {
+			q.Order(sumdbcosignature.ByID())
+		}).
+		Order(sumdbtreehead.BySize(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		common.JSONError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	var res api.TreeHeadPage
+	if len(heads) > limit {
+		heads = heads[:limit]
+		res.NextCursor = encodeCursor(strconv.FormatInt(heads[limit-1].Size, 10))
+	}
+
+	res.Items = make(api.TreeHeadList, len(heads))
+	for i, head := range heads {
+		res.Items[i] = api.TreeHead{
+			Size:         head.Size,
+			Hash:         hashString(head.Hash),
+			Cosignatures: make([]api.Cosignature, len(head.Edges.Cosignatures)),
+			CreatedAt:    head.CreatedAt,
+		}
+
+		for j, c := range head.Edges.Cosignatures {
+			res.Items[i].Cosignatures[j] = api.Cosignature{
+				Witness:   c.Witness,
+				KeyHash:   fmt.Sprintf("%08x", c.KeyHash),
+				CreatedAt: c.CreatedAt,
+			}
+		}
+	}
+
+	jsonWithETag(ctx, params.IfNoneMatch, res)
+}
+
+// GetTreeHead implements api.ServerInterface.
+func (h *Handler) GetTreeHead(ctx *gin.Context, name string, size int64) {
+	head, err := h.db.SumDBTreeHead.Query().
+		Where(
+			sumdbtreehead.HasTreeWith(sumdbtree.Name(name)),
+			sumdbtreehead.Size(size),
+		).
+		WithCosignatures(func(q *ent.SumDBCosignatureQuery) {
+			q.Order(sumdbcosignature.ByID())
+		}).
+		Only(ctx)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if ent.IsNotFound(err) {
+			status = http.StatusNotFound
+			err = fmt.Errorf("%w: %s, size %d", ErrUnknownTreeHead, name, size)
+		}
+
+		common.JSONError(ctx, status, err)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/plain; charset=UTF-8", cosign(bytes.Clone(head.Note), head))
+}
+
+// AddTreeCosignatures implements api.ServerInterface.
+func (h *Handler) AddTreeCosignatures(ctx *gin.Context, name string) {
+	sdb, ok := h.find(ctx, name)
+	if !ok {
+		return
+	}
+
+	msg, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxNoteSize))
+	if err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	size, n, err := sdb.Cosign(ctx, msg)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, ErrInvalidCosignature):
+			status = http.StatusBadRequest
+		case errors.Is(err, ErrUnknownTreeHead):
+			status = http.StatusNotFound
+		case errors.Is(err, ErrTreeHeadMismatch):
+			status = http.StatusConflict
+		}
+
+		common.JSONError(ctx, status, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, api.Cosigned{Size: size, Cosignatures: n})
+}
+
+// ProveInclusion implements api.ServerInterface.
+func (h *Handler) ProveInclusion(ctx *gin.Context, name string, params api.ProveInclusionParams) {
+	sdb, ok := h.find(ctx, name)
+	if !ok {
+		return
+	}
+
+	path, version, _ := strings.Cut(params.Module, "@")
+	if path == "" || version == "" {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid module version: %s", params.Module))
+		return
+	}
+
+	var size int64
+	if params.Size != nil {
+		size = *params.Size
+	}
+
+	m := module.Version{Path: path, Version: version}
+	proof, err := sdb.ProveInclusion(ctx, m, size)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, ErrInvalidTreeSize):
+			status = http.StatusBadRequest
+		case errors.Is(err, sumdb.ErrNotFound):
+			status = http.StatusNotFound
+			err = fmt.Errorf("unknown module version: %s, %w", m, err)
+		}
+
+		common.JSONError(ctx, status, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, api.InclusionProof{
+		Tree:     sdb.Name(),
+		Path:     m.Path,
+		Version:  m.Version,
+		RecordId: proof.RecordID,
+		Data:     string(proof.Data),
+		Size:     proof.Size,
+		TreeHash: proof.TreeHash.String(),
+		Proof:    hashStrings(proof.Proof),
+	})
+}
+
+// ProveConsistency implements api.ServerInterface.
+func (h *Handler) ProveConsistency(ctx *gin.Context, name string, params api.ProveConsistencyParams) {
+	sdb, ok := h.find(ctx, name)
+	if !ok {
+		return
+	}
+
+	var to int64
+	if params.To != nil {
+		to = *params.To
+	}
+
+	proof, err := sdb.ProveConsistency(ctx, params.From, to)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrInvalidTreeSize) {
+			status = http.StatusBadRequest
+		}
+
+		common.JSONError(ctx, status, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, api.ConsistencyProof{
+		Tree:     sdb.Name(),
+		From:     proof.From,
+		FromHash: proof.FromHash.String(),
+		To:       proof.To,
+		ToHash:   proof.ToHash.String(),
+		Proof:    hashStrings(proof.Proof),
+	})
+}
+
+// AuditTree implements api.ServerInterface.
+func (h *Handler) AuditTree(ctx *gin.Context, name string) {
+	report, err := Audit(ctx, h.db, name)
+	if err != nil {
+		treeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, api.AuditReport{
+		Tree:        report.Tree,
+		Ok:          report.OK(),
+		Size:        report.Size,
+		Records:     report.Records,
+		Hashes:      report.Hashes,
+		Heads:       report.Heads,
+		Divergences: append([]string{}, report.Divergences...),
+		Total:       report.Total,
+	})
+}
+
+// ExportTree implements api.ServerInterface.
+func (h *Handler) ExportTree(ctx *gin.Context, name string) {
+	var req api.ExportRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.JSONError(ctx, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	sdb, ok := h.find(ctx, name)
+	if !ok {
+		return
+	}
+
+	size, err := sdb.Export(ctx, req.Bucket)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, storage.ErrNoStorageForPath) {
+			status = http.StatusBadRequest
+		}
+
+		common.JSONError(ctx, status, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, api.Export{
+		Tree: sdb.Name(),
+		Size: size,
+		Url:  bucketRoot(req.Bucket) + "/sumdb/" + sdb.Name(),
+	})
+}
+
+// treeID returns the ID of the named tree.
+func (h *Handler) treeID(ctx context.Context, name string) (int, error) {
+	id, err := h.db.SumDBTree.Query().Where(sumdbtree.Name(name)).OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, fmt.Errorf("%w: %s", ErrUnknownTree, name)
+		}
+
+		return 0, fmt.Errorf("failed to find tree: %s, %w", name, err)
+	}
+
+	return id, nil
+}
+
+// treeError responds with err, which is a 404 for unknown trees.
+func treeError(ctx *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrUnknownTree):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrInvalidTreeName):
+		status = http.StatusBadRequest
+	case errors.Is(err, ErrTreeExists), errors.Is(err, ErrTreeRetired):
+		status = http.StatusConflict
+	}
+
+	common.JSONError(ctx, status, err)
+}
+
+func toTree(tree *ent.SumDBTree) api.Tree {
+	return api.Tree{
+		Name:        tree.Name,
+		Size:        tree.Size,
+		VerifierKey: tree.VerifierKey,
+		Status:      api.TreeStatus(tree.Status),
+		CreatedAt:   tree.CreatedAt,
+		UpdatedAt:   tree.UpdatedAt,
+	}
+}
+
+// find returns the SumDB for the named tree, responding with an error when it isn't served (e.g. it's been retired).
+func (h *Handler) find(ctx *gin.Context, name string) (*SumDB, bool) {
+	sdb, err := h.pool.SumDB(ctx, name)
+	if err != nil {
+		treeError(ctx, err)
+		return nil, false
+	}
+
+	return sdb, true
+}
+
+// hashStrings formats the hashes of a proof.
+func hashStrings(hashes []tlog.Hash) []string {
+	res := make([]string, len(hashes))
+	for i := range hashes {
+		res[i] = hashes[i].String()
+	}
+
+	return res
+}
+
+// keyList lists the tree's current key, followed by its previous ones (see treeKeys).
+func keyList(tree *ent.SumDBTree) api.KeyList {
+	res := api.KeyList{{VerifierKey: tree.VerifierKey, Current: true}}
+	for _, k := range tree.Edges.Keys {
+		res = append(res, api.Key{
+			VerifierKey: k.VerifierKey,
+			RetiredAt:   &k.CreatedAt,
+			CosignUntil: &k.CosignUntil,
+		})
+	}
+
+	return res
 }
 
 // RegisterRoutes implements types.Router interface.
 func (h *Handler) RegisterRoutes(engine *gin.Engine) {
-	api.RegisterHandlers(engine, h)
+	api.RegisterHandlersWithOptions(engine, h, api.GinServerOptions{
+		Middlewares: []api.MiddlewareFunc{h.auth.Middleware()},
+	})
 }
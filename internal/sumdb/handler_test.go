@@ -2,14 +2,29 @@ package sumdb_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/auth"
+	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent"
 	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/goproxy"
 	. "github.com/pseudomuto/pacman/internal/sumdb"
 	"github.com/pseudomuto/pacman/internal/sumdb/api"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
 )
 
 func TestHandler(t *testing.T) {
@@ -19,7 +34,7 @@ func TestHandler(t *testing.T) {
 	t.Cleanup(func() { _ = client.Close() })
 
 	loadFixture(t, client)
-	h := NewHandler(client)
+	h := NewHandler(&config.Config{}, newAuth(t), client, newPool(t, client))
 
 	t.Run("ListTrees", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -35,19 +50,605 @@ func TestHandler(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 
-		h.ListTreeHashes(ctx, "test2.example.com")
-		var hashes api.HashList
+		h.ListTreeHashes(ctx, "test2.example.com", api.ListTreeHashesParams{})
+		var hashes api.HashPage
 		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &hashes), w.Body.String())
-		require.Len(t, hashes, 1)
+		require.Len(t, hashes.Items, 1)
+		require.Nil(t, hashes.NextCursor)
 	})
 
 	t.Run("ListTreeRecords", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 
-		h.ListTreeRecords(ctx, "test.example.com")
-		var records api.RecordList
+		h.ListTreeRecords(ctx, "test.example.com", api.ListTreeRecordsParams{})
+		var records api.RecordPage
 		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &records), w.Body.String())
-		require.Len(t, records, 2)
+		require.Len(t, records.Items, 2)
+		require.Nil(t, records.NextCursor)
 	})
 }
+
+func TestHandler_ExportTree(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	seedTree(t, client, 1)
+
+	svr := gin.New()
+	NewHandler(&config.Config{}, newAuth(t), client, newPool(t, client)).RegisterRoutes(svr)
+
+	export := func(token, tree, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(
+			t.Context(),
+			"POST",
+			"/api/v1/sumdb/trees/"+tree+"/export",
+			strings.NewReader(body),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		tree   string
+		body   string
+		status int
+	}{
+		{name: "missing token", tree: "test.sumdb.com", body: `{"bucket":"file:///tmp"}`, status: http.StatusUnauthorized},
+		{
+			name:   "publish token",
+			token:  "secret",
+			tree:   "test.sumdb.com",
+			body:   `{"bucket":"file:///tmp"}`,
+			status: http.StatusForbidden,
+		},
+		{name: "invalid request", token: "admin", tree: "test.sumdb.com", body: `{}`, status: http.StatusBadRequest},
+		{
+			name:   "unknown tree",
+			token:  "admin",
+			tree:   "other.sumdb.com",
+			body:   `{"bucket":"file:///tmp"}`,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "unregistered bucket",
+			token:  "admin",
+			tree:   "test.sumdb.com",
+			body:   `{"bucket":"file:///unregistered"}`,
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := export(tt.token, tt.tree, tt.body)
+			require.Equal(t, tt.status, w.Code, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_AuditTree(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	seedTree(t, client, 3)
+
+	svr := gin.New()
+	NewHandler(&config.Config{}, newAuth(t), client, newPool(t, client)).RegisterRoutes(svr)
+
+	audit := func(token, tree string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), "GET", "/api/v1/sumdb/trees/"+tree+"/audit", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, http.StatusUnauthorized, audit("", "test.sumdb.com").Code)
+	require.Equal(t, http.StatusForbidden, audit("secret", "test.sumdb.com").Code)
+	require.Equal(t, http.StatusNotFound, audit("admin", "other.sumdb.com").Code)
+
+	w := audit("admin", "test.sumdb.com")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var report api.AuditReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	require.Equal(t, api.AuditReport{
+		Tree:        "test.sumdb.com",
+		Ok:          true,
+		Size:        3,
+		Records:     3,
+		Hashes:      4,
+		Divergences: []string{},
+	}, report)
+}
+
+func TestHandler_TreeKeys(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedTree(t, client, 3)
+
+	svr := gin.New()
+	NewHandler(&config.Config{}, newAuth(t), client, newPool(t, client)).RegisterRoutes(svr)
+
+	do := func(method, path, token, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), method, "/api/v1/sumdb/trees/"+path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	keys := func(w *httptest.ResponseRecorder) api.KeyList {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var res api.KeyList
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		return res
+	}
+
+	res := keys(do("GET", "test.sumdb.com/keys", "", ""))
+	require.Equal(t, api.KeyList{{VerifierKey: tree.VerifierKey, Current: true}}, res)
+	require.Equal(t, http.StatusNotFound, do("GET", "other.sumdb.com/keys", "", "").Code)
+
+	require.Equal(t, http.StatusUnauthorized, do("POST", "test.sumdb.com/rotate", "", "").Code)
+	require.Equal(t, http.StatusForbidden, do("POST", "test.sumdb.com/rotate", "secret", "").Code)
+	require.Equal(t, http.StatusNotFound, do("POST", "other.sumdb.com/rotate", "admin", "").Code)
+	require.Equal(t, http.StatusBadRequest, do("POST", "test.sumdb.com/rotate", "admin", `{"cosignWindow":"1x"}`).Code)
+	require.Equal(t, http.StatusBadRequest, do("POST", "test.sumdb.com/rotate", "admin", `{"cosignWindow":"-1h"}`).Code)
+
+	res = keys(do("POST", "test.sumdb.com/rotate", "admin", `{"cosignWindow":"72h"}`))
+	require.Len(t, res, 2)
+	require.True(t, res[0].Current)
+	require.NotEqual(t, tree.VerifierKey, res[0].VerifierKey)
+	require.Nil(t, res[0].RetiredAt)
+	require.False(t, res[1].Current)
+	require.Equal(t, tree.VerifierKey, res[1].VerifierKey)
+	require.NotNil(t, res[1].RetiredAt)
+	require.Equal(t, 72*time.Hour, res[1].CosignUntil.Sub(*res[1].RetiredAt).Round(time.Minute))
+
+	// The cosign window is optional, and the new verifier key is published.
+	res = keys(do("POST", "test.sumdb.com/rotate", "admin", ""))
+	require.Len(t, res, 3)
+	require.Equal(t, res, keys(do("GET", "test.sumdb.com/keys", "", "")))
+	require.Equal(t, DefaultCosignWindow, res[1].CosignUntil.Sub(*res[1].RetiredAt).Round(time.Minute))
+	require.Equal(t, res[0].VerifierKey, client.SumDBTree.GetX(t.Context(), tree.ID).VerifierKey)
+}
+
+func TestHandler_ManageTrees(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	pool := newPool(t, client)
+	cfg := &config.Config{Go: config.Go{Virtual: &config.Virtual{Name: "all"}}}
+
+	svr := gin.New()
+	NewHandler(cfg, newAuth(t), client, pool).RegisterRoutes(svr)
+	pool.RegisterRoutes(svr)
+
+	do := func(method, path, token, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	tree := func(w *httptest.ResponseRecorder, code int) api.Tree {
+		require.Equal(t, code, w.Code, w.Body.String())
+
+		var res api.Tree
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		return res
+	}
+
+	const trees = "/api/v1/sumdb/trees"
+	body := `{"name":"new.sumdb.com"}`
+	require.Equal(t, http.StatusUnauthorized, do("POST", trees, "", body).Code)
+	require.Equal(t, http.StatusForbidden, do("POST", trees, "secret", body).Code)
+	require.Equal(t, http.StatusBadRequest, do("POST", trees, "admin", `{}`).Code)
+	require.Equal(t, http.StatusBadRequest, do("POST", trees, "admin", `{"name":"new.sumdb.com/x"}`).Code)
+	require.Equal(t, http.StatusBadRequest, do("POST", trees, "admin", `{"name":"New.sumdb.com"}`).Code)
+
+	// The other Go proxies are served at /goproxy/<name> as well, so their names are reserved.
+	require.Equal(t, http.StatusBadRequest, do("POST", trees, "admin", `{"name":"proxy.golang.org"}`).Code)
+	require.Equal(t, http.StatusBadRequest, do("POST", trees, "admin", `{"name":"all"}`).Code)
+
+	res := tree(do("POST", trees, "admin", body), http.StatusCreated)
+	require.Equal(t, "new.sumdb.com", res.Name)
+	require.Equal(t, api.TreeStatus("active"), res.Status)
+	require.NotEmpty(t, res.VerifierKey)
+	require.Equal(t, http.StatusConflict, do("POST", trees, "admin", body).Code)
+
+	// The tree is served right away.
+	require.Equal(t, http.StatusOK, do("GET", "/sumdb/new.sumdb.com/latest", "", "").Code)
+	require.Equal(t, http.StatusOK, do("GET", trees+"/new.sumdb.com/heads", "", "").Code)
+
+	require.Equal(t, http.StatusForbidden, do("POST", trees+"/new.sumdb.com/freeze", "secret", "").Code)
+	require.Equal(t, http.StatusNotFound, do("POST", trees+"/other.sumdb.com/freeze", "admin", "").Code)
+
+	// Tree names are matched exactly.
+	require.Equal(t, http.StatusNotFound, do("GET", "/sumdb/NEW.sumdb.com/latest", "", "").Code)
+	require.Equal(t, http.StatusNotFound, do("GET", trees+"/NEW.sumdb.com/heads", "", "").Code)
+	require.Equal(t, http.StatusNotFound, do("POST", trees+"/NEW.sumdb.com/freeze", "admin", "").Code)
+
+	res = tree(do("POST", trees+"/new.sumdb.com/freeze", "admin", ""), http.StatusOK)
+	require.Equal(t, api.TreeStatus("frozen"), res.Status)
+	require.Equal(t, http.StatusOK, do("GET", "/sumdb/new.sumdb.com/latest", "", "").Code)
+
+	require.Equal(t, http.StatusForbidden, do("POST", trees+"/new.sumdb.com/retire", "secret", "").Code)
+	res = tree(do("POST", trees+"/new.sumdb.com/retire", "admin", ""), http.StatusOK)
+	require.Equal(t, api.TreeStatus("retired"), res.Status)
+	require.Equal(t, http.StatusNotFound, do("GET", "/sumdb/new.sumdb.com/latest", "", "").Code)
+	require.Equal(t, http.StatusNotFound, do("GET", trees+"/new.sumdb.com/heads/1", "", "").Code)
+	require.Equal(t, http.StatusConflict, do("POST", trees+"/new.sumdb.com/freeze", "admin", "").Code)
+	require.Equal(t, http.StatusConflict, do("POST", trees+"/new.sumdb.com/rotate", "admin", "").Code)
+
+	// Retired trees are still listed.
+	w := do("GET", trees, "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var list api.TreeList
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list, 1)
+	require.Equal(t, api.TreeStatus("retired"), list[0].Status)
+}
+
+func TestHandler_TreeHeads(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedTree(t, client, 3)
+	witness := newWitness(t, "witness.example.com")
+
+	pool := newPool(t, client, config.Witness{Key: witness.vkey})
+	sdb, err := pool.SumDB(t.Context(), tree.Name)
+	require.NoError(t, err)
+
+	signed, err := sdb.Signed(t.Context())
+	require.NoError(t, err)
+
+	svr := gin.New()
+	NewHandler(&config.Config{}, newAuth(t), client, pool).RegisterRoutes(svr)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), method, "/api/v1/sumdb/trees/"+path, strings.NewReader(body))
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	text, _, _ := strings.Cut(string(signed), "\n\n")
+	cosigned := witness.cosign(t, text+"\n")
+	require.Equal(t, http.StatusBadRequest, do("POST", "test.sumdb.com/cosignatures", string(signed)).Code)
+	require.Equal(t, http.StatusNotFound, do("POST", "other.sumdb.com/cosignatures", string(cosigned)).Code)
+	require.Equal(t, http.StatusNotFound, do("POST", "test.sumdb.com/cosignatures", string(witness.cosign(t,
+		"go.sum database tree\n4\nAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=\n"))).Code)
+	require.Equal(t, http.StatusConflict, do("POST", "test.sumdb.com/cosignatures", string(witness.cosign(t,
+		"go.sum database tree\n3\nAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=\n"))).Code)
+
+	w := do("POST", "test.sumdb.com/cosignatures", string(cosigned))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.JSONEq(t, `{"size":3,"cosignatures":1}`, w.Body.String())
+
+	w = do("GET", "test.sumdb.com/heads", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var page api.TreeHeadPage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+	heads := page.Items
+	require.Len(t, heads, 1)
+	require.Equal(t, int64(3), heads[0].Size)
+	require.Len(t, heads[0].Cosignatures, 1)
+	require.Equal(t, "witness.example.com", heads[0].Cosignatures[0].Witness)
+	require.Equal(t, fmt.Sprintf("%08x", witness.KeyHash()), heads[0].Cosignatures[0].KeyHash)
+	require.Equal(t, http.StatusNotFound, do("GET", "other.sumdb.com/heads", "").Code)
+
+	// The tree head is served with its cosignatures.
+	w = do("GET", "test.sumdb.com/heads/3", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, "text/plain; charset=UTF-8", w.Header().Get("Content-Type"))
+	require.True(t, strings.HasPrefix(w.Body.String(), string(signed)))
+	_, err = note.Open(w.Body.Bytes(), note.VerifierList(witness.verifier(t)))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusNotFound, do("GET", "test.sumdb.com/heads/4", "").Code)
+	require.Equal(t, http.StatusBadRequest, do("GET", "test.sumdb.com/heads/latest", "").Code)
+}
+
+func TestHandler_Proofs(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	seedTree(t, client, 20)
+
+	svr := gin.New()
+	NewHandler(&config.Config{}, newAuth(t), client, newPool(t, client)).RegisterRoutes(svr)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), "GET", "/api/v1/sumdb/trees/"+path, nil)
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	hashes := func(strs []string) []tlog.Hash {
+		res := make([]tlog.Hash, len(strs))
+		for i := range strs {
+			var err error
+			res[i], err = tlog.ParseHash(strs[i])
+			require.NoError(t, err)
+		}
+
+		return res
+	}
+
+	t.Run("inclusion", func(t *testing.T) {
+		w := get("test.sumdb.com/proofs/inclusion?module=example.com/Mod@v1.0.7&size=12")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var proof api.InclusionProof
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &proof))
+		require.Equal(t, "example.com/Mod", proof.Path)
+		require.Equal(t, "v1.0.7", proof.Version)
+		require.Equal(t, int64(7), proof.RecordId)
+		require.Equal(t, int64(12), proof.Size)
+
+		treeHash, err := tlog.ParseHash(proof.TreeHash)
+		require.NoError(t, err)
+		require.NoError(t, tlog.CheckRecord(
+			hashes(proof.Proof),
+			proof.Size,
+			treeHash,
+			proof.RecordId,
+			tlog.RecordHash([]byte(proof.Data)),
+		))
+
+		require.Equal(t, http.StatusNotFound, get("other.sumdb.com/proofs/inclusion?module=example.com/Mod@v1.0.7").Code)
+		require.Equal(t, http.StatusNotFound, get("test.sumdb.com/proofs/inclusion?module=example.com/Mod@v2.0.0").Code)
+		require.Equal(t, http.StatusBadRequest, get("test.sumdb.com/proofs/inclusion?module=example.com/Mod").Code)
+		require.Equal(t, http.StatusBadRequest, get("test.sumdb.com/proofs/inclusion").Code)
+		require.Equal(
+			t,
+			http.StatusBadRequest,
+			get("test.sumdb.com/proofs/inclusion?module=example.com/Mod@v1.0.7&size=7").Code,
+		)
+	})
+
+	t.Run("consistency", func(t *testing.T) {
+		w := get("test.sumdb.com/proofs/consistency?from=3")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var proof api.ConsistencyProof
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &proof))
+		require.Equal(t, int64(3), proof.From)
+		require.Equal(t, int64(20), proof.To)
+
+		fromHash, err := tlog.ParseHash(proof.FromHash)
+		require.NoError(t, err)
+		toHash, err := tlog.ParseHash(proof.ToHash)
+		require.NoError(t, err)
+		require.NoError(t, tlog.CheckTree(hashes(proof.Proof), proof.To, toHash, proof.From, fromHash))
+
+		require.Equal(t, http.StatusNotFound, get("other.sumdb.com/proofs/consistency?from=3").Code)
+		require.Equal(t, http.StatusBadRequest, get("test.sumdb.com/proofs/consistency").Code)
+		require.Equal(t, http.StatusBadRequest, get("test.sumdb.com/proofs/consistency?from=3&to=21").Code)
+	})
+}
+
+func TestHandler_Pagination(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedTree(t, client, 25)
+	start := time.Now().UTC()
+	tx, err := client.Tx(t.Context())
+	require.NoError(t, err)
+	_, err = Append(t.Context(), tx, tree.ID, NewRecord(
+		module.Version{Path: "example.org/Other", Version: "v1.0.2"},
+		"h1:zip=",
+		"h1:mod=",
+	))
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	for size := range int64(5) {
+		client.SumDBTreeHead.Create().
+			SetTree(tree).
+			SetSize(size + 1).
+			SetHash(make([]byte, 32)).
+			SetNote([]byte("note")).
+			ExecX(t.Context())
+	}
+
+	svr := gin.New()
+	NewHandler(&config.Config{}, newAuth(t), client, newPool(t, client)).RegisterRoutes(svr)
+
+	get := func(path, etag string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), "GET", "/api/v1/sumdb/trees/test.sumdb.com/"+path, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		svr.ServeHTTP(w, req)
+		return w
+	}
+
+	decode := func(w *httptest.ResponseRecorder, page any) {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), page))
+	}
+
+	t.Run("records", func(t *testing.T) {
+		var versions []string
+		path := "records?limit=10"
+		for pages := 1; ; pages++ {
+			var page api.RecordPage
+			decode(get(path, ""), &page)
+			for _, rec := range page.Items {
+				versions = append(versions, rec.Path+"@"+rec.Version)
+			}
+
+			if page.NextCursor == nil {
+				require.Equal(t, 3, pages)
+				break
+			}
+
+			require.Len(t, page.Items, 10)
+			path = "records?limit=10&cursor=" + url.QueryEscape(*page.NextCursor)
+		}
+
+		require.Len(t, versions, 26)
+		require.True(t, slices.IsSorted(versions))
+		require.Equal(t, "example.org/Other@v1.0.2", versions[25])
+
+		after := url.QueryEscape(start.Format(time.RFC3339Nano))
+		filters := map[string][]string{
+			"records?pathPrefix=example.org/":                {"example.org/Other@v1.0.2"},
+			"records?version=v1.0.2":                         {"example.com/Mod@v1.0.2", "example.org/Other@v1.0.2"},
+			"records?version=v1.0.2&pathPrefix=example.com/": {"example.com/Mod@v1.0.2"},
+			"records?createdAfter=" + after:                  {"example.org/Other@v1.0.2"},
+		}
+
+		for path, want := range filters {
+			var page api.RecordPage
+			decode(get(path, ""), &page)
+
+			got := make([]string, len(page.Items))
+			for i, rec := range page.Items {
+				got[i] = rec.Path + "@" + rec.Version
+			}
+
+			require.Equal(t, want, got, path)
+		}
+	})
+
+	t.Run("hashes", func(t *testing.T) {
+		var page api.HashPage
+		decode(get("hashes?limit=20", ""), &page)
+		require.Len(t, page.Items, 20)
+		require.NotNil(t, page.NextCursor)
+
+		for i, h := range page.Items {
+			require.Equal(t, int64(i), h.Index)
+			_, err := tlog.ParseHash(h.Hash)
+			require.NoError(t, err)
+		}
+
+		cursor := *page.NextCursor
+		page = api.HashPage{}
+		decode(get("hashes?limit=1000&cursor="+url.QueryEscape(cursor), ""), &page)
+		require.Len(t, page.Items, int(tlog.StoredHashCount(26))-20)
+		require.Equal(t, int64(20), page.Items[0].Index)
+		require.Nil(t, page.NextCursor)
+	})
+
+	t.Run("heads", func(t *testing.T) {
+		var page api.TreeHeadPage
+		decode(get("heads?limit=3", ""), &page)
+		require.Len(t, page.Items, 3)
+		require.Equal(t, int64(5), page.Items[0].Size)
+		require.Equal(t, int64(3), page.Items[2].Size)
+
+		cursor := *page.NextCursor
+		page = api.TreeHeadPage{}
+		decode(get("heads?limit=3&cursor="+url.QueryEscape(cursor), ""), &page)
+		require.Len(t, page.Items, 2)
+		require.Equal(t, int64(2), page.Items[0].Size)
+		require.Nil(t, page.NextCursor)
+	})
+
+	t.Run("ETag", func(t *testing.T) {
+		for _, path := range []string{"records", "hashes", "heads"} {
+			w := get(path, "")
+			require.Equal(t, http.StatusOK, w.Code)
+			etag := w.Header().Get("ETag")
+			require.NotEmpty(t, etag)
+
+			w = get(path, etag)
+			require.Equal(t, http.StatusNotModified, w.Code, path)
+			require.Empty(t, w.Body.String())
+			require.Equal(t, etag, w.Header().Get("ETag"))
+
+			require.Equal(t, http.StatusNotModified, get(path, `"other", W/`+etag).Code, path)
+			require.Equal(t, http.StatusOK, get(path, `"other"`).Code, path)
+			require.Equal(t, http.StatusOK, get(path+"?limit=1", etag).Code, path)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, path := range []string{"records", "hashes", "heads"} {
+			require.Equal(t, http.StatusBadRequest, get(path+"?limit=0", "").Code, path)
+			require.Equal(t, http.StatusBadRequest, get(path+"?limit=1001", "").Code, path)
+			require.Equal(t, http.StatusBadRequest, get(path+"?cursor=!", "").Code, path)
+			require.Equal(t, http.StatusBadRequest, get(path+"?cursor=YQpiCmM", "").Code, path)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(t.Context(), "GET", "/api/v1/sumdb/trees/other.sumdb.com/"+path, nil)
+			svr.ServeHTTP(w, req)
+			require.Equal(t, http.StatusNotFound, w.Code, path)
+		}
+	})
+}
+
+func newPool(t *testing.T, client *ent.Client, witnesses ...config.Witness) *SumDBPool {
+	t.Helper()
+
+	w, err := NewWitnesses(&config.Config{Go: config.Go{Witnesses: witnesses}})
+	require.NoError(t, err)
+
+	return NewSumDBPool(client, goproxy.NewUpstreamProxy(client, nil), boot.NewTrees(client), nil, nil, w)
+}
+
+func newAuth(t *testing.T) *auth.Authenticator {
+	t.Helper()
+
+	tokens := []config.Token{
+		{Name: "ci", Token: "secret", Scopes: []string{auth.ScopePublish}},
+		{Name: "ops", Token: "admin", Scopes: []string{auth.ScopeAdmin}},
+	}
+
+	// NB: Tokens are encrypted in the config.
+	for i := range tokens {
+		ct, err := crypto.Secret(tokens[i].Token).Value()
+		require.NoError(t, err)
+		tokens[i].Token = ct.(string)
+	}
+
+	a, err := auth.New(&config.Config{Auth: config.Auth{Tokens: tokens}})
+	require.NoError(t, err)
+	return a
+}
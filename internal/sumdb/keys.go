@@ -0,0 +1,163 @@
+package sumdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"golang.org/x/mod/sumdb/note"
+)
+
+// DefaultCosignWindow is how long tree heads are signed with a tree's previous key after it's rotated.
+const DefaultCosignWindow = 7 * 24 * time.Hour
+
+// Rotate replaces the signing key of the named tree with a new one. The previous key is kept as a SumDBKey, and tree
+// heads are signed with both keys until window has elapsed. This gives clients time to update GOSUMDB to the new
+// verifier key, while those which haven't yet keep verifying the tree. The updated tree, along with its keys, is
+// returned. Retired trees can't be changed, so an ErrTreeRetired is returned for them.
+//
+// Signing keys are reloaded periodically, so every replica signs with the new key shortly after it's rotated.
+func Rotate(ctx context.Context, db *ent.Client, name string, window time.Duration) (*ent.SumDBTree, error) {
+	return data.WithTx(ctx, db, func(tx *ent.Tx) (*ent.SumDBTree, error) {
+		tree, err := tx.SumDBTree.Query().Where(sumdbtree.Name(name)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownTree, name)
+			}
+
+			return nil, fmt.Errorf("failed to find tree: %s, %w", name, err)
+		}
+
+		if tree.Status == sumdbtree.StatusRetired {
+			return nil, fmt.Errorf("%w: %s", ErrTreeRetired, name)
+		}
+
+		skey, vkey, err := boot.GenerateKeys(tree.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tx.SumDBKey.Create().
+			SetTree(tree).
+			SetSignerKey(tree.SignerKey).
+			SetVerifierKey(tree.VerifierKey).
+			SetCosignUntil(time.Now().UTC().Add(window)).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to save previous key: %s, %w", name, err)
+		}
+
+		if err := tx.SumDBTree.UpdateOne(tree).
+			SetSignerKey(skey).
+			SetVerifierKey(vkey).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update key: %s, %w", name, err)
+		}
+
+		return treeKeys(ctx, tx.SumDBTree, name)
+	})
+}
+
+// treeKeys returns the named tree, along with its previous keys (newest first).
+func treeKeys(ctx context.Context, trees *ent.SumDBTreeClient, name string) (*ent.SumDBTree, error) {
+	tree, err := trees.Query().
+		Where(sumdbtree.Name(name)).
+		WithKeys(func(q *ent.SumDBKeyQuery) {
+			q.Order(ent.Desc(sumdbkey.FieldCreatedAt), ent.Desc(sumdbkey.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTree, name)
+		}
+
+		return nil, fmt.Errorf("failed to find tree: %s, %w", name, err)
+	}
+
+	return tree, nil
+}
+
+// Signed implements sumdb.ServerOps. The tree head is signed with the tree's current key, as well as any previous keys
+// which are still within their cosign window. Clients only need one of the signatures to verify the note.
+//
+// Every signed tree head is recorded, and served along with the cosignatures of its witnesses.
+func (s *SumDB) Signed(ctx context.Context) ([]byte, error) {
+	msg, err := s.sign(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := s.recordHead(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cosign(msg, head), nil
+}
+
+// sign signs the current tree head, without recording it.
+func (s *SumDB) sign(ctx context.Context) ([]byte, error) {
+	signed, err := s.sumdb.Signed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := s.loadSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// NB: The text of the note is everything up to the blank line before the signatures.
+	text, _, _ := bytes.Cut(signed, []byte("\n\n"))
+	msg, err := note.Sign(&note.Note{Text: string(text) + "\n"}, signers...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tree head: %s, %w", s.name, err)
+	}
+
+	return msg, nil
+}
+
+// loadSigners returns the signers for the tree's current key and those within their cosign window. They're cached
+// briefly, so keys rotated through other replicas are picked up.
+func (s *SumDB) loadSigners(ctx context.Context) ([]note.Signer, error) {
+	if signers, ok := s.signers.Get(s.id); ok {
+		return signers, nil
+	}
+
+	tree, err := s.db.SumDBTree.Query().
+		Where(sumdbtree.ID(s.id)).
+		WithKeys(func(q *ent.SumDBKeyQuery) {
+			q.Where(sumdbkey.CosignUntilGT(time.Now().UTC()))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keys: %s, %w", s.name, err)
+	}
+
+	skeys := []crypto.Secret{tree.SignerKey}
+	for _, k := range tree.Edges.Keys {
+		skeys = append(skeys, k.SignerKey)
+	}
+
+	signers := make([]note.Signer, len(skeys))
+	for i, skey := range skeys {
+		if signers[i], err = note.NewSigner(string(skey)); err != nil {
+			return nil, fmt.Errorf("invalid signer key: %s, %w", s.name, err)
+		}
+	}
+
+	s.signers.Set(s.id, signers, cache.DefaultTTL)
+	return signers, nil
+}
+
+// ReloadKeys drops the cached signing keys, so the next tree head is signed with the tree's current keys.
+func (s *SumDB) ReloadKeys() {
+	s.signers.Delete(s.id)
+}
@@ -0,0 +1,90 @@
+package sumdb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbkey"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/sumdb/note"
+)
+
+func TestRotate(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedTree(t, client, 3)
+	sdb, err := NewSumDB(tree, client)
+	require.NoError(t, err)
+
+	latest := func() []byte {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), "GET", "/sumdb/test.sumdb.com/latest", nil)
+		sdb.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return w.Body.Bytes()
+	}
+
+	verifies := func(vkey string, msg []byte) bool {
+		v, err := note.NewVerifier(vkey)
+		require.NoError(t, err)
+
+		_, err = note.Open(msg, note.VerifierList(v))
+		return err == nil
+	}
+
+	rotated, err := Rotate(t.Context(), client, tree.Name, time.Hour)
+	require.NoError(t, err)
+	require.NotEqual(t, tree.VerifierKey, rotated.VerifierKey)
+	require.NotEqual(t, tree.SignerKey, rotated.SignerKey)
+	require.Len(t, rotated.Edges.Keys, 1)
+	require.Equal(t, tree.VerifierKey, rotated.Edges.Keys[0].VerifierKey)
+	require.Equal(t, tree.SignerKey, rotated.Edges.Keys[0].SignerKey)
+	require.WithinDuration(t, time.Now().Add(time.Hour), rotated.Edges.Keys[0].CosignUntil, time.Minute)
+
+	// Tree heads are signed with both keys during the cosign window.
+	sdb.ReloadKeys()
+	msg := latest()
+	require.True(t, verifies(tree.VerifierKey, msg))
+	require.True(t, verifies(rotated.VerifierKey, msg))
+
+	// Once it ends, only the new key is used.
+	client.SumDBKey.Update().
+		Where(sumdbkey.VerifierKey(tree.VerifierKey)).
+		SetCosignUntil(time.Now().Add(-time.Second)).
+		ExecX(t.Context())
+
+	sdb.ReloadKeys()
+	msg = latest()
+	require.False(t, verifies(tree.VerifierKey, msg))
+	require.True(t, verifies(rotated.VerifierKey, msg))
+
+	// The tree remains consistent, and previous keys are kept.
+	report, err := Audit(t.Context(), client, tree.Name)
+	require.NoError(t, err)
+	require.True(t, report.OK(), report.Divergences)
+
+	again, err := Rotate(t.Context(), client, tree.Name, 0)
+	require.NoError(t, err)
+	require.Len(t, again.Edges.Keys, 2)
+	require.Equal(t, rotated.VerifierKey, again.Edges.Keys[0].VerifierKey)
+	require.Equal(t, tree.VerifierKey, again.Edges.Keys[1].VerifierKey)
+
+	_, err = Rotate(t.Context(), client, "unknown.sumdb.com", time.Hour)
+	require.ErrorIs(t, err, ErrUnknownTree)
+
+	// Retired trees keep their keys.
+	_, err = Retire(t.Context(), client, tree.Name)
+	require.NoError(t, err)
+
+	_, err = Rotate(t.Context(), client, tree.Name, time.Hour)
+	require.ErrorIs(t, err, ErrTreeRetired)
+	require.Equal(t, again.VerifierKey, client.SumDBTree.GetX(t.Context(), tree.ID).VerifierKey)
+	require.Equal(t, 2, client.SumDBKey.Query().CountX(t.Context()))
+}
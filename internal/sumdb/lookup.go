@@ -0,0 +1,61 @@
+package sumdb
+
+import (
+	"cmp"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/sumdb"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+type (
+	// LookupCache caches Store reads for every tree. Records and tree hashes are append only, so they're cached until
+	// evicted, while tree sizes expire after the configured TTL. Tree sizes are also dropped when records are added (see
+	// Invalidate).
+	LookupCache struct {
+		ttl       time.Duration
+		recordIDs *cache.Cache[recordKey, int64]
+		records   *cache.Cache[indexKey, *sumdb.Record]
+		hashes    *cache.Cache[indexKey, tlog.Hash]
+		sizes     *cache.Cache[int, int64]
+	}
+
+	recordKey struct {
+		tree    int
+		path    string
+		version string
+	}
+
+	indexKey struct {
+		tree  int
+		index int64
+	}
+)
+
+// NewLookupCache creates a LookupCache using the MemoryCache config.
+func NewLookupCache(c *config.Config, m *cache.Metrics) *LookupCache {
+	mc := c.Go.MemoryCache
+	return &LookupCache{
+		ttl:       cmp.Or(mc.TTL, cache.DefaultTTL),
+		recordIDs: cache.New[recordKey, int64]("sumdb_record_ids", mc.Size, cache.WithMetrics(m)),
+		records:   cache.New[indexKey, *sumdb.Record]("sumdb_records", mc.Size, cache.WithMetrics(m)),
+		hashes:    cache.New[indexKey, tlog.Hash]("sumdb_hashes", mc.Size, cache.WithMetrics(m)),
+		sizes:     cache.New[int, int64]("sumdb_tree_sizes", mc.Size, cache.WithMetrics(m)),
+	}
+}
+
+// Invalidate drops the cached tree sizes, since the module at path may have been added to any of them. It implements
+// publisher.Invalidator.
+func (c *LookupCache) Invalidate(string) {
+	c.sizes.DeleteFunc(func(int) bool { return true })
+}
+
+// seen drops the cached size of tree when it doesn't include the record with the given ID. This happens when the
+// record was added through another replica, in which case the tree head must include it.
+func (c *LookupCache) seen(tree int, id int64) {
+	if size, ok := c.sizes.Get(tree); ok && size <= id {
+		c.sizes.Delete(tree)
+	}
+}
@@ -0,0 +1,107 @@
+package sumdb
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/api/common"
+)
+
+const (
+	// DefaultPageSize is the number of items listed per page, unless a limit is requested.
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum number of items listed per page.
+	MaxPageSize = 1000
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageSize validates the requested page size, defaulting to DefaultPageSize.
+func pageSize(limit *int) (int, error) {
+	if limit == nil {
+		return DefaultPageSize, nil
+	}
+
+	if *limit < 1 || *limit > MaxPageSize {
+		return 0, fmt.Errorf("invalid limit: %d, must be between 1 and %d", *limit, MaxPageSize)
+	}
+
+	return *limit, nil
+}
+
+// encodeCursor encodes the position of the last item on a page, which is opaque to clients. The parts of the position
+// can't contain newlines.
+func encodeCursor(parts ...string) *string {
+	cursor := base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, "\n")))
+	return &cursor
+}
+
+// decodeCursor decodes a cursor with n parts. Nil is returned when cursor isn't set.
+func decodeCursor(cursor *string, n int) ([]string, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(*cursor)
+	parts := strings.Split(string(data), "\n")
+	if err != nil || len(parts) != n {
+		return nil, fmt.Errorf("%w: %s", errInvalidCursor, *cursor)
+	}
+
+	return parts, nil
+}
+
+// decodeIndexCursor decodes a cursor positioned at an index (or size).
+func decodeIndexCursor(cursor *string) (int64, bool, error) {
+	parts, err := decodeCursor(cursor, 1)
+	if err != nil || parts == nil {
+		return 0, false, err
+	}
+
+	idx, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %s", errInvalidCursor, *cursor)
+	}
+
+	return idx, true, nil
+}
+
+// jsonWithETag writes v as JSON, tagged with the hash of the response. When ifNoneMatch (the request's If-None-Match
+// header) matches the tag, 304 Not Modified is returned instead.
+func jsonWithETag(ctx *gin.Context, ifNoneMatch *string, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		common.JSONError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+	ctx.Header("ETag", etag)
+
+	if ifNoneMatch != nil && etagMatch(*ifNoneMatch, etag) {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// etagMatch reports whether the If-None-Match header matches etag (using weak comparison, as per RFC 9110).
+func etagMatch(header, etag string) bool {
+	for tag := range strings.SplitSeq(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,140 @@
+package sumdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pseudomuto/sumdb"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+// ErrInvalidTreeSize is returned when a proof is requested for a tree size the tree hasn't reached.
+var ErrInvalidTreeSize = errors.New("invalid tree size")
+
+type (
+	// InclusionProof proves that a record is included in the tree at Size. It's verified by tlog.CheckRecord, using the
+	// hash of the record's data (see tlog.RecordHash).
+	InclusionProof struct {
+		RecordID int64
+		Data     []byte
+		Size     int64
+		TreeHash tlog.Hash
+		Proof    tlog.RecordProof
+	}
+
+	// ConsistencyProof proves that the tree at To contains the tree at From. It's verified by tlog.CheckTree.
+	ConsistencyProof struct {
+		From     int64
+		FromHash tlog.Hash
+		To       int64
+		ToHash   tlog.Hash
+		Proof    tlog.TreeProof
+	}
+)
+
+// ProveInclusion proves that the record for m is included in the tree at size. When size is zero, the current tree size
+// is used. sumdb.ErrNotFound is returned when there's no record for m. Unlike lookups, modules are never fetched.
+func (s *SumDB) ProveInclusion(ctx context.Context, m module.Version, size int64) (*InclusionProof, error) {
+	size, err := s.proofSize(ctx, size)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.store.RecordID(ctx, m.Path, m.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if id >= size {
+		return nil, fmt.Errorf("%w: %s, record %d isn't in the tree at size %d", ErrInvalidTreeSize, s.name, id, size)
+	}
+
+	recs, err := s.store.Records(ctx, id, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recs) != 1 {
+		return nil, fmt.Errorf("failed to read record: %s, %d, %w", s.name, id, sumdb.ErrNotFound)
+	}
+
+	proof, err := tlog.ProveRecord(size, id, s.hashReader(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove inclusion: %s, %s, %w", s.name, m, err)
+	}
+
+	hash, err := tlog.TreeHash(size, s.hashReader(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute tree hash: %s, %w", s.name, err)
+	}
+
+	return &InclusionProof{
+		RecordID: id,
+		Data:     recs[0].Data,
+		Size:     size,
+		TreeHash: hash,
+		Proof:    proof,
+	}, nil
+}
+
+// ProveConsistency proves that the tree at to contains the tree at from. When to is zero, the current tree size is
+// used.
+func (s *SumDB) ProveConsistency(ctx context.Context, from, to int64) (*ConsistencyProof, error) {
+	to, err := s.proofSize(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if from < 1 || from > to {
+		return nil, fmt.Errorf("%w: %s, %d must be between 1 and %d", ErrInvalidTreeSize, s.name, from, to)
+	}
+
+	proof, err := tlog.ProveTree(to, from, s.hashReader(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove consistency: %s, %d-%d, %w", s.name, from, to, err)
+	}
+
+	fromHash, err := tlog.TreeHash(from, s.hashReader(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute tree hash: %s, %w", s.name, err)
+	}
+
+	toHash, err := tlog.TreeHash(to, s.hashReader(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute tree hash: %s, %w", s.name, err)
+	}
+
+	return &ConsistencyProof{
+		From:     from,
+		FromHash: fromHash,
+		To:       to,
+		ToHash:   toHash,
+		Proof:    proof,
+	}, nil
+}
+
+// proofSize validates the tree size a proof is requested for, defaulting to the current size when it's zero.
+func (s *SumDB) proofSize(ctx context.Context, size int64) (int64, error) {
+	current, err := s.store.TreeSize(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if size == 0 {
+		size = current
+	}
+
+	if size < 1 || size > current {
+		return 0, fmt.Errorf("%w: %s, %d must be between 1 and %d", ErrInvalidTreeSize, s.name, size, current)
+	}
+
+	return size, nil
+}
+
+func (s *SumDB) hashReader(ctx context.Context) tlog.HashReader {
+	return tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		return s.store.ReadHashes(ctx, indexes)
+	})
+}
@@ -0,0 +1,75 @@
+package sumdb_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/pseudomuto/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+func TestSumDB_Proofs(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	sdb, err := NewSumDB(seedTree(t, client, 300), client)
+	require.NoError(t, err)
+
+	signed, err := sdb.Signed(t.Context())
+	require.NoError(t, err)
+
+	text, _, _ := bytes.Cut(signed, []byte("\n\n"))
+	head, err := tlog.ParseTree(append(text, '\n'))
+	require.NoError(t, err)
+
+	mod := module.Version{Path: "example.com/Mod", Version: "v1.0.5"}
+
+	t.Run("inclusion", func(t *testing.T) {
+		proof, err := sdb.ProveInclusion(t.Context(), mod, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), proof.RecordID)
+		require.Equal(t, int64(300), proof.Size)
+		require.Equal(t, head.Hash, proof.TreeHash)
+		require.True(t, bytes.HasPrefix(proof.Data, []byte("example.com/Mod v1.0.5 h1:")))
+		require.NoError(t, tlog.CheckRecord(proof.Proof, proof.Size, proof.TreeHash, 5, tlog.RecordHash(proof.Data)))
+
+		proof, err = sdb.ProveInclusion(t.Context(), mod, 6)
+		require.NoError(t, err)
+		require.Equal(t, int64(6), proof.Size)
+		require.NoError(t, tlog.CheckRecord(proof.Proof, proof.Size, proof.TreeHash, 5, tlog.RecordHash(proof.Data)))
+
+		_, err = sdb.ProveInclusion(t.Context(), mod, 5)
+		require.ErrorIs(t, err, ErrInvalidTreeSize)
+
+		_, err = sdb.ProveInclusion(t.Context(), mod, 301)
+		require.ErrorIs(t, err, ErrInvalidTreeSize)
+
+		_, err = sdb.ProveInclusion(t.Context(), module.Version{Path: "example.com/Mod", Version: "v2.0.0"}, 0)
+		require.ErrorIs(t, err, sumdb.ErrNotFound)
+	})
+
+	t.Run("consistency", func(t *testing.T) {
+		proof, err := sdb.ProveConsistency(t.Context(), 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), proof.From)
+		require.Equal(t, int64(300), proof.To)
+		require.Equal(t, head.Hash, proof.ToHash)
+		require.NoError(t, tlog.CheckTree(proof.Proof, proof.To, proof.ToHash, proof.From, proof.FromHash))
+
+		proof, err = sdb.ProveConsistency(t.Context(), 10, 10)
+		require.NoError(t, err)
+		require.Empty(t, proof.Proof)
+		require.Equal(t, proof.FromHash, proof.ToHash)
+
+		for _, sizes := range [][2]int64{{0, 10}, {11, 10}, {10, 301}} {
+			_, err = sdb.ProveConsistency(t.Context(), sizes[0], sizes[1])
+			require.ErrorIs(t, err, ErrInvalidTreeSize, sizes)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package sumdb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	"github.com/pseudomuto/sumdb"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+// ErrRecordMismatch is returned by Append when the tree already has a record for the module with different hashes.
+var ErrRecordMismatch = errors.New("module recorded with different hashes")
+
+// Recorder implements publisher.Recorder, which adds Go modules to the sumdb trees when they're published.
+type Recorder struct{}
+
+func NewRecorder() *Recorder {
+	return new(Recorder)
+}
+
+func (*Recorder) Record(
+	ctx context.Context,
+	tx *ent.Tx,
+	tree *ent.SumDBTree,
+	mod module.Version,
+	zipHash string,
+	modHash string,
+) error {
+	if _, err := Append(ctx, tx, tree.ID, NewRecord(mod, zipHash, modHash)); err != nil {
+		if errors.Is(err, ErrRecordMismatch) {
+			return fmt.Errorf("%w: %w", publisher.ErrRecorded, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// NewRecord returns the record for mod, given the h1: hashes of its zip and go.mod. The data matches what the sumdb
+// library records when looking up a module.
+func NewRecord(mod module.Version, zipHash, modHash string) *sumdb.Record {
+	return &sumdb.Record{
+		Path:    mod.Path,
+		Version: mod.Version,
+		Data: fmt.Appendf(
+			nil,
+			"%s %s %s\n%s %s/go.mod %s\n",
+			mod.Path, mod.Version, zipHash,
+			mod.Path, mod.Version, modHash,
+		),
+	}
+}
+
+// Append adds r to the tree with the given ID as part of tx, along with its tree hashes. This is the same work done by
+// the sumdb library when a module is first looked up, which allows records to be added ahead of time (e.g. when
+// publishing). The record is linked to the assets of the module's archive, so it must already exist in tx.
+//
+// When the tree already has a record for the module, its ID is returned if the data matches, otherwise
+// ErrRecordMismatch is returned. When a concurrent append (e.g. from another replica) wins the race,
+// data.ErrConflict is returned, and tx must be retried (see data.WithRetry).
+func Append(ctx context.Context, tx *ent.Tx, id int, r *sumdb.Record) (int64, error) {
+	store := &Store{tx: tx, id: id}
+
+	rec, err := store.lookup(ctx, r.Path, r.Version)
+	if err != nil {
+		return 0, err
+	}
+
+	if rec != nil {
+		if !bytes.Equal(rec.Data, r.Data) {
+			return 0, fmt.Errorf("%w: %s@%s", ErrRecordMismatch, r.Path, r.Version)
+		}
+
+		return rec.RecordID, nil
+	}
+
+	recID, err := store.AddRecord(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	hashes, err := tlog.StoredHashes(recID, r.Data, tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		return store.ReadHashes(ctx, indexes)
+	}))
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute hashes: %s@%s, %w", r.Path, r.Version, err)
+	}
+
+	indexes := make([]int64, len(hashes))
+	for i := range hashes {
+		indexes[i] = tlog.StoredHashIndex(i, recID>>i)
+	}
+
+	if err := store.WriteHashes(ctx, indexes, hashes); err != nil {
+		return 0, fmt.Errorf("failed to write hashes: %s@%s, %w", r.Path, r.Version, err)
+	}
+
+	if err := store.SetTreeSize(ctx, recID+1); err != nil {
+		return 0, err
+	}
+
+	return recID, nil
+}
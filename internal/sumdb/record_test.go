@@ -0,0 +1,126 @@
+package sumdb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/pseudomuto/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+func TestAppend(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := t.Context()
+	skey, vkey, err := sumdb.GenerateKeys("test.sumdb.com")
+	require.NoError(t, err)
+
+	tree := client.SumDBTree.Create().
+		SetName("test.sumdb.com").
+		SetSize(0).
+		SetSignerKey(crypto.Secret(skey)).
+		SetVerifierKey(vkey).
+		SaveX(ctx)
+
+	appendRecord := func(r *sumdb.Record) (int64, error) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+
+		id, err := Append(ctx, tx, tree.ID, r)
+		if err != nil {
+			require.NoError(t, tx.Rollback())
+			return 0, err
+		}
+
+		return id, tx.Commit()
+	}
+
+	// The hashes the sumdb library would store for the same records.
+	var expected []tlog.Hash
+	reader := tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		res := make([]tlog.Hash, len(indexes))
+		for i, idx := range indexes {
+			res[i] = expected[idx]
+		}
+
+		return res, nil
+	})
+
+	for i := range 7 {
+		rec := NewRecord(
+			module.Version{Path: "example.com/mod", Version: fmt.Sprintf("v1.0.%d", i)},
+			fmt.Sprintf("h1:zip%d=", i),
+			fmt.Sprintf("h1:mod%d=", i),
+		)
+
+		id, err := appendRecord(rec)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), id)
+
+		hashes, err := tlog.StoredHashes(id, rec.Data, reader)
+		require.NoError(t, err)
+		expected = append(expected, hashes...)
+	}
+
+	store := NewStore(tree.ID, client)
+	size, err := store.TreeSize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), size)
+
+	want, err := tlog.TreeHash(size, reader)
+	require.NoError(t, err)
+
+	got, err := tlog.TreeHash(size, tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		return store.ReadHashes(ctx, indexes)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	t.Run("existing record", func(t *testing.T) {
+		mod := module.Version{Path: "example.com/mod", Version: "v1.0.3"}
+
+		id, err := appendRecord(NewRecord(mod, "h1:zip3=", "h1:mod3="))
+		require.NoError(t, err)
+		require.Equal(t, int64(3), id)
+
+		_, err = appendRecord(NewRecord(mod, "h1:other=", "h1:mod3="))
+		require.ErrorIs(t, err, ErrRecordMismatch)
+
+		size, err := store.TreeSize(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), size)
+	})
+
+	t.Run("Recorder", func(t *testing.T) {
+		var rec publisher.Recorder = NewRecorder()
+		record := func(mod module.Version, zipHash string) error {
+			tx, err := client.Tx(ctx)
+			require.NoError(t, err)
+
+			if err := rec.Record(ctx, tx, tree, mod, zipHash, "h1:mod="); err != nil {
+				require.NoError(t, tx.Rollback())
+				return err
+			}
+
+			return tx.Commit()
+		}
+
+		mod := module.Version{Path: "example.com/other", Version: "v1.0.0"}
+		require.NoError(t, record(mod, "h1:zip="))
+		require.NoError(t, record(mod, "h1:zip="))
+		require.ErrorIs(t, record(mod, "h1:other="), publisher.ErrRecorded)
+
+		id, err := store.RecordID(ctx, mod.Path, mod.Version)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), id)
+	})
+}
@@ -1,39 +1,77 @@
 package sumdb
 
 import (
+	"bytes"
 	"context"
-	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/pseudomuto/pacman/internal/data"
 	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/archive"
+	"github.com/pseudomuto/pacman/internal/ent/asset"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbhash"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
 	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/types"
 	"github.com/pseudomuto/sumdb"
+	"golang.org/x/mod/module"
 	"golang.org/x/mod/sumdb/tlog"
 )
 
 // Store implements sumdb.Store. It is bound to a particular tree, meaning it ensures that all queries are bounded to
 // a specific tree. This allows for multiple tree if desired.
-type Store struct {
-	tx     *ent.Tx
-	client *ent.Client
-	id     int
+type (
+	Store struct {
+		tx     *ent.Tx
+		client *ent.Client
+		id     int
+		cache  *LookupCache
+	}
+
+	// txStore is the Store given to the sumdb library's transactions (see WithTx).
+	txStore struct {
+		*Store
+		// recorded is set when the record being added was already in the tree.
+		recorded bool
+	}
+
+	// StoreOption configures a Store.
+	StoreOption func(*Store)
+)
+
+// WithLookupCache caches the Store's reads in c. A nil cache is ignored.
+//
+// NB: Reads within a transaction (see WithTx) are never cached, since the tree is being updated.
+func WithLookupCache(c *LookupCache) StoreOption {
+	return func(s *Store) {
+		if c != nil {
+			s.cache = c
+		}
+	}
 }
 
 // NewStore creates a new Store bounded to the supplied SumDBTree.
-func NewStore(id int, db *ent.Client) *Store {
-	return &Store{
+func NewStore(id int, db *ent.Client, opts ...StoreOption) *Store {
+	s := &Store{
 		client: db,
 		id:     id,
+		cache:  new(LookupCache),
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
+// WithTx implements sumdb.TxStore. When fn loses a race with a concurrent append (e.g. from another replica), it's
+// retried in a new transaction (see data.WithRetry). Since the winner may have appended the same module, fn is given a
+// txStore, which looks up the record again before adding it.
 func (s *Store) WithTx(ctx context.Context, fn func(sumdb.Store) error) error {
-	_, err := data.WithTx(ctx, s.client, func(tx *ent.Tx) (*ent.SumDBTree, error) {
-		store := &Store{tx: tx, id: s.id}
+	_, err := data.WithRetry(ctx, s.client, func(tx *ent.Tx) (*ent.SumDBTree, error) {
+		store := &txStore{Store: &Store{tx: tx, id: s.id}}
 		if err := fn(store); err != nil {
 			return nil, err
 		}
@@ -44,30 +82,77 @@ func (s *Store) WithTx(ctx context.Context, fn func(sumdb.Store) error) error {
 		return err
 	}
 
+	s.cache.sizes.Delete(s.id)
 	return nil
 }
 
-func (s *Store) RecordID(ctx context.Context, path, version string) (int64, error) {
-	rec, err := s.records().Query().
-		Where(
-			sumdbrecord.HasTreeWith(sumdbtree.ID(s.id)),
-			sumdbrecord.Path(path),
-			sumdbrecord.Version(version),
-		).
-		Only(ctx)
+// AddRecord implements sumdb.Store. When the tree already has the record, its ID is returned and the tree is left as
+// is (i.e. WriteHashes and SetTreeSize do nothing), so the sumdb library's append is a no-op. An ErrRecordMismatch is
+// returned when the existing record has different hashes.
+func (s *txStore) AddRecord(ctx context.Context, r *sumdb.Record) (int64, error) {
+	rec, err := s.lookup(ctx, r.Path, r.Version)
 	if err != nil {
-		var nfe *ent.NotFoundError
-		if errors.As(err, &nfe) {
-			return 0, sumdb.ErrNotFound
+		return 0, err
+	}
+
+	if rec == nil {
+		return s.Store.AddRecord(ctx, r)
+	}
+
+	if !bytes.Equal(rec.Data, r.Data) {
+		return 0, fmt.Errorf("%w: %s@%s", ErrRecordMismatch, r.Path, r.Version)
+	}
+
+	s.recorded = true
+	return rec.RecordID, nil
+}
+
+func (s *txStore) WriteHashes(ctx context.Context, indexes []int64, hashes []tlog.Hash) error {
+	if s.recorded {
+		return nil
+	}
+
+	return s.Store.WriteHashes(ctx, indexes, hashes)
+}
+
+func (s *txStore) SetTreeSize(ctx context.Context, size int64) error {
+	if s.recorded {
+		return nil
+	}
+
+	return s.Store.SetTreeSize(ctx, size)
+}
+
+func (s *Store) RecordID(ctx context.Context, path, version string) (int64, error) {
+	key := recordKey{tree: s.id, path: path, version: version}
+	if s.cached() {
+		if id, ok := s.cache.recordIDs.Get(key); ok {
+			return id, nil
 		}
+	}
 
-		return 0, fmt.Errorf("failed looking up record: %s@%s, %w", path, version, err)
+	rec, err := s.lookup(ctx, path, version)
+	if err != nil {
+		return 0, err
+	}
+
+	if rec == nil {
+		return 0, sumdb.ErrNotFound
+	}
+
+	if s.cached() {
+		s.cache.recordIDs.Set(key, rec.RecordID, 0)
+		s.cache.seen(s.id, rec.RecordID)
 	}
 
 	return rec.RecordID, nil
 }
 
 func (s *Store) Records(ctx context.Context, id, n int64) ([]*sumdb.Record, error) {
+	if res, ok := s.cachedRecords(id, n); ok {
+		return res, nil
+	}
+
 	recs, err := s.records().Query().
 		Where(
 			sumdbrecord.HasTreeWith(sumdbtree.ID(s.id)),
@@ -88,25 +173,59 @@ func (s *Store) Records(ctx context.Context, id, n int64) ([]*sumdb.Record, erro
 			Version: recs[i].Version,
 			Data:    recs[i].Data,
 		}
+
+		if s.cached() {
+			s.cache.records.Set(indexKey{tree: s.id, index: res[i].ID}, res[i], 0)
+		}
 	}
 
 	return res, nil
 }
 
+// cachedRecords returns the records with IDs in [id, id+n) when they're all cached.
+func (s *Store) cachedRecords(id, n int64) ([]*sumdb.Record, bool) {
+	if !s.cached() {
+		return nil, false
+	}
+
+	res := make([]*sumdb.Record, 0, n)
+	for i := id; i < id+n; i++ {
+		rec, ok := s.cache.records.Get(indexKey{tree: s.id, index: i})
+		if !ok {
+			return nil, false
+		}
+
+		res = append(res, rec)
+	}
+
+	return res, true
+}
+
 func (s *Store) AddRecord(ctx context.Context, r *sumdb.Record) (int64, error) {
 	tree, err := s.trees().Get(ctx, s.id)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get tree: %d, %w", s.id, err)
 	}
 
+	assets, err := s.archiveAssets(ctx, r.Path, r.Version)
+	if err != nil {
+		return 0, err
+	}
+
 	rec, err := s.records().Create().
 		SetTreeID(s.id).
 		SetRecordID(tree.Size).
 		SetPath(r.Path).
 		SetVersion(r.Version).
 		SetData(r.Data).
+		AddAssets(assets...).
 		Save(ctx)
 	if err != nil {
+		// NB: A concurrent append added a record with the same ID (or for the same module) first.
+		if ent.IsConstraintError(err) {
+			return 0, fmt.Errorf("failed to create record: %s@%s, %w: %s", r.Path, r.Version, data.ErrConflict, err)
+		}
+
 		return 0, fmt.Errorf("failed to create record: %s@%s, %w", r.Path, r.Version, err)
 	}
 
@@ -114,19 +233,45 @@ func (s *Store) AddRecord(ctx context.Context, r *sumdb.Record) (int64, error) {
 }
 
 func (s *Store) ReadHashes(ctx context.Context, indexes []int64) ([]tlog.Hash, error) {
-	hashes, err := s.hashes().Query().
-		Where(
-			sumdbhash.HasTreeWith(sumdbtree.ID(s.id)),
-			sumdbhash.IndexIn(indexes...),
-		).
-		All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read hashes: %w", err)
+	byIndex := make(map[int64]tlog.Hash, len(indexes))
+	missing := indexes
+	if s.cached() {
+		missing = nil
+		for _, idx := range indexes {
+			if h, ok := s.cache.hashes.Get(indexKey{tree: s.id, index: idx}); ok {
+				byIndex[idx] = h
+				continue
+			}
+
+			missing = append(missing, idx)
+		}
+	}
+
+	if len(missing) > 0 {
+		hashes, err := s.hashes().Query().
+			Where(
+				sumdbhash.HasTreeWith(sumdbtree.ID(s.id)),
+				sumdbhash.IndexIn(missing...),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read hashes: %w", err)
+		}
+
+		for i := range hashes {
+			byIndex[hashes[i].Index] = tlog.Hash(hashes[i].Hash)
+			if s.cached() {
+				s.cache.hashes.Set(indexKey{tree: s.id, index: hashes[i].Index}, tlog.Hash(hashes[i].Hash), 0)
+			}
+		}
 	}
 
-	res := make([]tlog.Hash, len(hashes))
-	for i := range hashes {
-		res[i] = tlog.Hash(hashes[i].Hash)
+	// NB: Hashes are returned in the order they were requested (as tlog expects), skipping any that don't exist.
+	res := make([]tlog.Hash, 0, len(byIndex))
+	for _, idx := range indexes {
+		if h, ok := byIndex[idx]; ok {
+			res = append(res, h)
+		}
 	}
 
 	return res, nil
@@ -153,25 +298,115 @@ func (s *Store) WriteHashes(ctx context.Context, indexes []int64, hashes []tlog.
 }
 
 func (s *Store) TreeSize(ctx context.Context) (int64, error) {
+	if s.cached() {
+		if size, ok := s.cache.sizes.Get(s.id); ok {
+			return size, nil
+		}
+	}
+
 	tree, err := s.trees().Get(ctx, s.id)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get tree: %d, %w", s.id, err)
 	}
 
+	if s.cached() {
+		s.cache.sizes.Set(s.id, tree.Size, s.cache.ttl)
+	}
+
 	return tree.Size, nil
 }
 
+// SetTreeSize implements sumdb.Store. Records are appended one at a time, so the size is only updated when the tree
+// still has the record before it as its last. Otherwise, a concurrent append has grown the tree, and
+// data.ErrConflict is returned.
 func (s *Store) SetTreeSize(ctx context.Context, size int64) error {
-	if err := s.trees().
-		UpdateOneID(s.id).
+	n, err := s.trees().
+		Update().
+		Where(sumdbtree.ID(s.id), sumdbtree.Size(size-1)).
 		SetSize(size).
-		Exec(ctx); err != nil {
+		Save(ctx)
+	if err != nil {
 		return fmt.Errorf("failed to update tree size: %w", err)
 	}
 
+	if n == 0 {
+		return fmt.Errorf("failed to update tree size: %d, %w", size, data.ErrConflict)
+	}
+
 	return nil
 }
 
+// cached reports whether reads can be cached, which is only the case outside of transactions.
+func (s *Store) cached() bool {
+	return s.tx == nil && s.cache != nil
+}
+
+// lookup returns the record for path@version in this tree, or nil when there isn't one.
+func (s *Store) lookup(ctx context.Context, path, version string) (*ent.SumDBRecord, error) {
+	rec, err := s.records().Query().
+		Where(
+			sumdbrecord.HasTreeWith(sumdbtree.ID(s.id)),
+			sumdbrecord.Path(path),
+			sumdbrecord.Version(version),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed looking up record: %s@%s, %w", path, version, err)
+	}
+
+	return rec, nil
+}
+
+// archiveAssets returns the Assets for the published archive of path@version (if any). These are linked to new records
+// so the goproxy server for this tree can serve the module.
+func (s *Store) archiveAssets(ctx context.Context, path, version string) ([]*ent.Asset, error) {
+	arch, err := s.archives().Query().
+		Where(
+			archive.TypeEQ(types.GoModule),
+			archive.Coordinate(module.Version{Path: path, Version: version}.String()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed looking up archive: %s@%s, %w", path, version, err)
+	}
+
+	uris := make([]string, len(arch.Assets))
+	for i := range arch.Assets {
+		uris[i] = arch.Assets[i].URL
+	}
+
+	assets, err := s.assets().Query().Where(asset.URIIn(uris...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed looking up assets: %s@%s, %w", path, version, err)
+	}
+
+	return assets, nil
+}
+
+func (s *Store) archives() *ent.ArchiveClient {
+	if s.tx != nil {
+		return s.tx.Archive
+	}
+
+	return s.client.Archive
+}
+
+func (s *Store) assets() *ent.AssetClient {
+	if s.tx != nil {
+		return s.tx.Asset
+	}
+
+	return s.client.Asset
+}
+
 func (s *Store) records() *ent.SumDBRecordClient {
 	if s.tx != nil {
 		return s.tx.SumDBRecord
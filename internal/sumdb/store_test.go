@@ -1,14 +1,29 @@
 package sumdb_test
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
 	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/schema"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbrecord"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
 	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/pseudomuto/pacman/internal/types"
 	"github.com/pseudomuto/sumdb"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
 )
 
 func TestStore(t *testing.T) {
@@ -53,6 +68,20 @@ func TestStore(t *testing.T) {
 	})
 
 	t.Run("AddRecord", func(t *testing.T) {
+		assets := client.Asset.CreateBulk(
+			client.Asset.Create().SetType(types.TextFile).SetURI("gs://bucket/go.mod"),
+			client.Asset.Create().SetType(types.Archive).SetURI("gs://bucket/go.zip"),
+		).SaveX(ctx)
+
+		client.Archive.Create().
+			SetType(types.GoModule).
+			SetCoordinate("github.com/pseudomuto/protoc-gen-doc@v1.5.1").
+			SetAssets([]schema.AssetURL{
+				{Type: types.TextFile, URL: assets[0].URI},
+				{Type: types.Archive, URL: assets[1].URI},
+			}).
+			SaveX(ctx)
+
 		require.NoError(t, store2.WithTx(ctx, func(s sumdb.Store) error {
 			id, err := s.AddRecord(ctx, &sumdb.Record{
 				Path:    "github.com/pseudomuto/protoc-gen-doc",
@@ -78,6 +107,16 @@ func TestStore(t *testing.T) {
 
 			return nil
 		}))
+
+		n, err := client.SumDBRecord.Query().
+			Where(
+				sumdbrecord.HasTreeWith(sumdbtree.ID(tree2.ID)),
+				sumdbrecord.Path("github.com/pseudomuto/protoc-gen-doc"),
+			).
+			QueryAssets().
+			Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
 	})
 
 	t.Run("ReadHashes", func(t *testing.T) {
@@ -90,3 +129,299 @@ func TestStore(t *testing.T) {
 		require.Len(t, hashes, 1)
 	})
 }
+
+func TestStore_Cache(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := t.Context()
+	tree := client.SumDBTree.Create().
+		SetName("test.sumdb.com").
+		SetSize(0).
+		SetSignerKey(crypto.Secret("shh")).
+		SetVerifierKey("good").
+		SaveX(ctx)
+
+	add := func(version string) int64 {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+
+		id, err := Append(ctx, tx, tree.ID, NewRecord(
+			module.Version{Path: "example.com/mod", Version: version},
+			"h1:zip=",
+			"h1:mod=",
+		))
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		return id
+	}
+
+	add("v1.0.0")
+	add("v1.0.1")
+
+	lc := NewLookupCache(&config.Config{}, cache.NewMetrics(prometheus.NewRegistry()))
+	store := NewStore(tree.ID, client, WithLookupCache(lc))
+
+	size, err := store.TreeSize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), size)
+
+	recs, err := store.Records(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+
+	hashes, err := store.ReadHashes(ctx, []int64{0, 1, 2})
+	require.NoError(t, err)
+	require.Len(t, hashes, 3)
+
+	t.Run("immutable entries", func(t *testing.T) {
+		// NB: The records and hashes are served from the cache.
+		client.SumDBRecord.Update().SetData([]byte("changed")).ExecX(ctx)
+		client.SumDBHash.Update().SetHash(make([]byte, 32)).ExecX(ctx)
+
+		cached, err := store.Records(ctx, 0, 2)
+		require.NoError(t, err)
+		require.Equal(t, recs, cached)
+
+		cachedHashes, err := store.ReadHashes(ctx, []int64{2, 0, 1})
+		require.NoError(t, err)
+		require.Equal(t, []tlog.Hash{hashes[2], hashes[0], hashes[1]}, cachedHashes)
+	})
+
+	t.Run("tree size", func(t *testing.T) {
+		// Records added elsewhere (e.g. another replica) aren't seen until they're looked up.
+		id := add("v1.0.2")
+
+		size, err := store.TreeSize(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), size)
+
+		found, err := store.RecordID(ctx, "example.com/mod", "v1.0.2")
+		require.NoError(t, err)
+		require.Equal(t, id, found)
+
+		size, err = store.TreeSize(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), size)
+
+		// Updates made through the store are seen right away.
+		require.NoError(t, store.WithTx(ctx, func(st sumdb.Store) error {
+			return st.SetTreeSize(ctx, 4)
+		}))
+
+		size, err = store.TreeSize(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), size)
+
+		// As are those made by publishing.
+		client.SumDBTree.UpdateOneID(tree.ID).SetSize(3).ExecX(ctx)
+		lc.Invalidate("example.com/mod")
+
+		size, err = store.TreeSize(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), size)
+	})
+}
+
+func TestStore_SetTreeSize(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := t.Context()
+	tree := client.SumDBTree.Create().
+		SetName("test.sumdb.com").
+		SetSize(2).
+		SetSignerKey(crypto.Secret("shh")).
+		SetVerifierKey("good").
+		SaveX(ctx)
+
+	store := NewStore(tree.ID, client)
+
+	// Another append already grew the tree past 2.
+	err := store.WithTx(ctx, func(st sumdb.Store) error { return st.SetTreeSize(ctx, 2) })
+	require.ErrorIs(t, err, data.ErrConflict)
+
+	require.NoError(t, store.WithTx(ctx, func(st sumdb.Store) error { return st.SetTreeSize(ctx, 3) }))
+	require.Equal(t, int64(3), client.SumDBTree.GetX(ctx, tree.ID).Size)
+}
+
+func TestStore_ConcurrentAppends(t *testing.T) {
+	t.Parallel()
+
+	const (
+		replicas = 4
+		workers  = 4
+		appends  = 10
+	)
+
+	ctx := t.Context()
+	dsn := "file:" + filepath.Join(t.TempDir(), "pacman.db") + "?_fk=1&_journal_mode=WAL&_busy_timeout=5000"
+
+	// Each replica has its own connection pool, and so its own SumDB (and lock) for the tree.
+	clients := make([]*ent.Client, replicas)
+	for i := range clients {
+		clients[i] = enttest.Open(t, "sqlite3", dsn)
+		t.Cleanup(func() { _ = clients[i].Close() })
+	}
+
+	tree := seedTree(t, clients[0], 0)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, replicas*workers*appends)
+	for r, client := range clients {
+		store := NewStore(tree.ID, client)
+		for w := range workers {
+			wg.Go(func() {
+				for i := range appends {
+					rec := NewRecord(
+						module.Version{Path: fmt.Sprintf("example.com/r%d/w%d", r, w), Version: fmt.Sprintf("v1.0.%d", i)},
+						"h1:zip=",
+						"h1:mod=",
+					)
+
+					// Alternate between appending when publishing and when looking up modules in the sumdb library.
+					if i%2 == 0 {
+						_, err := data.WithRetry(ctx, client, func(tx *ent.Tx) (*int64, error) {
+							id, err := Append(ctx, tx, tree.ID, rec)
+							return &id, err
+						})
+						errs <- err
+						continue
+					}
+
+					_, err := libraryAppend(ctx, store, rec)
+					errs <- err
+				}
+			})
+		}
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// The tree contains every record, with contiguous IDs.
+	const n = replicas * workers * appends
+	require.Equal(t, int64(n), clients[0].SumDBTree.GetX(ctx, tree.ID).Size)
+
+	recs, err := NewStore(tree.ID, clients[0]).Records(ctx, 0, n+1)
+	require.NoError(t, err)
+	require.Len(t, recs, n)
+
+	// The stored hashes match those of a log built from the records, in order.
+	var expected []tlog.Hash
+	reader := tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		res := make([]tlog.Hash, len(indexes))
+		for i, idx := range indexes {
+			res[i] = expected[idx]
+		}
+
+		return res, nil
+	})
+
+	for i, rec := range recs {
+		require.Equal(t, int64(i), rec.ID)
+
+		hashes, err := tlog.StoredHashes(rec.ID, rec.Data, reader)
+		require.NoError(t, err)
+		expected = append(expected, hashes...)
+	}
+
+	want, err := tlog.TreeHash(n, reader)
+	require.NoError(t, err)
+
+	stored := NewStore(tree.ID, clients[0])
+	got, err := tlog.TreeHash(n, tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		return stored.ReadHashes(ctx, indexes)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	require.Equal(t, len(expected), clients[0].SumDBHash.Query().CountX(ctx))
+}
+
+func TestStore_ConcurrentLookups(t *testing.T) {
+	t.Parallel()
+
+	const replicas = 4
+
+	ctx := t.Context()
+	dsn := "file:" + filepath.Join(t.TempDir(), "pacman.db") + "?_fk=1&_journal_mode=WAL&_busy_timeout=5000"
+
+	clients := make([]*ent.Client, replicas)
+	for i := range clients {
+		clients[i] = enttest.Open(t, "sqlite3", dsn)
+		t.Cleanup(func() { _ = clients[i].Close() })
+	}
+
+	tree := seedTree(t, clients[0], 0)
+	rec := NewRecord(module.Version{Path: "example.com/mod", Version: "v1.0.0"}, "h1:zip=", "h1:mod=")
+
+	// Every replica looks up the same module, so all but one lose the race and retry.
+	var wg sync.WaitGroup
+	ids := make(chan int64, replicas)
+	errs := make(chan error, replicas)
+	for _, client := range clients {
+		wg.Go(func() {
+			id, err := libraryAppend(ctx, NewStore(tree.ID, client), rec)
+			ids <- id
+			errs <- err
+		})
+	}
+
+	wg.Wait()
+	close(ids)
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	for id := range ids {
+		require.Zero(t, id)
+	}
+
+	require.Equal(t, int64(1), clients[0].SumDBTree.GetX(ctx, tree.ID).Size)
+
+	// A different record for the same module is never appended.
+	other := NewRecord(module.Version{Path: "example.com/mod", Version: "v1.0.0"}, "h1:other=", "h1:mod=")
+	_, err := libraryAppend(ctx, NewStore(tree.ID, clients[0]), other)
+	require.ErrorIs(t, err, ErrRecordMismatch)
+	require.Equal(t, int64(1), clients[0].SumDBTree.GetX(ctx, tree.ID).Size)
+}
+
+// libraryAppend appends rec to the tree in store like the sumdb library does when looking up a module, returning its
+// ID.
+func libraryAppend(ctx context.Context, store *Store, rec *sumdb.Record) (int64, error) {
+	var id int64
+	err := store.WithTx(ctx, func(st sumdb.Store) error {
+		var err error
+		if id, err = st.AddRecord(ctx, rec); err != nil {
+			return err
+		}
+
+		hashes, err := tlog.StoredHashes(id, rec.Data, tlog.HashReaderFunc(
+			func(indexes []int64) ([]tlog.Hash, error) { return st.ReadHashes(ctx, indexes) },
+		))
+		if err != nil {
+			return err
+		}
+
+		indexes := make([]int64, len(hashes))
+		for i := range hashes {
+			indexes[i] = tlog.StoredHashIndex(i, id>>i)
+		}
+
+		if err := st.WriteHashes(ctx, indexes, hashes); err != nil {
+			return err
+		}
+
+		return st.SetTreeSize(ctx, id+1)
+	})
+
+	return id, err
+}
@@ -1,84 +1,243 @@
 package sumdb
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/cache"
 	"github.com/pseudomuto/pacman/internal/ent"
-	"github.com/pseudomuto/pacman/internal/types"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/goproxy"
 	"github.com/pseudomuto/sumdb"
-	"go.uber.org/fx"
+	"golang.org/x/mod/module"
 	ogdb "golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
 )
 
 type (
 	SumDB struct {
-		name  string
-		sumdb *sumdb.SumDB
+		id        int
+		name      string
+		db        *ent.Client
+		sumdb     *sumdb.SumDB
+		handler   http.Handler
+		store     *Store
+		tiles     TileStore
+		signers   *cache.Cache[int, []note.Signer]
+		heads     *cache.Cache[int, *ent.SumDBTreeHead]
+		witnesses []note.Verifier
+		frozen    atomic.Bool
 	}
 
+	// SumDBPool serves every tree which hasn't been retired (see boot.Trees) at /sumdb/<tree>. SumDBs are created when
+	// their tree is first requested, so trees created at runtime are served without registering routes, while retired
+	// trees are no longer found.
 	SumDBPool struct {
-		fx.Out
+		db    *ent.Client
+		up    *goproxy.UpstreamProxy
+		trees *boot.Trees
+		lc    *LookupCache
+		ts    TileStore
+		w     *Witnesses
 
-		Routers []types.Router `group:"server_routers,flatten"`
-		SumDBs  []*SumDB
+		mu   sync.Mutex
+		sdbs map[int]*SumDB
 	}
 )
 
-func NewSumDBPool(db *ent.Client, trees []*ent.SumDBTree) (SumDBPool, error) {
-	var pool SumDBPool
-	pool.Routers = make([]types.Router, len(trees))
-	pool.SumDBs = make([]*SumDB, len(trees))
-	for i := range trees {
-		sdb, err := NewSumDB(trees[i], db)
+// NewSumDBPool creates the SumDBPool for trees. Unknown modules are resolved through up in-process, which ensures
+// published archives are found before falling back to the upstream proxy. Reads are cached in lc, and complete tiles
+// are served from ts, and cosignatures are accepted from w.
+func NewSumDBPool(
+	db *ent.Client,
+	up *goproxy.UpstreamProxy,
+	trees *boot.Trees,
+	lc *LookupCache,
+	ts TileStore,
+	w *Witnesses,
+) *SumDBPool {
+	return &SumDBPool{
+		db:    db,
+		up:    up,
+		trees: trees,
+		lc:    lc,
+		ts:    ts,
+		w:     w,
+		sdbs:  make(map[int]*SumDB),
+	}
+}
+
+// SumDB returns the SumDB for the named tree. An ErrUnknownTree is returned when the tree isn't served.
+func (p *SumDBPool) SumDB(ctx context.Context, name string) (*SumDB, error) {
+	tree, ok, err := p.trees.Find(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTree, name)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sdb, ok := p.sdbs[tree.ID]
+	if !ok {
+		st := NewStore(tree.ID, p.db, WithLookupCache(p.lc))
+		sdb, err = newSumDB(tree, p.db, st, sumdb.WithHTTPClient(p.up.HTTPClient()))
 		if err != nil {
-			return pool, fmt.Errorf("failed to create SumDB: %s, %w", trees[i].Name, err)
+			return nil, fmt.Errorf("failed to create SumDB: %s, %w", tree.Name, err)
 		}
 
-		pool.Routers[i] = sdb
-		pool.SumDBs[i] = sdb
+		sdb.WithTiles(p.ts).WithWitnesses(p.w.For(tree.Name)...)
+		p.sdbs[tree.ID] = sdb
 	}
 
-	return pool, nil
+	sdb.frozen.Store(tree.Status == sumdbtree.StatusFrozen)
+	return sdb, nil
+}
+
+// Reload ensures trees which have been created, frozen, or retired are picked up when they're next requested.
+func (p *SumDBPool) Reload() {
+	p.trees.Reload()
 }
 
+func (p *SumDBPool) RegisterRoutes(g *gin.Engine) {
+	g.GET("/sumdb/:tree/*path", func(ctx *gin.Context) {
+		sdb, err := p.SumDB(ctx, ctx.Param("tree"))
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, ErrUnknownTree) {
+				status = http.StatusNotFound
+			}
+
+			http.Error(ctx.Writer, err.Error(), status)
+			return
+		}
+
+		sdb.ServeHTTP(ctx.Writer, ctx.Request)
+	})
+}
+
+// NewSumDB creates a SumDB for the tree t, backed by an (uncached) Store for the tree.
 func NewSumDB(t *ent.SumDBTree, db *ent.Client, opts ...sumdb.Option) (*SumDB, error) {
+	return newSumDB(t, db, NewStore(t.ID, db), opts...)
+}
+
+func newSumDB(t *ent.SumDBTree, db *ent.Client, st *Store, opts ...sumdb.Option) (*SumDB, error) {
 	sdb, err := sumdb.New(
 		t.Name,
 		string(t.SignerKey),
-		append(opts, sumdb.WithStore(NewStore(t.ID, db)))...,
+		append(slices.Clip(opts), sumdb.WithStore(st))...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize sumdb: %s, %w", t.Name, err)
 	}
 
-	return &SumDB{
-		name:  t.Name,
-		sumdb: sdb,
-	}, nil
+	s := &SumDB{
+		id:      t.ID,
+		name:    t.Name,
+		db:      db,
+		sumdb:   sdb,
+		store:   st,
+		signers: cache.New[int, []note.Signer]("sumdb_signers", 1),
+		heads:   cache.New[int, *ent.SumDBTreeHead]("sumdb_heads", 1),
+	}
+
+	s.handler = ogdb.NewServer(s)
+	s.frozen.Store(t.Status == sumdbtree.StatusFrozen)
+	return s, nil
 }
 
-func (s *SumDB) RegisterRoutes(g *gin.Engine) {
-	h := s.sumdb.Handler()
-	gh := func(ctx *gin.Context) {
-		// NB: The underlying handler checks URL paths for prefixes.
-		// Rewrite the paths accordingly by stripping /sumdb/<name>.
-		ctx.Request.URL.Path = strings.TrimPrefix(
-			ctx.Request.URL.Path,
-			"/sumdb/"+s.name,
-		)
-
-		h.ServeHTTP(ctx.Writer, ctx.Request)
+// WithTiles serves complete tiles from ts, materializing them on first use.
+func (s *SumDB) WithTiles(ts TileStore) *SumDB {
+	s.tiles = ts
+	return s
+}
+
+// Name returns the name of the tree.
+func (s *SumDB) Name() string {
+	return s.name
+}
+
+// ReadRecords implements sumdb.ServerOps.
+func (s *SumDB) ReadRecords(ctx context.Context, id, n int64) ([][]byte, error) {
+	return s.sumdb.ReadRecords(ctx, id, n)
+}
+
+// Lookup implements sumdb.ServerOps. Unknown modules aren't fetched when the tree is frozen.
+func (s *SumDB) Lookup(ctx context.Context, m module.Version) (int64, error) {
+	if !s.frozen.Load() {
+		return s.sumdb.Lookup(ctx, m)
 	}
 
-	group := g.Group("/sumdb/" + s.name)
-	for _, path := range ogdb.ServerPaths {
-		if path == "/latest" {
-			group.GET(path, gh)
-			continue
+	id, err := s.store.RecordID(ctx, m.Path, m.Version)
+	if errors.Is(err, sumdb.ErrNotFound) {
+		// NB: The sumdb handler only responds with a 404 for fs.ErrNotExist.
+		return 0, &fs.PathError{Op: "lookup", Path: m.String(), Err: fs.ErrNotExist}
+	}
+
+	return id, err
+}
+
+// ReadTileData implements sumdb.ServerOps.
+func (s *SumDB) ReadTileData(ctx context.Context, t tlog.Tile) ([]byte, error) {
+	return s.sumdb.ReadTileData(ctx, t)
+}
+
+// ServeHTTP serves the sumdb endpoints (see ogdb.ServerPaths) at /sumdb/<name>.
+func (s *SumDB) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// NB: The underlying handler checks URL paths for prefixes.
+	// Rewrite the paths accordingly by stripping /sumdb/<name>.
+	req.URL.Path = strings.TrimPrefix(req.URL.Path, "/sumdb/"+s.name)
+	if strings.HasPrefix(req.URL.Path, "/tile/") && s.serveTile(w, req) {
+		return
+	}
+
+	s.handler.ServeHTTP(w, req)
+}
+
+// serveTile serves complete tiles from the TileStore, materializing them when they haven't been stored yet. Partial
+// tiles change as the tree grows, so they're always left to the sumdb handler (as are all tiles when there's no
+// TileStore). It returns false when the tile wasn't served.
+func (s *SumDB) serveTile(w http.ResponseWriter, req *http.Request) bool {
+	ctx := req.Context()
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	t, err := tlog.ParseTilePath(path)
+	if s.tiles == nil || err != nil || t.W != 1<<t.H {
+		return false
+	}
+
+	data, ok := s.tiles.ReadTile(ctx, s.name, t)
+	if !ok {
+		if data, err = s.readTile(ctx, t); err != nil {
+			// NB: Tiles beyond the end of the tree can't be read. Let the sumdb handler respond accordingly.
+			return false
 		}
 
-		group.GET(path+"/*data", gh)
+		if err := s.tiles.WriteTile(ctx, s.name, t, data); err != nil {
+			slog.WarnContext(ctx, "Failed to store tile", "tree", s.name, "tile", path, "error", err)
+		}
 	}
+
+	contentType := "application/octet-stream"
+	if t.L == -1 {
+		contentType = "text/plain; charset=UTF-8"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+	return true
 }
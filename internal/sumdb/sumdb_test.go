@@ -6,7 +6,6 @@ import (
 	"path/filepath"
 	"testing"
 
-	"github.com/gin-gonic/gin"
 	"github.com/pseudomuto/pacman/internal/crypto"
 	"github.com/pseudomuto/pacman/internal/ent/enttest"
 	. "github.com/pseudomuto/pacman/internal/sumdb"
@@ -38,9 +37,6 @@ func TestSumDB(t *testing.T) {
 	db, err := NewSumDB(tree, client, sumdb.WithHTTPClient(r.GetDefaultClient()))
 	require.NoError(t, err)
 
-	svr := gin.Default()
-	db.RegisterRoutes(svr)
-
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequestWithContext(
 		t.Context(),
@@ -48,7 +44,7 @@ func TestSumDB(t *testing.T) {
 		"/sumdb/test.sumdb.com/lookup/github.com/pseudomuto/where@v0.1.0",
 		nil,
 	)
-	svr.ServeHTTP(w, req)
+	db.ServeHTTP(w, req)
 	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
 
 	w = httptest.NewRecorder()
@@ -58,6 +54,6 @@ func TestSumDB(t *testing.T) {
 		"/sumdb/test.sumdb.com/lookup/github.com/pseudomuto/protoc-gen-doc@v1.5.1",
 		nil,
 	)
-	svr.ServeHTTP(w, req)
+	db.ServeHTTP(w, req)
 	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
 }
@@ -0,0 +1,229 @@
+package sumdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/storage"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+// tileHeight is the tile height used by the go command, and for exports.
+const tileHeight = 8
+
+type (
+	// TileStore stores complete tiles. A tile is complete once the tree has grown past it, after which it never changes.
+	TileStore interface {
+		// ReadTile returns the tile t of tree, or false when it hasn't been stored.
+		ReadTile(ctx context.Context, tree string, t tlog.Tile) ([]byte, bool)
+		WriteTile(ctx context.Context, tree string, t tlog.Tile, data []byte) error
+	}
+
+	// MemoryTiles is a TileStore which keeps tiles in an in-process cache.
+	MemoryTiles struct {
+		tiles *cache.Cache[string, []byte]
+	}
+
+	// BucketTiles is a TileStore which keeps tiles in a storage bucket. Tiles are written to sumdb/<tree>/<tile path>,
+	// which is the same layout used by Export.
+	BucketTiles struct {
+		root string
+	}
+)
+
+// NewTileStore creates the TileStore for the configured TileBucket, or a MemoryTiles (using the MemoryCache size) when
+// there isn't one.
+func NewTileStore(c *config.Config, m *cache.Metrics) TileStore {
+	if c.Go.TileBucket != "" {
+		return NewBucketTiles(c.Go.TileBucket)
+	}
+
+	return NewMemoryTiles(c.Go.MemoryCache.Size, m)
+}
+
+// NewMemoryTiles creates a MemoryTiles holding at most size tiles (see cache.New).
+func NewMemoryTiles(size int, m *cache.Metrics) *MemoryTiles {
+	return &MemoryTiles{tiles: cache.New[string, []byte]("sumdb_tiles", size, cache.WithMetrics(m))}
+}
+
+func (m *MemoryTiles) ReadTile(_ context.Context, tree string, t tlog.Tile) ([]byte, bool) {
+	return m.tiles.Get(tree + "/" + t.Path())
+}
+
+func (m *MemoryTiles) WriteTile(_ context.Context, tree string, t tlog.Tile, data []byte) error {
+	m.tiles.Set(tree+"/"+t.Path(), data, 0)
+	return nil
+}
+
+// NewBucketTiles creates a BucketTiles for the bucket at uri. The bucket must be registered (see StorageBuckets).
+func NewBucketTiles(uri string) *BucketTiles {
+	return &BucketTiles{root: bucketRoot(uri)}
+}
+
+func (b *BucketTiles) ReadTile(ctx context.Context, tree string, t tlog.Tile) ([]byte, bool) {
+	var buf bytes.Buffer
+	if err := storage.Read(ctx, &buf, b.root+"/"+staticPath(tree, t.Path())); err != nil {
+		// NB: Missing (or unreadable) tiles are materialized again.
+		return nil, false
+	}
+
+	return buf.Bytes(), true
+}
+
+func (b *BucketTiles) WriteTile(ctx context.Context, tree string, t tlog.Tile, data []byte) error {
+	uri := b.root + "/" + staticPath(tree, t.Path())
+	if err := storage.Write(ctx, bytes.NewReader(data), uri); err != nil {
+		return fmt.Errorf("failed to write tile: %s, %w", uri, err)
+	}
+
+	return nil
+}
+
+// Export writes the tree's tiles, records and signed tree head to the bucket at uri, beneath sumdb/<tree>. The files
+// mirror the sumdb HTTP API (latest, lookup/<module>@<version> and tile/...), so the checksum database can be served as
+// static files (e.g. by a CDN). The bucket must be registered (see StorageBuckets).
+//
+// The signed tree head is written last, so clients never see a tree whose tiles and records haven't been exported.
+// The tree size is returned.
+func (s *SumDB) Export(ctx context.Context, uri string) (int64, error) {
+	root := bucketRoot(uri)
+	write := func(path string, data []byte) error {
+		dest := root + "/" + staticPath(s.name, path)
+		if err := storage.Write(ctx, bytes.NewReader(data), dest); err != nil {
+			return fmt.Errorf("failed to export: %s, %w", dest, err)
+		}
+
+		return nil
+	}
+
+	signed, err := s.Signed(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sign tree head: %s, %w", s.name, err)
+	}
+
+	text, _, _ := bytes.Cut(signed, []byte("\n\n"))
+	tree, err := tlog.ParseTree(append(text, '\n'))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse tree head: %s, %w", s.name, err)
+	}
+
+	for _, t := range tlog.NewTiles(tileHeight, 0, tree.N) {
+		tiles := []tlog.Tile{t}
+		if t.L == 0 {
+			// NB: Data tiles hold the records for the corresponding level 0 hash tile.
+			tiles = append(tiles, tlog.Tile{H: t.H, L: -1, N: t.N, W: t.W})
+		}
+
+		for _, tile := range tiles {
+			data, err := s.readTile(ctx, tile)
+			if err != nil {
+				return 0, err
+			}
+
+			if err := write(tile.Path(), data); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for id := int64(0); id < tree.N; id += 1 << tileHeight {
+		recs, err := s.sumdb.ReadRecords(ctx, id, min(1<<tileHeight, tree.N-id))
+		if err != nil {
+			return 0, fmt.Errorf("failed to read records: %s, %w", s.name, err)
+		}
+
+		for i, rec := range recs {
+			path, err := lookupPath(rec)
+			if err != nil {
+				return 0, err
+			}
+
+			msg, err := tlog.FormatRecord(id+int64(i), rec)
+			if err != nil {
+				return 0, fmt.Errorf("failed to format record: %s, %w", path, err)
+			}
+
+			if err := write(path, append(msg, signed...)); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := write("latest", signed); err != nil {
+		return 0, err
+	}
+
+	return tree.N, nil
+}
+
+// readTile returns the contents of the tile t, as served by the sumdb HTTP API.
+func (s *SumDB) readTile(ctx context.Context, t tlog.Tile) ([]byte, error) {
+	if t.L >= 0 {
+		data, err := s.sumdb.ReadTileData(ctx, t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tile: %s, %w", t.Path(), err)
+		}
+
+		return data, nil
+	}
+
+	// NB: Data tiles are the records (without their IDs) which are hashed into the corresponding level 0 tile.
+	start := t.N << t.H
+	recs, err := s.sumdb.ReadRecords(ctx, start, int64(t.W))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read records for tile: %s, %w", t.Path(), err)
+	}
+
+	if len(recs) != t.W {
+		return nil, fmt.Errorf("failed to read records for tile: %s, got %d records", t.Path(), len(recs))
+	}
+
+	var data []byte
+	for i, rec := range recs {
+		msg, err := tlog.FormatRecord(start+int64(i), rec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format record for tile: %s, %w", t.Path(), err)
+		}
+
+		_, msg, _ = bytes.Cut(msg, []byte{'\n'})
+		data = append(data, msg...)
+	}
+
+	return data, nil
+}
+
+// lookupPath returns the path of the lookup endpoint for the record with the given data.
+func lookupPath(data []byte) (string, error) {
+	fields := strings.Fields(string(data))
+	if len(fields) < 2 {
+		return "", fmt.Errorf("invalid record: %q", data)
+	}
+
+	path, err := module.EscapePath(fields[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid record path: %s, %w", fields[0], err)
+	}
+
+	version, err := module.EscapeVersion(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid record version: %s, %w", fields[1], err)
+	}
+
+	return "lookup/" + path + "@" + version, nil
+}
+
+// staticPath returns the path of a sumdb endpoint for tree, relative to the root of a bucket.
+func staticPath(tree, path string) string {
+	return "sumdb/" + tree + "/" + path
+}
+
+// bucketRoot returns the root of the bucket at uri, without any query string params (which are only for the opener).
+func bucketRoot(uri string) string {
+	root, _, _ := strings.Cut(uri, "?")
+	return strings.TrimSuffix(root, "/")
+}
@@ -0,0 +1,189 @@
+package sumdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/storage"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/pseudomuto/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+func TestSumDB_Tiles(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedTree(t, client, 300)
+
+	ref, err := NewSumDB(tree, client)
+	require.NoError(t, err)
+
+	sdb, err := NewSumDB(tree, client)
+	require.NoError(t, err)
+
+	tiles := NewMemoryTiles(0, nil)
+	sdb.WithTiles(tiles)
+
+	get := func(h http.Handler, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), "GET", "/sumdb/test.sumdb.com/"+path, nil)
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	paths := []string{"tile/8/0/000", "tile/8/data/000", "tile/8/0/001.p/44", "tile/8/1/000.p/1"}
+	for _, path := range paths {
+		want := get(ref, path)
+		require.Equal(t, http.StatusOK, want.Code, want.Body.String())
+
+		got := get(sdb, path)
+		require.Equal(t, http.StatusOK, got.Code, got.Body.String())
+		require.Equal(t, want.Header().Get("Content-Type"), got.Header().Get("Content-Type"), path)
+		require.Equal(t, want.Body.Bytes(), got.Body.Bytes(), path)
+	}
+
+	// Only complete tiles are stored.
+	_, ok := tiles.ReadTile(t.Context(), "test.sumdb.com", tlog.Tile{H: 8, L: 0, N: 0, W: 256})
+	require.True(t, ok)
+	_, ok = tiles.ReadTile(t.Context(), "test.sumdb.com", tlog.Tile{H: 8, L: -1, N: 0, W: 256})
+	require.True(t, ok)
+	_, ok = tiles.ReadTile(t.Context(), "test.sumdb.com", tlog.Tile{H: 8, L: 0, N: 1, W: 44})
+	require.False(t, ok)
+
+	// Stored tiles are served without reading hashes.
+	want := get(sdb, "tile/8/0/000").Body.Bytes()
+	client.SumDBHash.Delete().ExecX(t.Context())
+
+	got := get(sdb, "tile/8/0/000")
+	require.Equal(t, http.StatusOK, got.Code)
+	require.Equal(t, want, got.Body.Bytes())
+
+	// Tiles beyond the end of the tree are handled by the sumdb handler.
+	require.Equal(t, get(ref, "tile/8/0/002").Code, get(sdb, "tile/8/0/002").Code)
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	bucket := "file://" + dir
+	require.NoError(t, storage.RegisterBuckets(t.Context(), bucket+"?create_dir=1&no_tmp_dir=1&metadata=skip"))
+
+	t.Run("BucketTiles", func(t *testing.T) {
+		tiles := NewBucketTiles(bucket + "?create_dir=1")
+		tile := tlog.Tile{H: 8, L: 1, N: 2, W: 256}
+
+		_, ok := tiles.ReadTile(t.Context(), "tiles.sumdb.com", tile)
+		require.False(t, ok)
+
+		require.NoError(t, tiles.WriteTile(t.Context(), "tiles.sumdb.com", tile, []byte("tile")))
+
+		data, ok := tiles.ReadTile(t.Context(), "tiles.sumdb.com", tile)
+		require.True(t, ok)
+		require.Equal(t, []byte("tile"), data)
+
+		_, err := os.Stat(filepath.Join(dir, "sumdb", "tiles.sumdb.com", "tile", "8", "1", "002"))
+		require.NoError(t, err)
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		t.Cleanup(func() { _ = client.Close() })
+
+		tree := seedTree(t, client, 300)
+		sdb, err := NewSumDB(tree, client)
+		require.NoError(t, err)
+
+		size, err := sdb.Export(t.Context(), bucket)
+		require.NoError(t, err)
+		require.Equal(t, int64(300), size)
+
+		// Every exported file matches the corresponding sumdb endpoint.
+		root := filepath.Join(dir, "sumdb", "test.sumdb.com")
+		var files int
+		require.NoError(t, filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			files++
+			rel, err := filepath.Rel(root, path)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				"GET",
+				"/sumdb/test.sumdb.com/"+filepath.ToSlash(rel),
+				nil,
+			)
+			sdb.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code, rel)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, w.Body.Bytes(), data, rel)
+			return nil
+		}))
+
+		// latest, 300 lookups, 2 level 0 tiles (along with their data tiles) and the partial level 1 tile.
+		require.Equal(t, 1+300+2+2+1, files)
+		_, err = os.Stat(filepath.Join(root, "lookup", "example.com", "!mod@v1.0.299"))
+		require.NoError(t, err)
+	})
+
+	t.Run("Export unregistered bucket", func(t *testing.T) {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		t.Cleanup(func() { _ = client.Close() })
+
+		sdb, err := NewSumDB(seedTree(t, client, 1), client)
+		require.NoError(t, err)
+
+		_, err = sdb.Export(t.Context(), "file:///unregistered")
+		require.ErrorIs(t, err, storage.ErrNoStorageForPath)
+	})
+}
+
+// seedTree creates the tree test.sumdb.com, containing n versions of example.com/Mod.
+func seedTree(t *testing.T, client *ent.Client, n int) *ent.SumDBTree {
+	t.Helper()
+
+	ctx := t.Context()
+	skey, vkey, err := sumdb.GenerateKeys("test.sumdb.com")
+	require.NoError(t, err)
+
+	tree := client.SumDBTree.Create().
+		SetName("test.sumdb.com").
+		SetSize(0).
+		SetSignerKey(crypto.Secret(skey)).
+		SetVerifierKey(vkey).
+		SaveX(ctx)
+
+	for i := range n {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+
+		_, err = Append(ctx, tx, tree.ID, NewRecord(
+			module.Version{Path: "example.com/Mod", Version: fmt.Sprintf("v1.0.%d", i)},
+			fmt.Sprintf("h1:%s=", bytes.Repeat([]byte{'z'}, i%10+1)),
+			fmt.Sprintf("h1:%s=", bytes.Repeat([]byte{'m'}, i%10+1)),
+		))
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+	}
+
+	return client.SumDBTree.GetX(ctx, tree.ID)
+}
@@ -0,0 +1,87 @@
+package sumdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pseudomuto/pacman/internal/boot"
+	"github.com/pseudomuto/pacman/internal/data"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"golang.org/x/mod/module"
+)
+
+var (
+	// ErrInvalidTreeName is returned when creating a tree whose name isn't a valid GOSUMDB name (e.g. sum.example.com).
+	ErrInvalidTreeName = errors.New("invalid tree name")
+	// ErrTreeExists is returned when creating a tree which already exists.
+	ErrTreeExists = errors.New("tree already exists")
+	// ErrTreeRetired is returned when changing a tree which has been retired.
+	ErrTreeRetired = errors.New("tree has been retired")
+)
+
+// CreateTree creates an empty tree named name, generating its signing key. Tree names are matched exactly, so they must
+// be lowercase.
+func CreateTree(ctx context.Context, db *ent.Client, name string) (*ent.SumDBTree, error) {
+	if err := module.CheckPath(name); err != nil || strings.Contains(name, "/") || name != strings.ToLower(name) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTreeName, name)
+	}
+
+	cr, err := boot.NewTree(db, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := cr.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s", ErrTreeExists, name)
+		}
+
+		return nil, fmt.Errorf("failed to create tree: %s, %w", name, err)
+	}
+
+	return tree, nil
+}
+
+// Freeze makes the named tree read-only. Frozen trees are still served, but they never grow. That is, unknown modules
+// aren't fetched and modules can't be published to them.
+func Freeze(ctx context.Context, db *ent.Client, name string) (*ent.SumDBTree, error) {
+	return setStatus(ctx, db, name, sumdbtree.StatusFrozen)
+}
+
+// Retire stops serving the named tree. Its records, hashes, and tree heads are kept for auditing, but it can't be
+// changed afterwards.
+func Retire(ctx context.Context, db *ent.Client, name string) (*ent.SumDBTree, error) {
+	return setStatus(ctx, db, name, sumdbtree.StatusRetired)
+}
+
+func setStatus(ctx context.Context, db *ent.Client, name string, status sumdbtree.Status) (*ent.SumDBTree, error) {
+	return data.WithTx(ctx, db, func(tx *ent.Tx) (*ent.SumDBTree, error) {
+		tree, err := tx.SumDBTree.Query().Where(sumdbtree.Name(name)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownTree, name)
+			}
+
+			return nil, fmt.Errorf("failed to find tree: %s, %w", name, err)
+		}
+
+		if tree.Status == status {
+			return tree, nil
+		}
+
+		if tree.Status == sumdbtree.StatusRetired {
+			return nil, fmt.Errorf("%w: %s", ErrTreeRetired, name)
+		}
+
+		tree, err = tx.SumDBTree.UpdateOne(tree).SetStatus(status).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update tree status: %s, %s, %w", name, status, err)
+		}
+
+		return tree, nil
+	})
+}
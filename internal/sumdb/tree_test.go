@@ -0,0 +1,89 @@
+package sumdb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/sumdb/note"
+)
+
+func TestSumDBPool(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	seedTree(t, client, 3)
+	pool := newPool(t, client)
+
+	svr := gin.New()
+	pool.RegisterRoutes(svr)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		svr.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil))
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, get("/sumdb/test.sumdb.com/latest").Code)
+	require.Equal(t, http.StatusOK, get("/sumdb/test.sumdb.com/tile/8/0/000.p/3").Code)
+	require.Equal(t, http.StatusNotFound, get("/sumdb/new.sumdb.com/latest").Code)
+
+	// Trees created at runtime are served without registering routes, once the trees are reloaded.
+	tree, err := CreateTree(t.Context(), client, "new.sumdb.com")
+	require.NoError(t, err)
+	require.Equal(t, sumdbtree.StatusActive, tree.Status)
+	require.Equal(t, http.StatusNotFound, get("/sumdb/new.sumdb.com/latest").Code)
+	pool.Reload()
+
+	w := get("/sumdb/new.sumdb.com/latest")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	verifier, err := note.NewVerifier(tree.VerifierKey)
+	require.NoError(t, err)
+	_, err = note.Open(w.Body.Bytes(), note.VerifierList(verifier))
+	require.NoError(t, err)
+
+	_, err = CreateTree(t.Context(), client, "new.sumdb.com")
+	require.ErrorIs(t, err, ErrTreeExists)
+
+	for _, name := range []string{"", "nope", "new.sumdb.com/path", "new+sumdb.com", "New.sumdb.com"} {
+		_, err = CreateTree(t.Context(), client, name)
+		require.ErrorIs(t, err, ErrInvalidTreeName, name)
+	}
+
+	// Frozen trees serve existing records, but don't fetch unknown modules.
+	tree, err = Freeze(t.Context(), client, "test.sumdb.com")
+	require.NoError(t, err)
+	require.Equal(t, sumdbtree.StatusFrozen, tree.Status)
+	pool.Reload()
+
+	w = get("/sumdb/test.sumdb.com/lookup/example.com/!mod@v1.0.1")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, http.StatusNotFound, get("/sumdb/test.sumdb.com/lookup/example.com/!mod@v1.0.3").Code)
+
+	// Retired trees are no longer served, and can't be changed.
+	tree, err = Retire(t.Context(), client, "test.sumdb.com")
+	require.NoError(t, err)
+	require.Equal(t, sumdbtree.StatusRetired, tree.Status)
+	pool.Reload()
+
+	require.Equal(t, http.StatusNotFound, get("/sumdb/test.sumdb.com/latest").Code)
+	_, err = pool.SumDB(t.Context(), "test.sumdb.com")
+	require.ErrorIs(t, err, ErrUnknownTree)
+
+	_, err = Retire(t.Context(), client, "test.sumdb.com")
+	require.NoError(t, err)
+
+	_, err = Freeze(t.Context(), client, "test.sumdb.com")
+	require.ErrorIs(t, err, ErrTreeRetired)
+
+	_, err = Freeze(t.Context(), client, "unknown.sumdb.com")
+	require.ErrorIs(t, err, ErrUnknownTree)
+}
@@ -0,0 +1,251 @@
+package sumdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pseudomuto/pacman/internal/cache"
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbcosignature"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtree"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+const (
+	algEd25519     = 0x01
+	algCosignature = 0x04
+)
+
+var (
+	// ErrUnknownTreeHead is returned when a tree head hasn't been signed by the tree.
+	ErrUnknownTreeHead = errors.New("unknown tree head")
+	// ErrTreeHeadMismatch is returned when a tree head doesn't match the one recorded for the same size.
+	ErrTreeHeadMismatch = errors.New("tree head doesn't match the recorded one")
+	// ErrInvalidCosignature is returned when a cosigned note isn't signed by any of the tree's witnesses.
+	ErrInvalidCosignature = errors.New("invalid cosignature")
+)
+
+type (
+	// Witnesses holds the verifiers of the configured witnesses.
+	Witnesses struct {
+		all   []note.Verifier
+		trees map[string][]note.Verifier
+	}
+
+	// cosignatureVerifier verifies cosignature/v1 signatures (see https://c2sp.org/tlog-cosignature). They're timestamped
+	// Ed25519 signatures, which can't be verified by note.NewVerifier.
+	cosignatureVerifier struct {
+		name string
+		hash uint32
+		key  ed25519.PublicKey
+	}
+)
+
+// NewWitnesses creates the verifiers for the configured witnesses.
+func NewWitnesses(c *config.Config) (*Witnesses, error) {
+	w := &Witnesses{trees: make(map[string][]note.Verifier)}
+	for _, wc := range c.Go.Witnesses {
+		v, err := NewWitnessVerifier(wc.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(wc.Trees) == 0 {
+			w.all = append(w.all, v)
+			continue
+		}
+
+		for _, tree := range wc.Trees {
+			w.trees[tree] = append(w.trees[tree], v)
+		}
+	}
+
+	return w, nil
+}
+
+// For returns the verifiers of the witnesses which cosign tree.
+func (w *Witnesses) For(tree string) []note.Verifier {
+	if w == nil {
+		return nil
+	}
+
+	return slices.Concat(w.all, w.trees[tree])
+}
+
+// NewWitnessVerifier creates a verifier for the witness key vkey, which is either a cosignature/v1 or Ed25519 verifier
+// key (see note.NewVerifier).
+func NewWitnessVerifier(vkey string) (note.Verifier, error) {
+	name, rest, _ := strings.Cut(vkey, "+")
+	hash16, key64, _ := strings.Cut(rest, "+")
+	hash, err1 := strconv.ParseUint(hash16, 16, 32)
+	key, err2 := base64.StdEncoding.DecodeString(key64)
+	if len(hash16) != 8 || err1 != nil || err2 != nil || len(key) == 0 {
+		return nil, fmt.Errorf("invalid witness key: %s", vkey)
+	}
+
+	switch key[0] {
+	case algEd25519:
+		v, err := note.NewVerifier(vkey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid witness key: %s, %w", vkey, err)
+		}
+
+		return v, nil
+	case algCosignature:
+		if len(key) != 1+ed25519.PublicKeySize || uint32(hash) != keyHash(name, key) {
+			return nil, fmt.Errorf("invalid witness key: %s", vkey)
+		}
+
+		return &cosignatureVerifier{name: name, hash: uint32(hash), key: key[1:]}, nil
+	default:
+		return nil, fmt.Errorf("unsupported witness key: %s", vkey)
+	}
+}
+
+func (v *cosignatureVerifier) Name() string    { return v.name }
+func (v *cosignatureVerifier) KeyHash() uint32 { return v.hash }
+
+// Verify checks the signature of msg, which is prefixed with the (big endian) time it was signed at.
+func (v *cosignatureVerifier) Verify(msg, sig []byte) bool {
+	if len(sig) != 8+ed25519.SignatureSize {
+		return false
+	}
+
+	ts := binary.BigEndian.Uint64(sig)
+	signed := fmt.Appendf(nil, "cosignature/v1\ntime %d\n%s", ts, msg)
+	return ed25519.Verify(v.key, signed, sig[8:])
+}
+
+// keyHash computes the hash of a note key (see note.NewVerifier).
+func keyHash(name string, key []byte) uint32 {
+	h := sha256.Sum256(append([]byte(name+"\n"), key...))
+	return binary.BigEndian.Uint32(h[:])
+}
+
+// WithWitnesses accepts cosignatures of the tree's heads from the witnesses verified by vs.
+func (s *SumDB) WithWitnesses(vs ...note.Verifier) *SumDB {
+	s.witnesses = vs
+	return s
+}
+
+// Cosign records the witness cosignatures of a tree head. The text of msg must match the tree head signed for the same
+// size, while only signatures verified by the tree's witnesses are recorded (others are ignored). The number of
+// cosignatures found is returned, along with the size of the tree head.
+func (s *SumDB) Cosign(ctx context.Context, msg []byte) (int64, int, error) {
+	n, err := note.Open(msg, note.VerifierList(s.witnesses...))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %s, %w", ErrInvalidCosignature, s.name, err)
+	}
+
+	tree, err := tlog.ParseTree([]byte(n.Text))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %s, %w", ErrInvalidCosignature, s.name, err)
+	}
+
+	head, err := s.db.SumDBTreeHead.Query().
+		Where(
+			sumdbtreehead.HasTreeWith(sumdbtree.ID(s.id)),
+			sumdbtreehead.Size(tree.N),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, 0, fmt.Errorf("%w: %s, size %d", ErrUnknownTreeHead, s.name, tree.N)
+		}
+
+		return 0, 0, fmt.Errorf("failed to find tree head: %s, %w", s.name, err)
+	}
+
+	if text, _, _ := bytes.Cut(head.Note, []byte("\n\n")); string(text)+"\n" != n.Text {
+		return 0, 0, fmt.Errorf("%w: %s, size %d", ErrTreeHeadMismatch, s.name, tree.N)
+	}
+
+	creates := make([]*ent.SumDBCosignatureCreate, len(n.Sigs))
+	for i, sig := range n.Sigs {
+		creates[i] = s.db.SumDBCosignature.Create().
+			SetHead(head).
+			SetWitness(sig.Name).
+			SetKeyHash(sig.Hash).
+			SetSignature(sig.Base64)
+	}
+
+	if err := s.db.SumDBCosignature.CreateBulk(creates...).
+		OnConflictColumns(
+			sumdbcosignature.FieldWitness,
+			sumdbcosignature.FieldKeyHash,
+			sumdbcosignature.HeadColumn,
+		).
+		Ignore().
+		Exec(ctx); err != nil {
+		return 0, 0, fmt.Errorf("failed to save cosignatures: %s, %w", s.name, err)
+	}
+
+	s.heads.Delete(s.id)
+	return tree.N, len(n.Sigs), nil
+}
+
+// recordHead records the signed tree head msg, unless its size has already been recorded, in which case it must match
+// the recorded one. The recorded head is returned along with its cosignatures.
+func (s *SumDB) recordHead(ctx context.Context, msg []byte) (*ent.SumDBTreeHead, error) {
+	text, _, _ := bytes.Cut(msg, []byte("\n\n"))
+	tree, err := tlog.ParseTree(msg[:len(text)+1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tree head: %s, %w", s.name, err)
+	}
+
+	head, ok := s.heads.Get(s.id)
+	if !ok || head.Size != tree.N {
+		if err := s.db.SumDBTreeHead.Create().
+			SetTreeID(s.id).
+			SetSize(tree.N).
+			SetHash(tree.Hash[:]).
+			SetNote(msg).
+			OnConflictColumns(sumdbtreehead.FieldSize, sumdbtreehead.TreeColumn).
+			Ignore().
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to record tree head: %s, %w", s.name, err)
+		}
+
+		head, err = s.db.SumDBTreeHead.Query().
+			Where(
+				sumdbtreehead.HasTreeWith(sumdbtree.ID(s.id)),
+				sumdbtreehead.Size(tree.N),
+			).
+			WithCosignatures(func(q *ent.SumDBCosignatureQuery) {
+				q.Order(ent.Asc(sumdbcosignature.FieldID))
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find tree head: %s, %w", s.name, err)
+		}
+
+		s.heads.Set(s.id, head, cache.DefaultTTL)
+	}
+
+	if !bytes.Equal(head.Hash, tree.Hash[:]) {
+		return nil, fmt.Errorf("%w: %s, size %d", ErrTreeHeadMismatch, s.name, tree.N)
+	}
+
+	return head, nil
+}
+
+// cosign appends the cosignatures of head to the signed note msg.
+func cosign(msg []byte, head *ent.SumDBTreeHead) []byte {
+	for _, c := range head.Edges.Cosignatures {
+		msg = fmt.Appendf(msg, "— %s %s\n", c.Witness, c.Signature)
+	}
+
+	return msg
+}
@@ -0,0 +1,227 @@
+package sumdb_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/ent"
+	"github.com/pseudomuto/pacman/internal/ent/enttest"
+	"github.com/pseudomuto/pacman/internal/ent/sumdbtreehead"
+	. "github.com/pseudomuto/pacman/internal/sumdb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+func TestNewWitnesses(t *testing.T) {
+	t.Parallel()
+
+	cosigner := newWitness(t, "cosigner.example.com")
+	_, ed25519Key, err := note.GenerateKey(rand.Reader, "ed25519.example.com")
+	require.NoError(t, err)
+
+	w, err := NewWitnesses(&config.Config{
+		Go: config.Go{
+			Witnesses: []config.Witness{
+				{Key: cosigner.vkey},
+				{Key: ed25519Key, Trees: []string{"private.sumdb.com"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	names := func(vs []note.Verifier) []string {
+		res := make([]string, len(vs))
+		for i := range vs {
+			res[i] = vs[i].Name()
+		}
+
+		return res
+	}
+
+	require.Equal(t, []string{"cosigner.example.com"}, names(w.For("public.sumdb.com")))
+	require.Equal(t, []string{"cosigner.example.com", "ed25519.example.com"}, names(w.For("private.sumdb.com")))
+
+	invalid := []string{
+		"",
+		"witness.example.com",
+		"witness.example.com+00000000+BCz1Ot6lMvvmjDTMv8fVFNKbeXKPJmuDIPnHFL2HxH29",
+		"witness.example.com+1a2b3c4d+AmFueQ==",
+		strings.Replace(cosigner.vkey, "+", "+0", 1),
+	}
+
+	for _, key := range invalid {
+		_, err := NewWitnesses(&config.Config{Go: config.Go{Witnesses: []config.Witness{{Key: key}}}})
+		require.Error(t, err, key)
+	}
+}
+
+func TestSumDB_Cosign(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	tree := seedTree(t, client, 3)
+	witness := newWitness(t, "witness.example.com")
+	other := newWitness(t, "other.example.com")
+
+	treeVerifier, err := note.NewVerifier(tree.VerifierKey)
+	require.NoError(t, err)
+
+	sdb, err := NewSumDB(tree, client)
+	require.NoError(t, err)
+	sdb.WithWitnesses(witness.verifier(t))
+
+	latest := func() []byte {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(t.Context(), "GET", "/sumdb/test.sumdb.com/latest", nil)
+		sdb.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return w.Body.Bytes()
+	}
+
+	// Every signed tree head is recorded.
+	head := latest()
+	n, err := note.Open(head, note.VerifierList(treeVerifier))
+	require.NoError(t, err)
+
+	heads := client.SumDBTreeHead.Query().AllX(t.Context())
+	require.Len(t, heads, 1)
+	require.Equal(t, int64(3), heads[0].Size)
+	require.Equal(t, head, heads[0].Note)
+
+	// Cosignatures are recorded and served along with the tree head.
+	size, count, err := sdb.Cosign(t.Context(), witness.cosign(t, n.Text))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), size)
+	require.Equal(t, 1, count)
+
+	cosigned := latest()
+	_, err = note.Open(cosigned, note.VerifierList(witness.verifier(t)))
+	require.NoError(t, err)
+	_, err = note.Open(cosigned, note.VerifierList(treeVerifier))
+	require.NoError(t, err)
+
+	// Cosigning again is a no-op.
+	_, _, err = sdb.Cosign(t.Context(), witness.cosign(t, n.Text))
+	require.NoError(t, err)
+	require.Equal(t, cosigned, latest())
+
+	// Signatures from other keys aren't accepted.
+	_, _, err = sdb.Cosign(t.Context(), other.cosign(t, n.Text))
+	require.ErrorIs(t, err, ErrInvalidCosignature)
+
+	// Only signed tree heads can be cosigned.
+	_, _, err = sdb.Cosign(t.Context(), witness.cosign(t, "go.sum database tree\n12\n"+tlog.Hash{}.String()+"\n"))
+	require.ErrorIs(t, err, ErrUnknownTreeHead)
+
+	_, _, err = sdb.Cosign(t.Context(), witness.cosign(t, "go.sum database tree\n3\n"+tlog.Hash{}.String()+"\n"))
+	require.ErrorIs(t, err, ErrTreeHeadMismatch)
+
+	// New tree heads are recorded as the tree grows.
+	appendRecord(t, client, tree, "v2.0.0")
+	_, err = note.Open(latest(), note.VerifierList(witness.verifier(t)))
+	require.Error(t, err)
+
+	heads = client.SumDBTreeHead.Query().Order(sumdbtreehead.BySize()).AllX(t.Context())
+	require.Len(t, heads, 2)
+	require.Equal(t, int64(4), heads[1].Size)
+
+	report, err := Audit(t.Context(), client, tree.Name)
+	require.NoError(t, err)
+	require.True(t, report.OK(), report.Divergences)
+	require.Equal(t, int64(2), report.Heads)
+
+	// Diverging tree heads are reported by the audit.
+	client.SumDBCosignature.Delete().ExecX(t.Context())
+	client.SumDBTreeHead.DeleteOneID(heads[0].ID).ExecX(t.Context())
+	client.SumDBTreeHead.Create().
+		SetTree(tree).
+		SetSize(3).
+		SetHash(make([]byte, 32)).
+		SetNote(heads[0].Note).
+		ExecX(t.Context())
+	report, err = Audit(t.Context(), client, tree.Name)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Total)
+	require.Regexp(t, `^tree head 3 note is for size 3 with hash .+=$`, report.Divergences[0])
+	require.Regexp(t, `^tree head 3 hash is AAAA.+=, expected .+=$`, report.Divergences[1])
+}
+
+func appendRecord(t *testing.T, client *ent.Client, tree *ent.SumDBTree, version string) {
+	t.Helper()
+
+	tx, err := client.Tx(t.Context())
+	require.NoError(t, err)
+
+	_, err = Append(t.Context(), tx, tree.ID, NewRecord(
+		module.Version{Path: "example.com/Mod", Version: version},
+		"h1:zip=",
+		"h1:mod=",
+	))
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+}
+
+// witness stands in for a tlog-witness, producing cosignature/v1 signatures.
+type witness struct {
+	name string
+	hash uint32
+	key  ed25519.PrivateKey
+	vkey string
+}
+
+func newWitness(t *testing.T, name string) *witness {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key := append([]byte{0x04}, pub...)
+	h := sha256.Sum256(append([]byte(name+"\n"), key...))
+	hash := binary.BigEndian.Uint32(h[:])
+
+	return &witness{
+		name: name,
+		hash: hash,
+		key:  priv,
+		vkey: fmt.Sprintf("%s+%08x+%s", name, hash, base64.StdEncoding.EncodeToString(key)),
+	}
+}
+
+func (w *witness) Name() string    { return w.name }
+func (w *witness) KeyHash() uint32 { return w.hash }
+
+func (w *witness) Sign(msg []byte) ([]byte, error) {
+	ts := uint64(time.Now().Unix())
+	sig := binary.BigEndian.AppendUint64(nil, ts)
+	return append(sig, ed25519.Sign(w.key, fmt.Appendf(nil, "cosignature/v1\ntime %d\n%s", ts, msg))...), nil
+}
+
+func (w *witness) cosign(t *testing.T, text string) []byte {
+	t.Helper()
+
+	msg, err := note.Sign(&note.Note{Text: text}, w)
+	require.NoError(t, err)
+	return msg
+}
+
+func (w *witness) verifier(t *testing.T) note.Verifier {
+	t.Helper()
+
+	v, err := NewWitnessVerifier(w.vkey)
+	require.NoError(t, err)
+	return v
+}
@@ -19,3 +19,15 @@ func (s StorageType) String() string {
 
 	panic(fmt.Sprintf("unknown storage type: %T", s))
 }
+
+// ParseStorageType returns the StorageType for s (e.g. fs, gcs).
+func ParseStorageType(s string) (StorageType, error) {
+	switch s {
+	case "fs":
+		return FileSystem, nil
+	case "gcs":
+		return GCS, nil
+	}
+
+	return 0, fmt.Errorf("unknown storage type: %q", s)
+}
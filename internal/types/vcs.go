@@ -1,8 +1,15 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 const (
 	GitLab VCSType = iota
 	GitHub VCSType = iota
+	Git    VCSType = iota
 )
 
 type (
@@ -12,12 +19,39 @@ type (
 		Ref string // SHA, branch, or tag
 		Dir string // Directory within the repo to fetch.
 	}
+
+	// Commit is a resolved VCS ref.
+	Commit struct {
+		SHA  string    // The full commit SHA.
+		Time time.Time // The commit (not author) time.
+		// Tags are the version tags reachable from the commit, including at least the latest one for each major version.
+		// When VCSOptions.Dir is set, only tags prefixed with it (e.g. sub/dir/v1.2.3) are included, as per Go's rules
+		// for modules in subdirectories.
+		Tags []string
+	}
 )
 
 func (v VCSType) String() string {
-	if v == GitHub {
+	switch v {
+	case GitHub:
 		return "GitHub"
+	case Git:
+		return "Git"
 	}
 
 	return "GitLab"
 }
+
+// ParseVCSType returns the VCSType for s (case insensitive).
+func ParseVCSType(s string) (VCSType, error) {
+	switch strings.ToLower(s) {
+	case "gitlab":
+		return GitLab, nil
+	case "github":
+		return GitHub, nil
+	case "git":
+		return Git, nil
+	}
+
+	return 0, fmt.Errorf("unknown VCS type: %q", s)
+}
@@ -0,0 +1,64 @@
+package vcs
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/publisher"
+	"go.uber.org/fx"
+)
+
+type Fetchers struct {
+	fx.Out
+
+	Fetchers []publisher.VCSFetcher `group:"publisher_vcs_fetchers,flatten"`
+}
+
+var Module = fx.Module("vcs", fx.Provide(NewFetchers))
+
+// NewFetchers creates a VCSFetcher for each configured VCS.
+func NewFetchers(c *config.Config) (Fetchers, error) {
+	var res Fetchers
+	if g := c.VCS.Git; g != nil {
+		res.Fetchers = append(res.Fetchers, NewGit(g.Binary))
+	}
+
+	if gh := c.VCS.GitHub; gh != nil {
+		token, err := decryptToken(gh.Token)
+		if err != nil {
+			return res, fmt.Errorf("invalid GitHub token: %w", err)
+		}
+
+		res.Fetchers = append(res.Fetchers, NewGitHub(http.DefaultClient, gh.BaseURL, token))
+	}
+
+	if gl := c.VCS.GitLab; gl != nil {
+		token, err := decryptToken(gl.Token)
+		if err != nil {
+			return res, fmt.Errorf("invalid GitLab token: %w", err)
+		}
+
+		fetcher, err := NewGitLab(gl.BaseURL, token)
+		if err != nil {
+			return res, err
+		}
+
+		res.Fetchers = append(res.Fetchers, fetcher)
+	}
+
+	return res, nil
+}
+
+// decryptToken decrypts the (optional) crypto.Secret ct.
+func decryptToken(ct string) (string, error) {
+	var token crypto.Secret
+	if ct != "" {
+		if err := token.Scan(ct); err != nil {
+			return "", err
+		}
+	}
+
+	return string(token), nil
+}
@@ -0,0 +1,55 @@
+package vcs_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pseudomuto/pacman/internal/config"
+	"github.com/pseudomuto/pacman/internal/crypto"
+	"github.com/pseudomuto/pacman/internal/types"
+	. "github.com/pseudomuto/pacman/internal/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFetchers(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(svr.Close)
+
+	// NB: Tokens are encrypted in the config.
+	ct, err := crypto.Secret("secret").Value()
+	require.NoError(t, err)
+
+	c := &config.Config{VCS: config.VCS{
+		Git:    &config.Git{},
+		GitHub: &config.GitHub{BaseURL: svr.URL, Token: ct.(string)},
+		GitLab: &config.GitLab{BaseURL: "https://gitlab.example.com", Token: ct.(string)},
+	}}
+
+	res, err := NewFetchers(c)
+	require.NoError(t, err)
+	require.Len(t, res.Fetchers, 3)
+
+	vcs := make([]types.VCSType, len(res.Fetchers))
+	for i, f := range res.Fetchers {
+		vcs[i] = f.Type()
+	}
+
+	require.Equal(t, []types.VCSType{types.Git, types.GitHub, types.GitLab}, vcs)
+	require.Equal(t, "https://gitlab.example.com/group/repo", res.Fetchers[2].RepoURL("group/repo"))
+
+	// The GitHub token is decrypted before it's sent.
+	_, err = res.Fetchers[1].FetchCommit("owner/repo", types.VCSOptions{Ref: "main"})
+	require.ErrorContains(t, err, "unexpected status: 404")
+
+	t.Run("plaintext token", func(t *testing.T) {
+		_, err := NewFetchers(&config.Config{VCS: config.VCS{GitHub: &config.GitHub{Token: "secret"}}})
+		require.ErrorContains(t, err, "invalid GitHub token")
+
+		_, err = NewFetchers(&config.Config{VCS: config.VCS{GitLab: &config.GitLab{Token: "secret"}}})
+		require.ErrorContains(t, err, "invalid GitLab token")
+	})
+}
@@ -0,0 +1,270 @@
+package vcs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/fsutil"
+	"github.com/pseudomuto/pacman/internal/types"
+)
+
+const (
+	defaultGitBinary = "git"
+
+	// cloneTTL is how long a clone made by FetchCommit is kept for the FetchArchive call which follows it.
+	cloneTTL = time.Minute
+)
+
+type (
+	// Git fetches archives from any git remote (https, ssh, file://, or a path to a repo on disk) using the git binary.
+	//
+	// This is useful for servers that don't expose an archive API (e.g. Gitea, cgit, mirrors) as well as local testing.
+	Git struct {
+		bin string
+
+		mu     sync.Mutex
+		clones map[string]*clone
+	}
+
+	// clone is a bare clone of a repo, kept by FetchCommit so that archiving the commit (i.e. when publishing a
+	// pseudo-version) doesn't clone the repo again. It's removed once used, or after cloneTTL.
+	clone struct {
+		dir   string
+		timer *time.Timer
+	}
+)
+
+// NewGit creates a Git fetcher using the supplied git binary. When empty, git is resolved from PATH.
+func NewGit(bin string) *Git {
+	if bin == "" {
+		bin = defaultGitBinary
+	}
+
+	return &Git{bin: bin, clones: make(map[string]*clone)}
+}
+
+func (g *Git) Type() types.VCSType {
+	return types.Git
+}
+
+// RepoURL returns repo, since it's already the URL (or path) the repo is cloned from.
+func (*Git) RepoURL(repo string) string {
+	return repo
+}
+
+// FetchArchive clones repo and writes a tar.gz of opts.Ref to w. When opts.Dir is set, only entries within that
+// directory are included. All entries are nested within a single top-level directory (<name>-<sha>/).
+//
+// When opts.Ref is the SHA of a commit just fetched by FetchCommit, its clone is reused.
+func (g *Git) FetchArchive(w io.Writer, repo string, opts types.VCSOptions) error {
+	ctx := context.Background()
+
+	if dir, ok := g.take(repo, opts.Ref); ok {
+		defer func() { _ = os.RemoveAll(dir) }()
+		return g.archive(ctx, w, dir, repo, opts)
+	}
+
+	return fsutil.WithTempDir(func(dir string) error {
+		if _, err := g.git(ctx, "", "clone", "--bare", "--quiet", "--", repo, dir); err != nil {
+			return fmt.Errorf("failed to clone repo: %s, %w", repo, err)
+		}
+
+		return g.archive(ctx, w, dir, repo, opts)
+	})
+}
+
+// FetchCommit clones repo and resolves opts.Ref to a Commit, including the version tags (see types.Commit) reachable
+// from it. The clone is kept briefly, for archiving the commit (see FetchArchive).
+func (g *Git) FetchCommit(repo string, opts types.VCSOptions) (*types.Commit, error) {
+	ctx := context.Background()
+
+	dir, err := os.MkdirTemp("", "pacman-git-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clone dir: %w", err)
+	}
+
+	commit, err := g.commit(ctx, dir, repo, opts)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	g.keep(repo, commit.SHA, dir)
+	return commit, nil
+}
+
+// archive writes a tar.gz of opts.Ref from the clone of repo in dir to w.
+func (g *Git) archive(ctx context.Context, w io.Writer, dir, repo string, opts types.VCSOptions) error {
+	sha, err := g.resolve(ctx, dir, opts.Ref)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"archive", "--format=tar", "--prefix=" + archivePrefix(repo, sha), sha}
+	if d := strings.Trim(path.Clean("/"+opts.Dir), "/"); d != "" {
+		args = append(args, "--", d)
+	}
+
+	gw := gzip.NewWriter(w)
+	cmd := g.command(ctx, dir, args...)
+	cmd.Stdout = gw
+	if err := run(cmd); err != nil {
+		return fmt.Errorf("failed to write VCS archive: %s:%s, %w", repo, opts.Dir, err)
+	}
+
+	return gw.Close()
+}
+
+// commit clones repo into dir and resolves opts.Ref to a Commit.
+func (g *Git) commit(ctx context.Context, dir, repo string, opts types.VCSOptions) (*types.Commit, error) {
+	if _, err := g.git(ctx, "", "clone", "--bare", "--quiet", "--", repo, dir); err != nil {
+		return nil, fmt.Errorf("failed to clone repo: %s, %w", repo, err)
+	}
+
+	sha, err := g.resolve(ctx, dir, opts.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := g.git(ctx, dir, "show", "--no-patch", "--format=%ct", sha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit time: %s, %w", sha, err)
+	}
+
+	ts, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit time: %s, %w", sha, err)
+	}
+
+	out, err = g.git(ctx, dir, "tag", "--merged", sha, "--list", tagPrefix(opts.Dir)+"v*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %s, %w", sha, err)
+	}
+
+	return &types.Commit{
+		SHA:  sha,
+		Time: time.Unix(ts, 0).UTC(),
+		Tags: strings.Fields(out),
+	}, nil
+}
+
+// keep holds on to the clone of repo in dir for archiving sha, removing it after cloneTTL unless it's taken first.
+func (g *Git) keep(repo, sha, dir string) {
+	key := repo + "@" + sha
+	c := &clone{dir: dir}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// NB: A concurrent publish of the same commit. Either clone will do.
+	if prev, ok := g.clones[key]; ok && prev.timer.Stop() {
+		_ = os.RemoveAll(prev.dir)
+	}
+
+	g.clones[key] = c
+	c.timer = time.AfterFunc(cloneTTL, func() {
+		g.mu.Lock()
+		if g.clones[key] == c {
+			delete(g.clones, key)
+		}
+		g.mu.Unlock()
+
+		_ = os.RemoveAll(dir)
+	})
+}
+
+// take returns the dir of the clone kept for archiving repo at sha. The caller is responsible for removing it.
+func (g *Git) take(repo, sha string) (string, bool) {
+	key := repo + "@" + sha
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	c, ok := g.clones[key]
+	if !ok {
+		return "", false
+	}
+
+	delete(g.clones, key)
+
+	// NB: The clone expired, and is being removed.
+	if !c.timer.Stop() {
+		return "", false
+	}
+
+	return c.dir, true
+}
+
+// resolve returns the full commit SHA for ref (branch, tag, or [abbreviated] SHA) within the repo at dir.
+func (g *Git) resolve(ctx context.Context, dir, ref string) (string, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	out, err := g.git(ctx, dir, "rev-parse", "--verify", "--quiet", "--end-of-options", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("unknown ref: %s, %w", ref, err)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// git runs the git command in dir and returns its output.
+func (g *Git) git(ctx context.Context, dir string, args ...string) (string, error) {
+	var out bytes.Buffer
+	cmd := g.command(ctx, dir, args...)
+	cmd.Stdout = &out
+	if err := run(cmd); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+func (g *Git) command(ctx context.Context, dir string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, g.bin, args...) //nolint:gosec // args are never passed to a shell
+	cmd.Dir = dir
+	// NB: Never prompt for credentials, fail instead.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	return cmd
+}
+
+// run executes cmd, including stderr in the returned error on failure.
+func run(cmd *exec.Cmd) error {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// archivePrefix returns the top-level directory for archives of repo at sha (e.g. repo-0123456789ab/).
+func archivePrefix(repo, sha string) string {
+	name := strings.TrimSuffix(path.Base(strings.TrimRight(repo, "/")), ".git")
+	return name + "-" + sha[:min(len(sha), 12)] + "/"
+}
+
+// tagPrefix returns the prefix for version tags of the module in dir (e.g. sub/dir/).
+func tagPrefix(dir string) string {
+	if d := strings.Trim(path.Clean("/"+dir), "/"); d != "" {
+		return d + "/"
+	}
+
+	return ""
+}
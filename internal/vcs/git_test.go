@@ -0,0 +1,203 @@
+package vcs_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/types"
+	. "github.com/pseudomuto/pacman/internal/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGit_FetchArchive(t *testing.T) {
+	t.Parallel()
+
+	repo := newGitRepo(t, map[string]string{
+		"README.md":           "# Readme",
+		"sub/dir/go.mod":      "module example.com/sub/dir\n",
+		"sub/dir/pkg/lib.go":  "package pkg\n",
+		"sub/other/thing.txt": "other",
+	})
+
+	gitCmd(t, repo, "tag", "v1.0.0")
+	sha := strings.TrimSpace(gitCmd(t, repo, "rev-parse", "HEAD"))
+
+	// Add another commit so tags/branches/SHAs resolve differently
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "sub", "dir", "new.go"), []byte("package dir\n"), 0o600))
+	gitCmd(t, repo, "add", ".")
+	gitCmd(t, repo, "commit", "-qm", "second")
+
+	bare := filepath.Join(t.TempDir(), "mirror.git")
+	gitCmd(t, "", "clone", "-q", "--bare", repo, bare)
+
+	g := NewGit("")
+	require.Equal(t, types.Git, g.Type())
+
+	tests := []struct {
+		name  string
+		repo  string
+		opts  types.VCSOptions
+		files []string
+	}{
+		{
+			name: "tag with dir",
+			repo: "file://" + repo,
+			opts: types.VCSOptions{Ref: "v1.0.0", Dir: "sub/dir"},
+			files: []string{
+				"repo-" + sha[:12] + "/",
+				"repo-" + sha[:12] + "/sub/",
+				"repo-" + sha[:12] + "/sub/dir/",
+				"repo-" + sha[:12] + "/sub/dir/go.mod",
+				"repo-" + sha[:12] + "/sub/dir/pkg/",
+				"repo-" + sha[:12] + "/sub/dir/pkg/lib.go",
+			},
+		},
+		{
+			name: "short SHA from bare repo",
+			repo: bare,
+			opts: types.VCSOptions{Ref: sha[:8], Dir: "sub/other"},
+			files: []string{
+				"mirror-" + sha[:12] + "/",
+				"mirror-" + sha[:12] + "/sub/",
+				"mirror-" + sha[:12] + "/sub/other/",
+				"mirror-" + sha[:12] + "/sub/other/thing.txt",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, g.FetchArchive(&buf, tt.repo, tt.opts))
+			require.Equal(t, tt.files, tarNames(t, &buf))
+		})
+	}
+
+	t.Run("branch", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, g.FetchArchive(&buf, bare, types.VCSOptions{Ref: "main", Dir: "sub/dir"}))
+		require.Contains(t, tarNames(t, &buf), "mirror-"+strings.TrimSpace(gitCmd(t, repo, "rev-parse", "HEAD"))[:12]+"/sub/dir/new.go")
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		err := g.FetchArchive(&bytes.Buffer{}, bare, types.VCSOptions{Ref: "v9.9.9"})
+		require.ErrorContains(t, err, "unknown ref: v9.9.9")
+	})
+
+	t.Run("unknown repo", func(t *testing.T) {
+		err := g.FetchArchive(&bytes.Buffer{}, filepath.Join(t.TempDir(), "nope"), types.VCSOptions{})
+		require.ErrorContains(t, err, "failed to clone repo")
+	})
+}
+
+func TestGit_FetchCommit(t *testing.T) {
+	t.Parallel()
+
+	repo := newGitRepo(t, map[string]string{"sub/dir/go.mod": "module example.com/sub/dir\n"})
+	gitCmd(t, repo, "tag", "v1.0.0")
+	gitCmd(t, repo, "tag", "sub/dir/v0.1.0")
+	gitCmd(t, repo, "tag", "sub/dirty/v0.2.0")
+	gitCmd(t, repo, "tag", "release")
+	gitCmd(t, repo, "checkout", "-q", "-b", "feature")
+	gitCmd(t, repo, "commit", "-q", "--allow-empty", "-m", "feature")
+	gitCmd(t, repo, "tag", "v1.1.0-rc.1")
+	gitCmd(t, repo, "checkout", "-q", "main")
+	gitCmd(t, repo, "commit", "-q", "--allow-empty", "-m", "second")
+
+	sha := strings.TrimSpace(gitCmd(t, repo, "rev-parse", "main"))
+	ts, err := strconv.ParseInt(strings.TrimSpace(gitCmd(t, repo, "show", "--no-patch", "--format=%ct", "main")), 10, 64)
+	require.NoError(t, err)
+
+	g := NewGit("")
+	commit, err := g.FetchCommit(repo, types.VCSOptions{Ref: "main"})
+	require.NoError(t, err)
+	require.Equal(t, sha, commit.SHA)
+	require.Equal(t, time.Unix(ts, 0).UTC(), commit.Time)
+	require.Equal(t, []string{"v1.0.0"}, commit.Tags)
+
+	commit, err = g.FetchCommit(repo, types.VCSOptions{Ref: "feature"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.0.0", "v1.1.0-rc.1"}, commit.Tags)
+
+	commit, err = g.FetchCommit(repo, types.VCSOptions{Ref: sha[:8], Dir: "sub/dir"})
+	require.NoError(t, err)
+	require.Equal(t, sha, commit.SHA)
+	require.Equal(t, []string{"sub/dir/v0.1.0"}, commit.Tags)
+
+	_, err = g.FetchCommit(repo, types.VCSOptions{Ref: "nope"})
+	require.ErrorContains(t, err, "unknown ref: nope")
+}
+
+func TestGit_FetchCommit_ReusesClone(t *testing.T) {
+	t.Parallel()
+
+	repo := newGitRepo(t, map[string]string{"go.mod": "module example.com/mod\n"})
+
+	// NB: Wraps git to log the subcommands that are run.
+	dir := t.TempDir()
+	log := filepath.Join(dir, "git.log")
+	bin := filepath.Join(dir, "git")
+	require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\necho \"$1\" >> "+log+"\nexec git \"$@\"\n"), 0o700))
+
+	clones := func() int {
+		data, err := os.ReadFile(log)
+		require.NoError(t, err)
+		return strings.Count(string(data), "clone\n")
+	}
+
+	g := NewGit(bin)
+	commit, err := g.FetchCommit(repo, types.VCSOptions{Ref: "main"})
+	require.NoError(t, err)
+	require.Equal(t, 1, clones())
+
+	var buf bytes.Buffer
+	require.NoError(t, g.FetchArchive(&buf, repo, types.VCSOptions{Ref: commit.SHA}))
+	require.Contains(t, tarNames(t, &buf), "repo-"+commit.SHA[:12]+"/go.mod")
+	require.Equal(t, 1, clones())
+
+	// The clone is only used once.
+	buf.Reset()
+	require.NoError(t, g.FetchArchive(&buf, repo, types.VCSOptions{Ref: commit.SHA}))
+	require.Equal(t, 2, clones())
+}
+
+// newGitRepo creates a git repo (in a dir named repo) with a single commit containing files.
+func newGitRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "repo")
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	gitCmd(t, dir, "init", "-q", "-b", "main")
+	gitCmd(t, dir, "add", ".")
+	gitCmd(t, dir, "commit", "-qm", "initial")
+	return dir
+}
+
+func gitCmd(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.CommandContext(t.Context(), "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(
+		os.Environ(),
+		"GIT_AUTHOR_NAME=test",
+		"GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test",
+		"GIT_COMMITTER_EMAIL=test@example.com",
+	)
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return string(out)
+}
@@ -0,0 +1,288 @@
+package vcs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/types"
+)
+
+const (
+	defaultGitHubURL = "https://api.github.com"
+	gitHubPageSize   = 100
+)
+
+type (
+	// GitHub fetches archives using the GitHub (or GitHub Enterprise) REST API.
+	GitHub struct {
+		client  *http.Client
+		baseURL string
+		webURL  string
+		token   string
+	}
+)
+
+// NewGitHub creates a GitHub fetcher for the API at baseURL (e.g. https://ghe.example.com/api/v3). When baseURL is
+// empty, api.github.com is used. The token is optional, but required for private repos.
+func NewGitHub(client *http.Client, baseURL, token string) *GitHub {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if baseURL == "" {
+		baseURL = defaultGitHubURL
+	}
+
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &GitHub{
+		client:  client,
+		baseURL: baseURL,
+		webURL:  gitHubWebURL(baseURL),
+		token:   token,
+	}
+}
+
+func (g *GitHub) Type() types.VCSType {
+	return types.GitHub
+}
+
+// RepoURL returns the web URL of repo (owner/name), e.g. https://ghe.example.com/owner/name.
+func (g *GitHub) RepoURL(repo string) string {
+	return g.webURL + "/" + repo
+}
+
+// FetchArchive writes a tar.gz of repo (owner/name) at opts.Ref to w. When opts.Dir is set, only entries within that
+// directory are included. As with GitLab, all entries are nested within a single top-level directory.
+func (g *GitHub) FetchArchive(w io.Writer, repo string, opts types.VCSOptions) error {
+	res, err := g.get(context.Background(), "/repos/"+repo+"/tarball/"+escapeRef(opts.Ref))
+	if err != nil {
+		return fmt.Errorf("failed fetching VCS archive: %s:%s, %w", repo, opts.Dir, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if err := narrowTarGz(w, res.Body, opts.Dir); err != nil {
+		return fmt.Errorf("failed to write VCS archive: %s:%s, %w", repo, opts.Dir, err)
+	}
+
+	return nil
+}
+
+// FetchCommit resolves opts.Ref in repo (owner/name) to a Commit. Since the API doesn't expose which tags are reachable
+// from a commit, version tags are compared against it, latest first. Only the latest reachable tag for each major
+// version determines the pseudo-version, so older ones aren't compared.
+func (g *GitHub) FetchCommit(repo string, opts types.VCSOptions) (*types.Commit, error) {
+	ctx := context.Background()
+
+	var c struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Committer struct {
+				Date time.Time `json:"date"`
+			} `json:"committer"`
+		} `json:"commit"`
+	}
+
+	if err := g.getJSON(ctx, "/repos/"+repo+"/commits/"+escapeRef(opts.Ref), &c); err != nil {
+		return nil, fmt.Errorf("failed fetching commit: %s@%s, %w", repo, opts.Ref, err)
+	}
+
+	tags, err := g.versionTags(ctx, repo, tagPrefix(opts.Dir))
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err = latestReachable(tags, func(sha string) (bool, error) { return g.reachable(ctx, repo, sha, c.SHA) })
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Commit{SHA: c.SHA, Time: c.Commit.Committer.Date.UTC(), Tags: tagNames(tags)}, nil
+}
+
+// versionTags returns the canonical version tags in repo with the given prefix (e.g. sub/dir/), latest first.
+func (g *GitHub) versionTags(ctx context.Context, repo, prefix string) ([]versionTag, error) {
+	var res []versionTag
+	for page := 1; ; page++ {
+		var tags []struct {
+			Name   string `json:"name"`
+			Commit struct {
+				SHA string `json:"sha"`
+			} `json:"commit"`
+		}
+
+		uri := "/repos/" + repo + "/tags?per_page=" + strconv.Itoa(gitHubPageSize) + "&page=" + strconv.Itoa(page)
+		if err := g.getJSON(ctx, uri, &tags); err != nil {
+			return nil, fmt.Errorf("failed fetching tags: %s, %w", repo, err)
+		}
+
+		for _, t := range tags {
+			if tag, ok := parseVersionTag(t.Name, t.Commit.SHA, prefix); ok {
+				res = append(res, tag)
+			}
+		}
+
+		if len(tags) < gitHubPageSize {
+			break
+		}
+	}
+
+	sortVersionTags(res)
+	return res, nil
+}
+
+// reachable reports whether base is an ancestor of (or the same commit as) head.
+func (g *GitHub) reachable(ctx context.Context, repo, base, head string) (bool, error) {
+	if base == head {
+		return true, nil
+	}
+
+	var cmp struct {
+		Status string `json:"status"`
+	}
+
+	if err := g.getJSON(ctx, "/repos/"+repo+"/compare/"+base+"..."+head+"?per_page=1", &cmp); err != nil {
+		return false, fmt.Errorf("failed comparing commits: %s, %s...%s, %w", repo, base, head, err)
+	}
+
+	return cmp.Status == "ahead" || cmp.Status == "identical", nil
+}
+
+// get performs an authenticated GET for uri (relative to the API root). Non-200 responses are returned as errors.
+func (g *GitHub) get(ctx context.Context, uri string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating VCS request: %s, %w", uri, err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if g.token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.token)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("unexpected status: %d", res.StatusCode)
+	}
+
+	return res, nil
+}
+
+// getJSON GETs uri and decodes the JSON response into v.
+func (g *GitHub) getJSON(ctx context.Context, uri string, v any) error {
+	res, err := g.get(ctx, uri)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %s, %w", uri, err)
+	}
+
+	return nil
+}
+
+// gitHubWebURL returns the web root for the API at baseURL. That is, https://github.com for https://api.github.com and
+// https://ghe.example.com for https://ghe.example.com/api/v3.
+func gitHubWebURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL
+	}
+
+	u.Host = strings.TrimPrefix(u.Host, "api.")
+	u.Path = strings.TrimSuffix(u.Path, "/api/v3")
+	return strings.TrimSuffix(u.String(), "/")
+}
+
+// escapeRef escapes ref for use in a URL path. Refs can contain slashes (e.g. feature/thing), so each segment is
+// escaped individually.
+func escapeRef(ref string) string {
+	parts := strings.Split(ref, "/")
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// narrowTarGz copies the tar.gz stream in r to w, keeping only the entries beneath dir (relative to the top-level
+// directory of the archive). Entry names are left untouched.
+func narrowTarGz(w io.Writer, r io.Reader, dir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed to open gzip stream: %w", err)
+	}
+	defer func() { _ = gr.Close() }()
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	tr := tar.NewReader(gr)
+
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read header: %w", err)
+		}
+
+		// NB: GitHub includes a pax global header with the commit SHA.
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+
+		if !withinDir(hdr.Name, dir) {
+			continue
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("failed to write header: %s, %w", hdr.Name, err)
+		}
+
+		if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec // copying between archives
+			return fmt.Errorf("failed to write entry: %s, %w", hdr.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to close tar stream: %w", err)
+	}
+
+	return gw.Close()
+}
+
+// withinDir reports whether the archive entry name (<top>/<path>) is at or beneath dir.
+func withinDir(name, dir string) bool {
+	if dir == "" {
+		return true
+	}
+
+	_, rel, ok := strings.Cut(strings.TrimSuffix(name, "/"), "/")
+	if !ok {
+		// The top-level directory itself.
+		return true
+	}
+
+	return rel == dir || strings.HasPrefix(rel, dir+"/") || strings.HasPrefix(dir, rel+"/")
+}
@@ -0,0 +1,208 @@
+package vcs_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pseudomuto/pacman/internal/types"
+	. "github.com/pseudomuto/pacman/internal/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHub_FetchArchive(t *testing.T) {
+	t.Parallel()
+
+	tarball := makeTarGz(t, []string{
+		"owner-repo-abc123/",
+		"owner-repo-abc123/README.md",
+		"owner-repo-abc123/sub/",
+		"owner-repo-abc123/sub/dir/",
+		"owner-repo-abc123/sub/dir/go.mod",
+		"owner-repo-abc123/sub/dir/pkg/lib.go",
+		"owner-repo-abc123/sub/dirty/go.mod",
+	})
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/owner/repo/tarball/feature/thing":
+			require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			http.Redirect(w, r, "/codeload/owner-repo-abc123.tar.gz", http.StatusFound)
+		case "/codeload/owner-repo-abc123.tar.gz":
+			_, _ = w.Write(tarball)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	gh := NewGitHub(svr.Client(), svr.URL+"/api/v3/", "secret")
+	require.Equal(t, types.GitHub, gh.Type())
+
+	t.Run("narrowed to dir", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gh.FetchArchive(&buf, "owner/repo", types.VCSOptions{
+			Ref: "feature/thing",
+			Dir: "sub/dir",
+		}))
+
+		require.Equal(t, []string{
+			"owner-repo-abc123/",
+			"owner-repo-abc123/sub/",
+			"owner-repo-abc123/sub/dir/",
+			"owner-repo-abc123/sub/dir/go.mod",
+			"owner-repo-abc123/sub/dir/pkg/lib.go",
+		}, tarNames(t, &buf))
+	})
+
+	t.Run("entire repo", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gh.FetchArchive(&buf, "owner/repo", types.VCSOptions{Ref: "feature/thing"}))
+		require.Len(t, tarNames(t, &buf), 7)
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		err := gh.FetchArchive(io.Discard, "owner/repo", types.VCSOptions{Ref: "nope"})
+		require.ErrorContains(t, err, "unexpected status: 404")
+	})
+}
+
+func TestGitHub_RepoURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "", want: "https://github.com/owner/repo"},
+		{baseURL: "https://api.github.com/", want: "https://github.com/owner/repo"},
+		{baseURL: "https://ghe.example.com/api/v3", want: "https://ghe.example.com/owner/repo"},
+		{baseURL: "https://api.corp.ghe.com", want: "https://corp.ghe.com/owner/repo"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, NewGitHub(nil, tt.baseURL, "").RepoURL("owner/repo"), tt.baseURL)
+	}
+}
+
+func TestGitHub_FetchCommit(t *testing.T) {
+	t.Parallel()
+
+	head := "daa7c04131f5e0a1b2c3d4e5f60718293a4b5c6d"
+	tags := make([]map[string]any, 0, 104)
+	for i := range 99 {
+		tags = append(tags, map[string]any{"name": fmt.Sprintf("other-%d", i), "commit": map[string]string{"sha": "x"}})
+	}
+
+	tags = append(tags,
+		// NB: v0.9.0 and v1.0.0-rc.1 are never compared, since v1.0.0 is the latest reachable tag.
+		map[string]any{"name": "v0.9.0", "commit": map[string]string{"sha": "older"}},
+		map[string]any{"name": "v1.0.0-rc.1", "commit": map[string]string{"sha": "older"}},
+		map[string]any{"name": "v1.0.0", "commit": map[string]string{"sha": "old"}},
+		map[string]any{"name": "v2.0.0", "commit": map[string]string{"sha": "diverged"}},
+		map[string]any{"name": "v1.0", "commit": map[string]string{"sha": "noncanonical"}},
+		map[string]any{"name": "sub/dir/v0.1.0", "commit": map[string]string{"sha": head}},
+	)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/repos/owner/repo/commits/feature/thing":
+			_, _ = fmt.Fprintf(w, `{"sha":%q,"commit":{"committer":{"date":"2019-11-09T02:19:31Z"}}}`, head)
+		case "/repos/owner/repo/tags":
+			switch r.URL.Query().Get("page") {
+			case "1":
+				require.NoError(t, json.NewEncoder(w).Encode(tags[:100]))
+			default:
+				require.NoError(t, json.NewEncoder(w).Encode(tags[100:]))
+			}
+		case "/repos/owner/repo/compare/old..." + head:
+			_, _ = w.Write([]byte(`{"status":"ahead"}`))
+		case "/repos/owner/repo/compare/diverged..." + head:
+			_, _ = w.Write([]byte(`{"status":"diverged"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	gh := NewGitHub(svr.Client(), svr.URL, "secret")
+
+	commit, err := gh.FetchCommit("owner/repo", types.VCSOptions{Ref: "feature/thing"})
+	require.NoError(t, err)
+	require.Equal(t, &types.Commit{
+		SHA:  head,
+		Time: time.Date(2019, 11, 9, 2, 19, 31, 0, time.UTC),
+		Tags: []string{"v1.0.0"},
+	}, commit)
+
+	commit, err = gh.FetchCommit("owner/repo", types.VCSOptions{Ref: "feature/thing", Dir: "sub/dir"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sub/dir/v0.1.0"}, commit.Tags)
+
+	_, err = gh.FetchCommit("owner/repo", types.VCSOptions{Ref: "nope"})
+	require.ErrorContains(t, err, "unexpected status: 404")
+}
+
+func makeTarGz(t *testing.T, names []string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	for _, name := range names {
+		hdr := &tar.Header{Name: name, Mode: 0o755, Typeflag: tar.TypeDir}
+		var data []byte
+		if name[len(name)-1] != '/' {
+			data = []byte("content of " + name)
+			hdr.Mode = 0o644
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = int64(len(data))
+		}
+
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err := tw.Write(data)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func tarNames(t *testing.T, r io.Reader) []string {
+	t.Helper()
+
+	gr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+
+	var names []string
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+
+		names = append(names, hdr.Name)
+	}
+
+	slices.Sort(names)
+	return names
+}
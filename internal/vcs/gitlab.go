@@ -4,31 +4,59 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/pseudomuto/pacman/internal/types"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
-type (
-	GitLab struct {
-		repo GitLabRepo
+const (
+	defaultGitLabURL = "https://gitlab.com"
+	gitLabPageSize   = 100
+)
+
+// GitLab fetches archives using the GitLab (gitlab.com or self-managed) REST API.
+type GitLab struct {
+	client  *gitlab.Client
+	baseURL string
+}
+
+// NewGitLab creates a GitLab fetcher for the instance at baseURL (e.g. https://gitlab.example.com). When baseURL is
+// empty, gitlab.com is used. The token is optional, but required for private repos.
+func NewGitLab(baseURL, token string) (*GitLab, error) {
+	if baseURL == "" {
+		baseURL = defaultGitLabURL
+	}
+
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(baseURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab client: %s, %w", baseURL, err)
 	}
 
-	GitLabRepo interface {
-		Archive(any, *gitlab.ArchiveOptions, ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error)
+	return NewGitLabWithClient(client, baseURL), nil
+}
+
+// NewGitLabWithClient creates a GitLab fetcher using client, which must be configured for the instance at baseURL.
+func NewGitLabWithClient(client *gitlab.Client, baseURL string) *GitLab {
+	if baseURL == "" {
+		baseURL = defaultGitLabURL
 	}
-)
 
-func NewGitLab(repo GitLabRepo) *GitLab {
-	return &GitLab{repo: repo}
+	return &GitLab{client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
 }
 
-func (g *GitLab) Name() string {
-	return "gitlab"
+func (g *GitLab) Type() types.VCSType {
+	return types.GitLab
+}
+
+// RepoURL returns the web URL of repo (namespace/name), e.g. https://gitlab.example.com/group/name.
+func (g *GitLab) RepoURL(repo string) string {
+	return g.baseURL + "/" + repo
 }
 
 func (g *GitLab) FetchArchive(w io.Writer, repo string, opts types.VCSOptions) error {
-	data, _, err := g.repo.Archive(
+	data, _, err := g.client.Repositories.Archive(
 		repo,
 		&gitlab.ArchiveOptions{
 			Format: gitlab.Ptr("tar.gz"),
@@ -47,3 +75,73 @@ func (g *GitLab) FetchArchive(w io.Writer, repo string, opts types.VCSOptions) e
 
 	return nil
 }
+
+// FetchCommit resolves opts.Ref in repo (namespace/name) to a Commit. Like GitHub, the API doesn't expose which tags
+// are reachable from a commit, so version tags are compared against it (using their merge base), latest first.
+func (g *GitLab) FetchCommit(repo string, opts types.VCSOptions) (*types.Commit, error) {
+	c, _, err := g.client.Commits.GetCommit(repo, opts.Ref, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching commit: %s@%s, %w", repo, opts.Ref, err)
+	}
+
+	tags, err := g.versionTags(repo, tagPrefix(opts.Dir))
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err = latestReachable(tags, func(sha string) (bool, error) { return g.reachable(repo, sha, c.ID) })
+	if err != nil {
+		return nil, err
+	}
+
+	commit := &types.Commit{SHA: c.ID, Tags: tagNames(tags)}
+	if c.CommittedDate != nil {
+		commit.Time = c.CommittedDate.UTC()
+	}
+
+	return commit, nil
+}
+
+// versionTags returns the canonical version tags in repo with the given prefix (e.g. sub/dir/), latest first.
+func (g *GitLab) versionTags(repo, prefix string) ([]versionTag, error) {
+	var res []versionTag
+	for page := int64(1); ; page++ {
+		tags, _, err := g.client.Tags.ListTags(repo, &gitlab.ListTagsOptions{
+			ListOptions: gitlab.ListOptions{Page: page, PerPage: gitLabPageSize},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed fetching tags: %s, %w", repo, err)
+		}
+
+		for _, t := range tags {
+			if t.Commit == nil {
+				continue
+			}
+
+			if tag, ok := parseVersionTag(t.Name, t.Commit.ID, prefix); ok {
+				res = append(res, tag)
+			}
+		}
+
+		if len(tags) < gitLabPageSize {
+			break
+		}
+	}
+
+	sortVersionTags(res)
+	return res, nil
+}
+
+// reachable reports whether base is an ancestor of (or the same commit as) head. That is, when it's their merge base.
+func (g *GitLab) reachable(repo, base, head string) (bool, error) {
+	if base == head {
+		return true, nil
+	}
+
+	mb, _, err := g.client.Repositories.MergeBase(repo, &gitlab.MergeBaseOptions{Ref: &[]string{base, head}})
+	if err != nil {
+		return false, fmt.Errorf("failed finding merge base: %s, %s...%s, %w", repo, base, head, err)
+	}
+
+	return mb.ID == base, nil
+}
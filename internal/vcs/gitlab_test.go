@@ -2,8 +2,10 @@ package vcs_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pseudomuto/pacman/internal/types"
 	. "github.com/pseudomuto/pacman/internal/vcs"
@@ -27,7 +29,8 @@ func TestGitLab_FetchArchive(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	gl := NewGitLab(client.Repositories)
+	gl := NewGitLabWithClient(client.Client, "")
+	require.Equal(t, types.GitLab, gl.Type())
 	require.NoError(t, gl.FetchArchive(buf, "test/repo", types.VCSOptions{
 		Dir: "some/sub/dir",
 		Ref: "c12345d",
@@ -54,3 +57,83 @@ func TestGitLab_FetchArchive(t *testing.T) {
 		require.ErrorContains(t, err, "Error: boom")
 	})
 }
+
+func TestGitLab_RepoURL(t *testing.T) {
+	client := gitlabtesting.NewTestClient(t)
+
+	require.Equal(t, "https://gitlab.com/group/repo", NewGitLabWithClient(client.Client, "").RepoURL("group/repo"))
+	require.Equal(
+		t,
+		"https://gitlab.example.com/group/repo",
+		NewGitLabWithClient(client.Client, "https://gitlab.example.com/").RepoURL("group/repo"),
+	)
+
+	gl, err := NewGitLab("https://gitlab.example.com", "secret")
+	require.NoError(t, err)
+	require.Equal(t, "https://gitlab.example.com/group/repo", gl.RepoURL("group/repo"))
+}
+
+func TestGitLab_FetchCommit(t *testing.T) {
+	head := "daa7c04131f5e0a1b2c3d4e5f60718293a4b5c6d"
+	date := time.Date(2019, 11, 9, 2, 19, 31, 0, time.FixedZone("EST", -5*60*60))
+
+	tag := func(name, sha string) *gitlab.Tag {
+		return &gitlab.Tag{Name: name, Commit: &gitlab.Commit{ID: sha}}
+	}
+
+	tags := make([]*gitlab.Tag, 0, 104)
+	for i := range 99 {
+		tags = append(tags, tag(fmt.Sprintf("other-%d", i), "x"))
+	}
+
+	tags = append(tags,
+		// NB: v0.9.0 and v1.0.0-rc.1 are never compared, since v1.0.0 is the latest reachable tag.
+		tag("v0.9.0", "older"),
+		tag("v1.0.0-rc.1", "older"),
+		tag("v1.0.0", "old"),
+		tag("v2.0.0", "diverged"),
+		tag("v1.0", "noncanonical"),
+		tag("sub/dir/v0.1.0", head),
+	)
+
+	client := gitlabtesting.NewTestClient(t)
+	client.MockCommits.EXPECT().
+		GetCommit("group/repo", "feature/thing", nil).
+		Return(&gitlab.Commit{ID: head, CommittedDate: &date}, nil, nil).
+		Times(2)
+	client.MockCommits.EXPECT().
+		GetCommit("group/repo", "nope", nil).
+		Return(nil, nil, errors.New("404 Not Found"))
+
+	page := func(n int64) *gitlab.ListTagsOptions {
+		return &gitlab.ListTagsOptions{ListOptions: gitlab.ListOptions{Page: n, PerPage: 100}}
+	}
+
+	client.MockTags.EXPECT().ListTags("group/repo", page(1)).Return(tags[:100], nil, nil).Times(2)
+	client.MockTags.EXPECT().ListTags("group/repo", page(2)).Return(tags[100:], nil, nil).Times(2)
+
+	mergeBase := func(base string) *gitlab.MergeBaseOptions {
+		return &gitlab.MergeBaseOptions{Ref: &[]string{base, head}}
+	}
+
+	client.MockRepositories.EXPECT().MergeBase("group/repo", mergeBase("old")).Return(&gitlab.Commit{ID: "old"}, nil, nil)
+	client.MockRepositories.EXPECT().
+		MergeBase("group/repo", mergeBase("diverged")).
+		Return(&gitlab.Commit{ID: "base"}, nil, nil)
+
+	gl := NewGitLabWithClient(client.Client, "")
+	commit, err := gl.FetchCommit("group/repo", types.VCSOptions{Ref: "feature/thing"})
+	require.NoError(t, err)
+	require.Equal(t, &types.Commit{
+		SHA:  head,
+		Time: time.Date(2019, 11, 9, 7, 19, 31, 0, time.UTC),
+		Tags: []string{"v1.0.0"},
+	}, commit)
+
+	commit, err = gl.FetchCommit("group/repo", types.VCSOptions{Ref: "feature/thing", Dir: "sub/dir"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sub/dir/v0.1.0"}, commit.Tags)
+
+	_, err = gl.FetchCommit("group/repo", types.VCSOptions{Ref: "nope"})
+	require.ErrorContains(t, err, "404 Not Found")
+}
@@ -0,0 +1,73 @@
+package vcs
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// versionTag is a version tag, e.g. sub/dir/v1.2.3 (name) for v1.2.3 (version).
+type versionTag struct {
+	name    string
+	sha     string
+	version string
+}
+
+// parseVersionTag returns the version tag for name (pointing at sha), or false when it isn't a canonical version with
+// the given prefix (e.g. sub/dir/). Pseudo-versions are never tags.
+func parseVersionTag(name, sha, prefix string) (versionTag, bool) {
+	v, ok := strings.CutPrefix(name, prefix)
+	if !ok || semver.Canonical(v) != v || module.IsPseudoVersion(v) {
+		return versionTag{}, false
+	}
+
+	return versionTag{name: name, sha: sha, version: v}, true
+}
+
+// sortVersionTags sorts tags latest first.
+func sortVersionTags(tags []versionTag) {
+	slices.SortFunc(tags, func(a, b versionTag) int { return semver.Compare(b.version, a.version) })
+}
+
+// latestReachable returns the latest of tags (sorted latest first) for each major version which reachable reports is an
+// ancestor of the commit being resolved. Only the latest reachable tag for each major version determines the
+// pseudo-version, so older ones aren't compared.
+func latestReachable(tags []versionTag, reachable func(sha string) (bool, error)) ([]versionTag, error) {
+	var res []versionTag
+	found := make(map[string]bool)
+	for _, t := range tags {
+		// NB: Majors are grouped by the module paths they're valid for. That is, v0 and v1 share paths without a major
+		// suffix, while +incompatible versions are valid for different paths than vN.x.y.
+		major := semver.Major(t.version) + semver.Build(t.version)
+		if major == "v0" {
+			major = "v1"
+		}
+		if found[major] {
+			continue
+		}
+
+		ok, err := reachable(t.sha)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			found[major] = true
+			res = append(res, t)
+		}
+	}
+
+	return res, nil
+}
+
+// tagNames returns the names of tags.
+func tagNames(tags []versionTag) []string {
+	var res []string
+	for _, t := range tags {
+		res = append(res, t.name)
+	}
+
+	return res
+}